@@ -279,6 +279,21 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
+		if isFakeAWSEnabled() {
+			t.Logf("%s is set, using fake AWS services at %s", envVarFakeAWS, fakeAWSServer().URL())
+
+			if err := sdkdiag.DiagnosticsError(configureFakeAWS(ctx, Provider)); err != nil {
+				t.Fatalf("configuring provider: %s", err)
+			}
+			return
+		}
+
+		// Recorded tests can be replayed without credentials, e.g. in air-gapped CI.
+		if isVCROffline() {
+			configureVCROffline(ctx, t)
+			return
+		}
+
 		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
@@ -890,6 +905,18 @@ func ThirdRegion() string {
 	return envvar.GetWithDefault(envvar.ThirdRegion, names.USEast2RegionID)
 }
 
+// RegionImportStateIDFunc returns an import ID with a per-resource Region override appended, e.g. `vpc-12345678@eu-west-1`.
+func RegionImportStateIDFunc(resourceName, region string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", resourceName)
+		}
+
+		return rs.Primary.ID + "@" + region, nil
+	}
+}
+
 func Partition() string {
 	return names.PartitionForRegion(Region())
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 	helperlogging "github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func Context(t *testing.T) context.Context {
@@ -26,6 +27,18 @@ func Context(t *testing.T) context.Context {
 	return ctx
 }
 
+// RegionContext returns a Context in which AWS API clients target the specified Region,
+// as they do for a resource with a per-resource Region override.
+func RegionContext(ctx context.Context, servicePackageName, region string) context.Context {
+	ctx = conns.NewResourceContext(ctx, servicePackageName, "")
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+
+	return ctx
+}
+
 func logger(ctx context.Context, t *testing.T, name string) context.Context {
 	t.Helper()
 
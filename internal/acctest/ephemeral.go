@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+)
+
+const (
+	// EchoResourceName is the address of the resource into which ConfigWithEchoProvider echoes an ephemeral resource's result.
+	EchoResourceName = "echo.test"
+)
+
+// ProtoV6EchoProviderFactories is a static map containing only the echo provider instance.
+//
+// Ephemeral resource results are never persisted in Terraform plan or state, so acceptance tests
+// pass them to the echo provider whose `echo` resource stores them in its `data` attribute.
+var (
+	ProtoV6EchoProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+)
+
+// ConfigWithEchoProvider returns configuration that echoes the specified ephemeral resource's result
+// into the `data` attribute of the EchoResourceName resource.
+func ConfigWithEchoProvider(ephemeralResourceAddress string) string {
+	return fmt.Sprintf(`
+provider "echo" {
+  data = %[1]s
+}
+
+resource "echo" "test" {}
+`, ephemeralResourceAddress)
+}
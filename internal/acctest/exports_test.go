@@ -5,5 +5,9 @@ package acctest
 
 // Exports for use in tests only.
 var (
-	CloseVCRRecorder = closeVCRRecorder
+	CloseVCRRecorder     = closeVCRRecorder
+	ConfigureFakeAWS     = configureFakeAWS
+	NewVCRRecorder       = newVCRRecorder
+	RedactVCRInteraction = redactVCRInteraction
+	VCRMatcher           = vcrMatcher
 )
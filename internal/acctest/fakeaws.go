@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+const (
+	envVarFakeAWS = "TF_ACC_FAKE_AWS"
+)
+
+// fakeAWSServer returns the fake AWS services shared by all tests in the process.
+var fakeAWSServer = sync.OnceValue(fakeaws.NewServer)
+
+func isFakeAWSEnabled() bool {
+	return os.Getenv(envVarFakeAWS) != ""
+}
+
+// fakeAWSProviderConfig returns the provider configuration that directs AWS API calls to the fake AWS services.
+func fakeAWSProviderConfig() map[string]any {
+	server := fakeAWSServer()
+
+	endpoints := make(map[string]any)
+	for k, v := range server.Endpoints() {
+		endpoints[k] = v
+	}
+
+	return map[string]any{
+		"access_key":              fakeaws.AccessKeyID,
+		"endpoints":               []any{endpoints},
+		"s3_use_path_style":       true,
+		"secret_key":              fakeaws.SecretAccessKey,
+		"skip_metadata_api_check": "true",
+	}
+}
+
+// configureFakeAWS configures a provider to use the fake AWS services.
+func configureFakeAWS(ctx context.Context, p *schema.Provider) diag.Diagnostics {
+	config := fakeAWSProviderConfig()
+	config["region"] = Region()
+
+	return p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+}
+
+// fakeAWSProtoV5ProviderFactories returns ProtoV5ProviderFactories whose providers use the fake AWS services.
+func fakeAWSProtoV5ProviderFactories(ctx context.Context, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+	output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+
+	for name := range input {
+		output[name] = func() (tfprotov5.ProviderServer, error) {
+			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			primary.ConfigureContextFunc = fakeAWSProviderConfigureContextFunc(primary.ConfigureContextFunc)
+
+			return providerServerFactory(), nil
+		}
+	}
+
+	return output
+}
+
+// fakeAWSProviderConfigureContextFunc returns a provider configuration function that overrides the test configuration's
+// credentials and endpoints with those of the fake AWS services.
+// A test configuration without a Region uses the default test Region.
+func fakeAWSProviderConfigureContextFunc(configureContextFunc schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		for k, v := range fakeAWSProviderConfig() {
+			if err := d.Set(k, v); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "setting %s for fake AWS services: %s", k, err)
+			}
+		}
+
+		if d.Get("region").(string) == "" {
+			if err := d.Set("region", Region()); err != nil {
+				return nil, sdkdiag.AppendErrorf(diags, "setting region for fake AWS services: %s", err)
+			}
+		}
+
+		return configureContextFunc(ctx, d)
+	}
+}
@@ -0,0 +1,686 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// dynamoDBService implements Amazon DynamoDB tables and items.
+type dynamoDBService struct {
+	store
+	operations map[string]jsonOperation
+	tables     map[string]*dynamoDBTable // Keyed by name.
+}
+
+type dynamoDBTable struct {
+	arn                    string
+	attributeDefinitions   []any
+	billingMode            string
+	created                time.Time
+	deletionProtection     bool
+	globalSecondaryIndexes []any
+	id                     string
+	items                  map[string]jsonObject // Keyed by the JSON encoding of the item's key.
+	keySchema              []any
+	localSecondaryIndexes  []any
+	name                   string
+	pointInTimeRecovery    bool
+	provisionedThroughput  jsonObject
+	sseSpecification       jsonObject
+	streamLabel            string
+	streamSpecification    jsonObject
+	tableClass             string
+	tags                   map[string]string
+	ttlAttributeName       string
+}
+
+func newDynamoDBService() *dynamoDBService {
+	s := &dynamoDBService{
+		tables: make(map[string]*dynamoDBTable),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"CreateTable":                         s.createTable,
+		"DeleteItem":                          s.deleteItem,
+		"DeleteTable":                         s.deleteTable,
+		"DescribeContinuousBackups":           s.describeContinuousBackups,
+		"DescribeKinesisStreamingDestination": s.describeKinesisStreamingDestination,
+		"DescribeTable":                       s.describeTable,
+		"DescribeTimeToLive":                  s.describeTimeToLive,
+		"GetItem":                             s.getItem,
+		"ListTables":                          s.listTables,
+		"ListTagsOfResource":                  s.listTagsOfResource,
+		"PutItem":                             s.putItem,
+		"Scan":                                s.scan,
+		"TagResource":                         s.tagResource,
+		"UntagResource":                       s.untagResource,
+		"UpdateContinuousBackups":             s.updateContinuousBackups,
+		"UpdateItem":                          s.updateItem,
+		"UpdateTable":                         s.updateTable,
+		"UpdateTimeToLive":                    s.updateTimeToLive,
+	}
+
+	return s
+}
+
+func (s *dynamoDBService) endpoint() string {
+	return "dynamodb"
+}
+
+func (s *dynamoDBService) signingName() string {
+	return "dynamodb"
+}
+
+func (s *dynamoDBService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.0", s.operations, nil)
+}
+
+func (s *dynamoDBService) findTable(name string) (*dynamoDBTable, error) {
+	// Tables can be identified by name or ARN.
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	table, ok := s.tables[name]
+	if !ok {
+		return nil, errBadRequest("ResourceNotFoundException", "Requested resource not found: Table: %s not found", name)
+	}
+
+	return table, nil
+}
+
+func (t *dynamoDBTable) description(status string) jsonObject {
+	v := jsonObject{
+		"AttributeDefinitions":      t.attributeDefinitions,
+		"BillingModeSummary":        jsonObject{"BillingMode": t.billingMode},
+		"CreationDateTime":          epochSeconds(t.created),
+		"DeletionProtectionEnabled": t.deletionProtection,
+		"ItemCount":                 len(t.items),
+		"KeySchema":                 t.keySchema,
+		"ProvisionedThroughput":     dynamoDBProvisionedThroughput(t.provisionedThroughput),
+		"TableArn":                  t.arn,
+		"TableClassSummary":         jsonObject{"TableClass": t.tableClass},
+		"TableId":                   t.id,
+		"TableName":                 t.name,
+		"TableSizeBytes":            0,
+		"TableStatus":               status,
+	}
+
+	if len(t.globalSecondaryIndexes) > 0 {
+		var indexes []any
+		for _, index := range t.globalSecondaryIndexes {
+			index := maps.Clone(index.(map[string]any))
+			index["IndexArn"] = t.arn + "/index/" + jsonObject(index).string("IndexName")
+			index["IndexStatus"] = "ACTIVE"
+			index["ProvisionedThroughput"] = dynamoDBProvisionedThroughput(jsonObject(index).object("ProvisionedThroughput"))
+			indexes = append(indexes, index)
+		}
+		v["GlobalSecondaryIndexes"] = indexes
+	}
+	if len(t.localSecondaryIndexes) > 0 {
+		var indexes []any
+		for _, index := range t.localSecondaryIndexes {
+			index := maps.Clone(index.(map[string]any))
+			index["IndexArn"] = t.arn + "/index/" + jsonObject(index).string("IndexName")
+			indexes = append(indexes, index)
+		}
+		v["LocalSecondaryIndexes"] = indexes
+	}
+	if t.sseSpecification.bool("Enabled") {
+		sse := jsonObject{
+			"SSEType": "KMS",
+			"Status":  "ENABLED",
+		}
+		if v := t.sseSpecification.string("KMSMasterKeyId"); v != "" {
+			sse["KMSMasterKeyArn"] = v
+		} else {
+			sse["KMSMasterKeyArn"] = arnString("kms", strings.Split(t.arn, ":")[3], "key/aws-managed-dynamodb")
+		}
+		v["SSEDescription"] = sse
+	}
+	if t.streamSpecification.bool("StreamEnabled") {
+		v["LatestStreamArn"] = t.arn + "/stream/" + t.streamLabel
+		v["LatestStreamLabel"] = t.streamLabel
+		v["StreamSpecification"] = t.streamSpecification
+	}
+
+	return v
+}
+
+func dynamoDBProvisionedThroughput(v jsonObject) jsonObject {
+	return jsonObject{
+		"NumberOfDecreasesToday": 0,
+		"ReadCapacityUnits":      v.int("ReadCapacityUnits", 0),
+		"WriteCapacityUnits":     v.int("WriteCapacityUnits", 0),
+	}
+}
+
+func (s *dynamoDBService) createTable(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("TableName")
+	if _, ok := s.tables[name]; ok {
+		return nil, errBadRequest("ResourceInUseException", "Table already exists: %s", name)
+	}
+
+	table := &dynamoDBTable{
+		arn:                    arnString("dynamodb", regionFrom(ctx), "table/"+name),
+		attributeDefinitions:   input.list("AttributeDefinitions"),
+		billingMode:            input.string("BillingMode"),
+		created:                time.Now(),
+		deletionProtection:     input.bool("DeletionProtectionEnabled"),
+		globalSecondaryIndexes: input.list("GlobalSecondaryIndexes"),
+		id:                     newID(),
+		items:                  make(map[string]jsonObject),
+		keySchema:              input.list("KeySchema"),
+		localSecondaryIndexes:  input.list("LocalSecondaryIndexes"),
+		name:                   name,
+		provisionedThroughput:  input.object("ProvisionedThroughput"),
+		sseSpecification:       input.object("SSESpecification"),
+		streamSpecification:    input.object("StreamSpecification"),
+		tableClass:             input.string("TableClass"),
+		tags:                   input.tagList("Tags", "Key", "Value"),
+	}
+	if table.billingMode == "" {
+		table.billingMode = "PROVISIONED"
+	}
+	if table.tableClass == "" {
+		table.tableClass = "STANDARD"
+	}
+	if table.streamSpecification.bool("StreamEnabled") {
+		table.streamLabel = time.Now().UTC().Format("2006-01-02T15:04:05.000")
+	}
+	s.tables[name] = table
+
+	return jsonObject{"TableDescription": table.description("ACTIVE")}, nil
+}
+
+func (s *dynamoDBService) describeTable(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"Table": table.description("ACTIVE")}, nil
+}
+
+func (s *dynamoDBService) updateTable(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.list("AttributeDefinitions"); v != nil {
+		table.attributeDefinitions = v
+	}
+	if v := input.string("BillingMode"); v != "" {
+		table.billingMode = v
+	}
+	if _, ok := input["DeletionProtectionEnabled"]; ok {
+		table.deletionProtection = input.bool("DeletionProtectionEnabled")
+	}
+	if v := input.object("ProvisionedThroughput"); v != nil {
+		table.provisionedThroughput = v
+	}
+	if v := input.object("SSESpecification"); v != nil {
+		table.sseSpecification = v
+	}
+	if v := input.object("StreamSpecification"); v != nil {
+		table.streamSpecification = v
+		if v.bool("StreamEnabled") {
+			table.streamLabel = time.Now().UTC().Format("2006-01-02T15:04:05.000")
+		}
+	}
+	if v := input.string("TableClass"); v != "" {
+		table.tableClass = v
+	}
+
+	for _, v := range input.list("GlobalSecondaryIndexUpdates") {
+		update := jsonObject(v.(map[string]any))
+
+		switch {
+		case update.object("Create") != nil:
+			table.globalSecondaryIndexes = append(table.globalSecondaryIndexes, map[string]any(update.object("Create")))
+		case update.object("Delete") != nil:
+			name := update.object("Delete").string("IndexName")
+			table.globalSecondaryIndexes = slices.DeleteFunc(table.globalSecondaryIndexes, func(index any) bool {
+				return jsonObject(index.(map[string]any)).string("IndexName") == name
+			})
+		case update.object("Update") != nil:
+			name := update.object("Update").string("IndexName")
+			for _, index := range table.globalSecondaryIndexes {
+				if index := index.(map[string]any); jsonObject(index).string("IndexName") == name {
+					index["ProvisionedThroughput"] = update.object("Update").object("ProvisionedThroughput")
+				}
+			}
+		}
+	}
+
+	return jsonObject{"TableDescription": table.description("ACTIVE")}, nil
+}
+
+func (s *dynamoDBService) deleteTable(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if table.deletionProtection {
+		return nil, errBadRequest("ValidationException", "Resource cannot be deleted as it is currently protected against deletion. Disable deletion protection first.")
+	}
+
+	delete(s.tables, table.name)
+
+	return jsonObject{"TableDescription": table.description("DELETING")}, nil
+}
+
+func (s *dynamoDBService) listTables(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	names := sortedKeys(s.tables)
+	start := 0
+	if v := input.string("ExclusiveStartTableName"); v != "" {
+		start, _ = slices.BinarySearch(names, v)
+		if start < len(names) && names[start] == v {
+			start++
+		}
+	}
+	names = names[start:]
+
+	output := jsonObject{}
+	if limit := input.int("Limit", 100); len(names) > limit {
+		names = names[:limit]
+		output["LastEvaluatedTableName"] = names[len(names)-1]
+	}
+	output["TableNames"] = names
+
+	return output, nil
+}
+
+func (s *dynamoDBService) tagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(table.tags, input.tagList("Tags", "Key", "Value"))
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) untagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(table.tags, input.strings("TagKeys"))
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) listTagsOfResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"Tags": jsonTags(table.tags, "Key", "Value")}, nil
+}
+
+func (s *dynamoDBService) describeContinuousBackups(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, errBadRequest("TableNotFoundException", "Table not found: %s", input.string("TableName"))
+	}
+
+	return jsonObject{"ContinuousBackupsDescription": table.continuousBackupsDescription()}, nil
+}
+
+func (t *dynamoDBTable) continuousBackupsDescription() jsonObject {
+	status := "DISABLED"
+	if t.pointInTimeRecovery {
+		status = "ENABLED"
+	}
+
+	return jsonObject{
+		"ContinuousBackupsStatus": "ENABLED",
+		"PointInTimeRecoveryDescription": jsonObject{
+			"PointInTimeRecoveryStatus": status,
+		},
+	}
+}
+
+func (s *dynamoDBService) updateContinuousBackups(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, errBadRequest("TableNotFoundException", "Table not found: %s", input.string("TableName"))
+	}
+
+	table.pointInTimeRecovery = input.object("PointInTimeRecoverySpecification").bool("PointInTimeRecoveryEnabled")
+
+	return jsonObject{"ContinuousBackupsDescription": table.continuousBackupsDescription()}, nil
+}
+
+func (s *dynamoDBService) describeTimeToLive(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	description := jsonObject{"TimeToLiveStatus": "DISABLED"}
+	if table.ttlAttributeName != "" {
+		description = jsonObject{
+			"AttributeName":    table.ttlAttributeName,
+			"TimeToLiveStatus": "ENABLED",
+		}
+	}
+
+	return jsonObject{"TimeToLiveDescription": description}, nil
+}
+
+func (s *dynamoDBService) updateTimeToLive(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	specification := input.object("TimeToLiveSpecification")
+	if specification.bool("Enabled") {
+		table.ttlAttributeName = specification.string("AttributeName")
+	} else {
+		table.ttlAttributeName = ""
+	}
+
+	return jsonObject{"TimeToLiveSpecification": specification}, nil
+}
+
+func (s *dynamoDBService) describeKinesisStreamingDestination(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{
+		"KinesisDataStreamDestinations": []any{},
+		"TableName":                     table.name,
+	}, nil
+}
+
+// itemKey returns the key of an item, or of a Key request field, as a map key.
+func (t *dynamoDBTable) itemKey(item jsonObject) (string, jsonObject, error) {
+	key := jsonObject{}
+
+	for _, v := range t.keySchema {
+		name := jsonObject(v.(map[string]any)).string("AttributeName")
+
+		value, ok := item[name]
+		if !ok {
+			return "", nil, errBadRequest("ValidationException", "One of the required keys was not given a value")
+		}
+		key[name] = value
+	}
+
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(b), key, nil
+}
+
+var dynamoDBConditionRegexp = regexp.MustCompile(`^\s*attribute_(not_)?exists\s*\(\s*([^)\s]+)\s*\)\s*$`)
+
+// checkDynamoDBCondition evaluates a condition expression against an existing item, which may be nil.
+// Only the attribute_exists and attribute_not_exists functions are supported.
+func checkDynamoDBCondition(input jsonObject, item jsonObject) error {
+	expression := input.string("ConditionExpression")
+	if expression == "" {
+		return nil
+	}
+
+	m := dynamoDBConditionRegexp.FindStringSubmatch(expression)
+	if m == nil {
+		return errBadRequest("ValidationException", "fake DynamoDB condition expression not supported: %s", expression)
+	}
+
+	name := m[2]
+	if v := input.object("ExpressionAttributeNames").string(name); v != "" {
+		name = v
+	}
+
+	_, exists := item[name]
+	if exists == (m[1] == "not_") {
+		return errBadRequest("ConditionalCheckFailedException", "The conditional request failed")
+	}
+
+	return nil
+}
+
+func (s *dynamoDBService) putItem(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	item := input.object("Item")
+	k, _, err := table.itemKey(item)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDynamoDBCondition(input, table.items[k]); err != nil {
+		return nil, err
+	}
+
+	table.items[k] = item
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) getItem(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	k, _, err := table.itemKey(input.object("Key"))
+	if err != nil {
+		return nil, err
+	}
+
+	item, ok := table.items[k]
+	if !ok {
+		return nil, nil
+	}
+
+	return jsonObject{"Item": item}, nil
+}
+
+func (s *dynamoDBService) updateItem(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	k, key, err := table.itemKey(input.object("Key"))
+	if err != nil {
+		return nil, err
+	}
+
+	existing := table.items[k]
+	if err := checkDynamoDBCondition(input, existing); err != nil {
+		return nil, err
+	}
+
+	item := maps.Clone(existing)
+	if item == nil {
+		item = maps.Clone(key)
+	}
+
+	for name, v := range input.object("AttributeUpdates") {
+		update := jsonObject(v.(map[string]any))
+
+		switch action := update.string("Action"); action {
+		case "", "PUT":
+			item[name] = update["Value"]
+		case "DELETE":
+			delete(item, name)
+		default:
+			return nil, errBadRequest("ValidationException", "fake DynamoDB attribute update action not supported: %s", action)
+		}
+	}
+
+	if expression := input.string("UpdateExpression"); expression != "" {
+		if err := applyDynamoDBUpdateExpression(item, expression, input.object("ExpressionAttributeNames"), input.object("ExpressionAttributeValues")); err != nil {
+			return nil, err
+		}
+	}
+
+	table.items[k] = item
+
+	return nil, nil
+}
+
+var dynamoDBUpdateClauseRegexp = regexp.MustCompile(`(?i)\b(SET|REMOVE)\b`)
+
+// applyDynamoDBUpdateExpression applies an update expression's SET and REMOVE clauses to an item.
+// Only assignments of values and removals of top-level attributes are supported.
+func applyDynamoDBUpdateExpression(item jsonObject, expression string, names, values jsonObject) error {
+	name := func(s string) string {
+		s = strings.TrimSpace(s)
+		if v := names.string(s); v != "" {
+			return v
+		}
+		return s
+	}
+
+	locations := dynamoDBUpdateClauseRegexp.FindAllStringIndex(expression, -1)
+	if len(locations) == 0 {
+		return errBadRequest("ValidationException", "fake DynamoDB update expression not supported: %s", expression)
+	}
+
+	for i, location := range locations {
+		end := len(expression)
+		if i+1 < len(locations) {
+			end = locations[i+1][0]
+		}
+		clause := strings.ToUpper(expression[location[0]:location[1]])
+
+		for _, action := range strings.Split(expression[location[1]:end], ",") {
+			switch clause {
+			case "SET":
+				path, value, ok := strings.Cut(action, "=")
+				if !ok {
+					return errBadRequest("ValidationException", "fake DynamoDB update expression not supported: %s", expression)
+				}
+				v, ok := values[strings.TrimSpace(value)]
+				if !ok {
+					return errBadRequest("ValidationException", "fake DynamoDB update expression not supported: %s", expression)
+				}
+				item[name(path)] = v
+			case "REMOVE":
+				delete(item, name(action))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *dynamoDBService) deleteItem(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	k, _, err := table.itemKey(input.object("Key"))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkDynamoDBCondition(input, table.items[k]); err != nil {
+		return nil, err
+	}
+
+	delete(table.items, k)
+
+	return nil, nil
+}
+
+func (s *dynamoDBService) scan(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	table, err := s.findTable(input.string("TableName"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := sortedKeys(table.items)
+	start := 0
+	if v := input.object("ExclusiveStartKey"); v != nil {
+		k, _, err := table.itemKey(v)
+		if err != nil {
+			return nil, err
+		}
+		start, _ = slices.BinarySearch(keys, k)
+		if start < len(keys) && keys[start] == k {
+			start++
+		}
+	}
+	keys = keys[start:]
+
+	output := jsonObject{}
+	if limit := input.int("Limit", 0); limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+		_, key, _ := table.itemKey(table.items[keys[len(keys)-1]])
+		output["LastEvaluatedKey"] = key
+	}
+
+	items := []any{}
+	for _, k := range keys {
+		items = append(items, table.items[k])
+	}
+	output["Count"] = len(items)
+	output["Items"] = items
+	output["ScannedCount"] = len(items)
+
+	return output, nil
+}
@@ -0,0 +1,816 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// iamService implements AWS Identity and Access Management roles and customer managed policies.
+type iamService struct {
+	store
+	operations map[string]queryOperation
+	policies   map[string]*iamPolicy // Keyed by ARN.
+	roles      map[string]*iamRole   // Keyed by name.
+}
+
+type iamRole struct {
+	arn                 string
+	assumeRolePolicy    string
+	attachedPolicyARNs  []string
+	created             time.Time
+	description         string
+	id                  string
+	inlinePolicies      map[string]string
+	maxSessionDuration  int
+	name                string
+	path                string
+	permissionsBoundary string
+	tags                map[string]string
+}
+
+type iamPolicy struct {
+	arn            string
+	created        time.Time
+	defaultVersion string
+	description    string
+	id             string
+	name           string
+	nextVersion    int
+	path           string
+	tags           map[string]string
+	updated        time.Time
+	versions       map[string]*iamPolicyVersion
+}
+
+type iamPolicyVersion struct {
+	created  time.Time
+	document string
+	id       string
+}
+
+func newIAMService() *iamService {
+	s := &iamService{
+		policies: make(map[string]*iamPolicy),
+		roles:    make(map[string]*iamRole),
+	}
+
+	s.operations = map[string]queryOperation{
+		"AttachRolePolicy":            s.attachRolePolicy,
+		"CreatePolicy":                s.createPolicy,
+		"CreatePolicyVersion":         s.createPolicyVersion,
+		"CreateRole":                  s.createRole,
+		"DeletePolicy":                s.deletePolicy,
+		"DeletePolicyVersion":         s.deletePolicyVersion,
+		"DeleteRole":                  s.deleteRole,
+		"DeleteRolePolicy":            s.deleteRolePolicy,
+		"DetachRolePolicy":            s.detachRolePolicy,
+		"GetPolicy":                   s.getPolicy,
+		"GetPolicyVersion":            s.getPolicyVersion,
+		"GetRole":                     s.getRole,
+		"GetRolePolicy":               s.getRolePolicy,
+		"ListAttachedRolePolicies":    s.listAttachedRolePolicies,
+		"ListEntitiesForPolicy":       s.listEntitiesForPolicy,
+		"ListInstanceProfilesForRole": s.listInstanceProfilesForRole,
+		"ListPolicies":                s.listPolicies,
+		"ListPolicyTags":              s.listPolicyTags,
+		"ListPolicyVersions":          s.listPolicyVersions,
+		"ListRolePolicies":            s.listRolePolicies,
+		"ListRoleTags":                s.listRoleTags,
+		"ListRoles":                   s.listRoles,
+		"PutRolePolicy":               s.putRolePolicy,
+		"SetDefaultPolicyVersion":     s.setDefaultPolicyVersion,
+		"TagPolicy":                   s.tagPolicy,
+		"TagRole":                     s.tagRole,
+		"UntagPolicy":                 s.untagPolicy,
+		"UntagRole":                   s.untagRole,
+		"UpdateAssumeRolePolicy":      s.updateAssumeRolePolicy,
+		"UpdateRole":                  s.updateRole,
+		"UpdateRoleDescription":       s.updateRoleDescription,
+	}
+
+	return s
+}
+
+func (s *iamService) endpoint() string {
+	return "iam"
+}
+
+func (s *iamService) signingName() string {
+	return "iam"
+}
+
+func (s *iamService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "https://iam.amazonaws.com/doc/2010-05-08/", s.operations)
+}
+
+// iamID returns a new unique identifier with the specified prefix, e.g. "AROA" for roles.
+func iamID(prefix string) string {
+	return prefix + strings.ToUpper(strings.ReplaceAll(newID(), "-", ""))[:17]
+}
+
+func iamPath(values url.Values) string {
+	if v := values.Get("Path"); v != "" {
+		return v
+	}
+
+	return "/"
+}
+
+// iamPolicyDocument encodes a policy document as returned by IAM.
+func iamPolicyDocument(document string) string {
+	return url.QueryEscape(document)
+}
+
+func (s *iamService) findRole(name string) (*iamRole, error) {
+	role, ok := s.roles[name]
+	if !ok {
+		return nil, errNotFound("NoSuchEntity", "The role with name %s cannot be found.", name)
+	}
+
+	return role, nil
+}
+
+func (s *iamService) findPolicy(arn string) (*iamPolicy, error) {
+	policy, ok := s.policies[arn]
+	if !ok {
+		return nil, errNotFound("NoSuchEntity", "Policy %s does not exist or is not attachable.", arn)
+	}
+
+	return policy, nil
+}
+
+func (s *iamService) roleXML(role *iamRole) xmlObject {
+	v := xmlObject{
+		"Arn":                      role.arn,
+		"AssumeRolePolicyDocument": iamPolicyDocument(role.assumeRolePolicy),
+		"CreateDate":               role.created,
+		"MaxSessionDuration":       role.maxSessionDuration,
+		"Path":                     role.path,
+		"RoleId":                   role.id,
+		"RoleLastUsed":             xmlObject{},
+		"RoleName":                 role.name,
+	}
+
+	if role.description != "" {
+		v["Description"] = role.description
+	}
+	if role.permissionsBoundary != "" {
+		v["PermissionsBoundary"] = xmlObject{
+			"PermissionsBoundaryArn":  role.permissionsBoundary,
+			"PermissionsBoundaryType": "Policy",
+		}
+	}
+	if len(role.tags) > 0 {
+		v["Tags"] = xmlTags(role.tags)
+	}
+
+	return v
+}
+
+func (s *iamService) createRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	name := input.Get("RoleName")
+	if _, ok := s.roles[name]; ok {
+		return nil, errConflict("EntityAlreadyExists", "Role with name %s already exists.", name)
+	}
+
+	path := iamPath(input)
+	role := &iamRole{
+		arn:                 "arn:" + Partition + ":iam::" + AccountID + ":role" + path + name,
+		assumeRolePolicy:    input.Get("AssumeRolePolicyDocument"),
+		created:             time.Now(),
+		description:         input.Get("Description"),
+		id:                  iamID("AROA"),
+		inlinePolicies:      make(map[string]string),
+		maxSessionDuration:  queryInt(input, "MaxSessionDuration", 3600),
+		name:                name,
+		path:                path,
+		permissionsBoundary: input.Get("PermissionsBoundary"),
+		tags:                queryTags(input, "Tags.member"),
+	}
+	s.roles[name] = role
+
+	return xmlObject{"Role": s.roleXML(role)}, nil
+}
+
+func (s *iamService) getRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return xmlObject{"Role": s.roleXML(role)}, nil
+}
+
+func (s *iamService) updateRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Has("Description") {
+		role.description = input.Get("Description")
+	}
+	role.maxSessionDuration = queryInt(input, "MaxSessionDuration", role.maxSessionDuration)
+
+	return nil, nil
+}
+
+func (s *iamService) updateRoleDescription(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.description = input.Get("Description")
+
+	return xmlObject{"Role": s.roleXML(role)}, nil
+}
+
+func (s *iamService) updateAssumeRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.assumeRolePolicy = input.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) deleteRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(role.inlinePolicies) > 0 || len(role.attachedPolicyARNs) > 0 {
+		return nil, errConflict("DeleteConflict", "Cannot delete entity, must delete policies first.")
+	}
+
+	delete(s.roles, role.name)
+
+	return nil, nil
+}
+
+func (s *iamService) listRoles(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	var roles []*iamRole
+	for _, name := range sortedKeys(s.roles) {
+		if role := s.roles[name]; strings.HasPrefix(role.path, input.Get("PathPrefix")) {
+			roles = append(roles, role)
+		}
+	}
+
+	page, marker, err := paginate(roles, input.Get("Marker"), queryInt(input, "MaxItems", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, role := range page {
+		members = append(members, s.roleXML(role))
+	}
+
+	return iamPage(xmlObject{"Roles": members}, marker), nil
+}
+
+// iamPage adds IAM's pagination fields to a list result.
+func iamPage(result xmlObject, marker string) xmlObject {
+	result["IsTruncated"] = marker != ""
+	if marker != "" {
+		result["Marker"] = marker
+	}
+
+	return result
+}
+
+func (s *iamService) tagRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(role.tags, queryTags(input, "Tags.member"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(role.tags, queryStrings(input, "TagKeys.member"))
+
+	return nil, nil
+}
+
+func (s *iamService) listRoleTags(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamPage(xmlObject{"Tags": xmlTags(role.tags)}, ""), nil
+}
+
+func (s *iamService) putRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	role.inlinePolicies[input.Get("PolicyName")] = input.Get("PolicyDocument")
+
+	return nil, nil
+}
+
+func (s *iamService) getRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.Get("PolicyName")
+	document, ok := role.inlinePolicies[name]
+	if !ok {
+		return nil, errNotFound("NoSuchEntity", "The role policy with name %s cannot be found.", name)
+	}
+
+	return xmlObject{
+		"PolicyDocument": iamPolicyDocument(document),
+		"PolicyName":     name,
+		"RoleName":       role.name,
+	}, nil
+}
+
+func (s *iamService) deleteRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.Get("PolicyName")
+	if _, ok := role.inlinePolicies[name]; !ok {
+		return nil, errNotFound("NoSuchEntity", "The role policy with name %s cannot be found.", name)
+	}
+
+	delete(role.inlinePolicies, name)
+
+	return nil, nil
+}
+
+func (s *iamService) listRolePolicies(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	page, marker, err := paginate(sortedKeys(role.inlinePolicies), input.Get("Marker"), queryInt(input, "MaxItems", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, name := range page {
+		members = append(members, name)
+	}
+
+	return iamPage(xmlObject{"PolicyNames": members}, marker), nil
+}
+
+// isAWSManagedPolicy returns whether the specified ARN is that of an AWS managed policy.
+// AWS managed policies aren't modeled and can always be attached.
+func isAWSManagedPolicy(arn string) bool {
+	return strings.HasPrefix(arn, "arn:"+Partition+":iam::aws:policy/")
+}
+
+func (s *iamService) attachRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	arn := input.Get("PolicyArn")
+	if !isAWSManagedPolicy(arn) {
+		if _, err := s.findPolicy(arn); err != nil {
+			return nil, err
+		}
+	}
+
+	if !slices.Contains(role.attachedPolicyARNs, arn) {
+		role.attachedPolicyARNs = append(role.attachedPolicyARNs, arn)
+	}
+
+	return nil, nil
+}
+
+func (s *iamService) detachRolePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	arn := input.Get("PolicyArn")
+	i := slices.Index(role.attachedPolicyARNs, arn)
+	if i < 0 {
+		return nil, errNotFound("NoSuchEntity", "Policy %s was not found.", arn)
+	}
+
+	role.attachedPolicyARNs = slices.Delete(role.attachedPolicyARNs, i, i+1)
+
+	return nil, nil
+}
+
+func (s *iamService) listAttachedRolePolicies(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	role, err := s.findRole(input.Get("RoleName"))
+	if err != nil {
+		return nil, err
+	}
+
+	page, marker, err := paginate(role.attachedPolicyARNs, input.Get("Marker"), queryInt(input, "MaxItems", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, arn := range page {
+		members = append(members, xmlObject{
+			"PolicyArn":  arn,
+			"PolicyName": arn[strings.LastIndex(arn, "/")+1:],
+		})
+	}
+
+	return iamPage(xmlObject{"AttachedPolicies": members}, marker), nil
+}
+
+func (s *iamService) listInstanceProfilesForRole(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	if _, err := s.findRole(input.Get("RoleName")); err != nil {
+		return nil, err
+	}
+
+	return iamPage(xmlObject{"InstanceProfiles": xmlMembers{}}, ""), nil
+}
+
+func (s *iamService) policyXML(policy *iamPolicy) xmlObject {
+	v := xmlObject{
+		"Arn":                           policy.arn,
+		"AttachmentCount":               len(s.policyRoles(policy.arn)),
+		"CreateDate":                    policy.created,
+		"DefaultVersionId":              policy.defaultVersion,
+		"IsAttachable":                  true,
+		"Path":                          policy.path,
+		"PermissionsBoundaryUsageCount": 0,
+		"PolicyId":                      policy.id,
+		"PolicyName":                    policy.name,
+		"UpdateDate":                    policy.updated,
+	}
+
+	if policy.description != "" {
+		v["Description"] = policy.description
+	}
+	if len(policy.tags) > 0 {
+		v["Tags"] = xmlTags(policy.tags)
+	}
+
+	return v
+}
+
+func (s *iamService) policyVersionXML(policy *iamPolicy, version *iamPolicyVersion) xmlObject {
+	return xmlObject{
+		"CreateDate":       version.created,
+		"Document":         iamPolicyDocument(version.document),
+		"IsDefaultVersion": version.id == policy.defaultVersion,
+		"VersionId":        version.id,
+	}
+}
+
+// policyRoles returns the roles to which the specified policy is attached.
+func (s *iamService) policyRoles(arn string) []*iamRole {
+	var roles []*iamRole
+
+	for _, name := range sortedKeys(s.roles) {
+		if role := s.roles[name]; slices.Contains(role.attachedPolicyARNs, arn) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+func (p *iamPolicy) addVersion(document string, setAsDefault bool) *iamPolicyVersion {
+	p.nextVersion++
+	version := &iamPolicyVersion{
+		created:  time.Now(),
+		document: document,
+		id:       "v" + strconv.Itoa(p.nextVersion),
+	}
+	p.versions[version.id] = version
+	p.updated = version.created
+
+	if setAsDefault {
+		p.defaultVersion = version.id
+	}
+
+	return version
+}
+
+func (s *iamService) createPolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	path := iamPath(input)
+	name := input.Get("PolicyName")
+	arn := "arn:" + Partition + ":iam::" + AccountID + ":policy" + path + name
+	if _, ok := s.policies[arn]; ok {
+		return nil, errConflict("EntityAlreadyExists", "A policy called %s already exists. Duplicate names are not allowed.", name)
+	}
+
+	policy := &iamPolicy{
+		arn:         arn,
+		created:     time.Now(),
+		description: input.Get("Description"),
+		id:          iamID("ANPA"),
+		name:        name,
+		path:        path,
+		tags:        queryTags(input, "Tags.member"),
+		versions:    make(map[string]*iamPolicyVersion),
+	}
+	policy.addVersion(input.Get("PolicyDocument"), true)
+	s.policies[arn] = policy
+
+	return xmlObject{"Policy": s.policyXML(policy)}, nil
+}
+
+func (s *iamService) getPolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return xmlObject{"Policy": s.policyXML(policy)}, nil
+}
+
+func (s *iamService) deletePolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.policyRoles(policy.arn)) > 0 {
+		return nil, errConflict("DeleteConflict", "Cannot delete a policy attached to entities.")
+	}
+	if len(policy.versions) > 1 {
+		return nil, errConflict("DeleteConflict", "This policy has more than one version. Before you delete a policy, you must delete the policy's versions. The default version is deleted with the policy.")
+	}
+
+	delete(s.policies, policy.arn)
+
+	return nil, nil
+}
+
+func (s *iamService) listPolicies(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	// AWS managed policies aren't modeled.
+	if input.Get("Scope") == "AWS" {
+		return iamPage(xmlObject{"Policies": xmlMembers{}}, ""), nil
+	}
+
+	var policies []*iamPolicy
+	for _, arn := range sortedKeys(s.policies) {
+		policy := s.policies[arn]
+
+		if !strings.HasPrefix(policy.path, input.Get("PathPrefix")) {
+			continue
+		}
+		if input.Get("OnlyAttached") == "true" && len(s.policyRoles(arn)) == 0 {
+			continue
+		}
+
+		policies = append(policies, policy)
+	}
+
+	page, marker, err := paginate(policies, input.Get("Marker"), queryInt(input, "MaxItems", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, policy := range page {
+		members = append(members, s.policyXML(policy))
+	}
+
+	return iamPage(xmlObject{"Policies": members}, marker), nil
+}
+
+func (s *iamService) createPolicyVersion(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policy.versions) >= 5 {
+		return nil, newAPIError(http.StatusConflict, "LimitExceeded", "A managed policy can have up to 5 versions.")
+	}
+
+	version := policy.addVersion(input.Get("PolicyDocument"), input.Get("SetAsDefault") == "true")
+
+	return xmlObject{"PolicyVersion": s.policyVersionXML(policy, version)}, nil
+}
+
+func (s *iamService) findPolicyVersion(input url.Values) (*iamPolicy, *iamPolicyVersion, error) {
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	id := input.Get("VersionId")
+	version, ok := policy.versions[id]
+	if !ok {
+		return nil, nil, errNotFound("NoSuchEntity", "Policy %s version %s does not exist or is not attachable.", policy.arn, id)
+	}
+
+	return policy, version, nil
+}
+
+func (s *iamService) getPolicyVersion(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, version, err := s.findPolicyVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return xmlObject{"PolicyVersion": s.policyVersionXML(policy, version)}, nil
+}
+
+func (s *iamService) deletePolicyVersion(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, version, err := s.findPolicyVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.id == policy.defaultVersion {
+		return nil, errConflict("DeleteConflict", "Cannot delete the default version of a policy.")
+	}
+
+	delete(policy.versions, version.id)
+
+	return nil, nil
+}
+
+func (s *iamService) setDefaultPolicyVersion(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, version, err := s.findPolicyVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.defaultVersion = version.id
+
+	return nil, nil
+}
+
+func (s *iamService) listPolicyVersions(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*iamPolicyVersion, 0, len(policy.versions))
+	for _, version := range policy.versions {
+		versions = append(versions, version)
+	}
+	// Newest first.
+	slices.SortFunc(versions, func(a, b *iamPolicyVersion) int {
+		return b.created.Compare(a.created)
+	})
+
+	page, marker, err := paginate(versions, input.Get("Marker"), queryInt(input, "MaxItems", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, version := range page {
+		v := s.policyVersionXML(policy, version)
+		delete(v, "Document")
+		members = append(members, v)
+	}
+
+	return iamPage(xmlObject{"Versions": members}, marker), nil
+}
+
+func (s *iamService) listEntitiesForPolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	arn := input.Get("PolicyArn")
+	if !isAWSManagedPolicy(arn) {
+		if _, err := s.findPolicy(arn); err != nil {
+			return nil, err
+		}
+	}
+
+	roles := xmlMembers{}
+	for _, role := range s.policyRoles(arn) {
+		roles = append(roles, xmlObject{"RoleId": role.id, "RoleName": role.name})
+	}
+
+	return iamPage(xmlObject{
+		"PolicyGroups": xmlMembers{},
+		"PolicyRoles":  roles,
+		"PolicyUsers":  xmlMembers{},
+	}, ""), nil
+}
+
+func (s *iamService) tagPolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(policy.tags, queryTags(input, "Tags.member"))
+
+	return nil, nil
+}
+
+func (s *iamService) untagPolicy(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(policy.tags, queryStrings(input, "TagKeys.member"))
+
+	return nil, nil
+}
+
+func (s *iamService) listPolicyTags(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	policy, err := s.findPolicy(input.Get("PolicyArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return iamPage(xmlObject{"Tags": xmlTags(policy.tags)}, ""), nil
+}
@@ -0,0 +1,585 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// kmsService implements AWS Key Management Service keys and aliases.
+// No cryptographic operations are supported.
+type kmsService struct {
+	store
+	aliases    map[string]*kmsAlias // Keyed by name.
+	keys       map[string]*kmsKey   // Keyed by key ID.
+	operations map[string]jsonOperation
+}
+
+type kmsKey struct {
+	arn            string
+	created        time.Time
+	deletionDate   time.Time
+	description    string
+	id             string
+	keySpec        string
+	keyUsage       string
+	multiRegion    bool
+	policy         string
+	rotation       bool
+	rotationPeriod int
+	state          string
+	tags           map[string]string
+}
+
+type kmsAlias struct {
+	arn      string
+	created  time.Time
+	keyID    string
+	modified time.Time
+	name     string
+}
+
+const (
+	kmsKeyStateDisabled        = "Disabled"
+	kmsKeyStateEnabled         = "Enabled"
+	kmsKeyStatePendingDeletion = "PendingDeletion"
+	kmsPolicyNameDefault       = "default"
+)
+
+func newKMSService() *kmsService {
+	s := &kmsService{
+		aliases: make(map[string]*kmsAlias),
+		keys:    make(map[string]*kmsKey),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"CancelKeyDeletion":    s.cancelKeyDeletion,
+		"CreateAlias":          s.createAlias,
+		"CreateKey":            s.createKey,
+		"DeleteAlias":          s.deleteAlias,
+		"DescribeKey":          s.describeKey,
+		"DisableKey":           s.disableKey,
+		"DisableKeyRotation":   s.disableKeyRotation,
+		"EnableKey":            s.enableKey,
+		"EnableKeyRotation":    s.enableKeyRotation,
+		"GetKeyPolicy":         s.getKeyPolicy,
+		"GetKeyRotationStatus": s.getKeyRotationStatus,
+		"ListAliases":          s.listAliases,
+		"ListKeyPolicies":      s.listKeyPolicies,
+		"ListKeys":             s.listKeys,
+		"ListResourceTags":     s.listResourceTags,
+		"PutKeyPolicy":         s.putKeyPolicy,
+		"ScheduleKeyDeletion":  s.scheduleKeyDeletion,
+		"TagResource":          s.tagResource,
+		"UntagResource":        s.untagResource,
+		"UpdateAlias":          s.updateAlias,
+		"UpdateKeyDescription": s.updateKeyDescription,
+	}
+
+	return s
+}
+
+func (s *kmsService) endpoint() string {
+	return "kms"
+}
+
+func (s *kmsService) signingName() string {
+	return "kms"
+}
+
+func (s *kmsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.1", s.operations, nil)
+}
+
+// kmsDefaultKeyPolicy is the key policy applied when CreateKey doesn't specify one.
+const kmsDefaultKeyPolicy = `{"Version":"2012-10-17","Id":"key-default-1","Statement":[{"Sid":"Enable IAM User Permissions","Effect":"Allow","Principal":{"AWS":"arn:` + Partition + `:iam::` + AccountID + `:root"},"Action":"kms:*","Resource":"*"}]}`
+
+// findKey returns the key identified by key ID, key ARN, alias name or alias ARN.
+func (s *kmsService) findKey(id string) (*kmsKey, error) {
+	if strings.HasPrefix(id, "arn:") {
+		if _, resource, ok := strings.Cut(id, ":key/"); ok {
+			id = resource
+		} else if i := strings.Index(id, ":alias/"); i >= 0 {
+			id = id[i+1:]
+		}
+	}
+
+	if strings.HasPrefix(id, "alias/") {
+		alias, ok := s.aliases[id]
+		if !ok {
+			return nil, errBadRequest("NotFoundException", "Alias %s is not found.", id)
+		}
+		id = alias.keyID
+	}
+
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, errBadRequest("NotFoundException", "Key '%s' does not exist", id)
+	}
+
+	return key, nil
+}
+
+// findModifiableKey returns the specified key, failing if it's pending deletion.
+func (s *kmsService) findModifiableKey(id string) (*kmsKey, error) {
+	key, err := s.findKey(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.state == kmsKeyStatePendingDeletion {
+		return nil, errBadRequest("KMSInvalidStateException", "%s is pending deletion.", key.arn)
+	}
+
+	return key, nil
+}
+
+func (key *kmsKey) metadata() jsonObject {
+	v := jsonObject{
+		"AWSAccountId":          AccountID,
+		"Arn":                   key.arn,
+		"CreationDate":          epochSeconds(key.created),
+		"CustomerMasterKeySpec": key.keySpec,
+		"Description":           key.description,
+		"Enabled":               key.state == kmsKeyStateEnabled,
+		"KeyId":                 key.id,
+		"KeyManager":            "CUSTOMER",
+		"KeySpec":               key.keySpec,
+		"KeyState":              key.state,
+		"KeyUsage":              key.keyUsage,
+		"MultiRegion":           key.multiRegion,
+		"Origin":                "AWS_KMS",
+	}
+
+	if key.keySpec == "SYMMETRIC_DEFAULT" {
+		v["EncryptionAlgorithms"] = []string{"SYMMETRIC_DEFAULT"}
+	}
+	if key.multiRegion {
+		v["MultiRegionConfiguration"] = jsonObject{
+			"MultiRegionKeyType": "PRIMARY",
+			"PrimaryKey": jsonObject{
+				"Arn":    key.arn,
+				"Region": strings.Split(key.arn, ":")[3],
+			},
+			"ReplicaKeys": []any{},
+		}
+	}
+	if !key.deletionDate.IsZero() {
+		v["DeletionDate"] = epochSeconds(key.deletionDate)
+	}
+
+	return v
+}
+
+func (s *kmsService) createKey(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	if v := input.string("Origin"); v != "" && v != "AWS_KMS" {
+		return nil, unsupportedOperation("CreateKey with Origin " + v)
+	}
+
+	id := newID()
+	if input.bool("MultiRegion") {
+		id = "mrk-" + strings.ReplaceAll(id, "-", "")
+	}
+
+	key := &kmsKey{
+		arn:         arnString("kms", regionFrom(ctx), "key/"+id),
+		created:     time.Now(),
+		description: input.string("Description"),
+		id:          id,
+		keySpec:     "SYMMETRIC_DEFAULT",
+		keyUsage:    "ENCRYPT_DECRYPT",
+		multiRegion: input.bool("MultiRegion"),
+		policy:      kmsDefaultKeyPolicy,
+		state:       kmsKeyStateEnabled,
+		tags:        input.tagList("Tags", "TagKey", "TagValue"),
+	}
+
+	if v := input.string("KeySpec"); v != "" {
+		key.keySpec = v
+	} else if v := input.string("CustomerMasterKeySpec"); v != "" {
+		key.keySpec = v
+	}
+	if v := input.string("KeyUsage"); v != "" {
+		key.keyUsage = v
+	}
+	if v := input.string("Policy"); v != "" {
+		key.policy = v
+	}
+
+	s.keys[id] = key
+
+	return jsonObject{"KeyMetadata": key.metadata()}, nil
+}
+
+func (s *kmsService) describeKey(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"KeyMetadata": key.metadata()}, nil
+}
+
+func (s *kmsService) listKeys(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	page, next, err := paginate(sortedKeys(s.keys), input.string("Marker"), input.int("Limit", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []any{}
+	for _, id := range page {
+		keys = append(keys, jsonObject{
+			"KeyArn": s.keys[id].arn,
+			"KeyId":  id,
+		})
+	}
+
+	output := jsonObject{
+		"Keys":      keys,
+		"Truncated": next != "",
+	}
+	if next != "" {
+		output["NextMarker"] = next
+	}
+
+	return output, nil
+}
+
+func (s *kmsService) enableKey(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	key.state = kmsKeyStateEnabled
+
+	return nil, nil
+}
+
+func (s *kmsService) disableKey(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	key.state = kmsKeyStateDisabled
+
+	return nil, nil
+}
+
+func (s *kmsService) enableKeyRotation(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if key.state != kmsKeyStateEnabled {
+		return nil, errBadRequest("DisabledException", "%s is disabled.", key.arn)
+	}
+	if key.keySpec != "SYMMETRIC_DEFAULT" {
+		return nil, errBadRequest("UnsupportedOperationException", "%s key spec doesn't support automatic rotation.", key.keySpec)
+	}
+
+	key.rotation = true
+	key.rotationPeriod = input.int("RotationPeriodInDays", 365)
+
+	return nil, nil
+}
+
+func (s *kmsService) disableKeyRotation(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	key.rotation = false
+	key.rotationPeriod = 0
+
+	return nil, nil
+}
+
+func (s *kmsService) getKeyRotationStatus(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	output := jsonObject{
+		"KeyId":              key.arn,
+		"KeyRotationEnabled": key.rotation,
+	}
+	if key.rotation {
+		output["RotationPeriodInDays"] = key.rotationPeriod
+	}
+
+	return output, nil
+}
+
+func (s *kmsService) getKeyPolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.string("PolicyName"); v != "" && v != kmsPolicyNameDefault {
+		return nil, errBadRequest("NotFoundException", "Policy %s does not exist.", v)
+	}
+
+	return jsonObject{
+		"Policy":     key.policy,
+		"PolicyName": kmsPolicyNameDefault,
+	}, nil
+}
+
+func (s *kmsService) putKeyPolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if v := input.string("PolicyName"); v != "" && v != kmsPolicyNameDefault {
+		return nil, errBadRequest("ValidationException", "Policy name %s is not valid.", v)
+	}
+
+	key.policy = input.string("Policy")
+
+	return nil, nil
+}
+
+func (s *kmsService) listKeyPolicies(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	if _, err := s.findKey(input.string("KeyId")); err != nil {
+		return nil, err
+	}
+
+	return jsonObject{
+		"PolicyNames": []string{kmsPolicyNameDefault},
+		"Truncated":   false,
+	}, nil
+}
+
+func (s *kmsService) updateKeyDescription(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	key.description = input.string("Description")
+
+	return nil, nil
+}
+
+func (s *kmsService) scheduleKeyDeletion(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	days := input.int("PendingWindowInDays", 30)
+	if days < 7 || days > 30 {
+		return nil, errBadRequest("ValidationException", "1 validation error detected: Value '%d' at 'pendingWindowInDays' failed to satisfy constraint: Member must have value between 7 and 30", days)
+	}
+
+	key.deletionDate = time.Now().AddDate(0, 0, days)
+	key.state = kmsKeyStatePendingDeletion
+
+	return jsonObject{
+		"DeletionDate":        epochSeconds(key.deletionDate),
+		"KeyId":               key.arn,
+		"KeyState":            key.state,
+		"PendingWindowInDays": days,
+	}, nil
+}
+
+func (s *kmsService) cancelKeyDeletion(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if key.state != kmsKeyStatePendingDeletion {
+		return nil, errBadRequest("KMSInvalidStateException", "%s is not pending deletion.", key.arn)
+	}
+
+	key.deletionDate = time.Time{}
+	key.state = kmsKeyStateDisabled
+
+	return jsonObject{"KeyId": key.arn}, nil
+}
+
+func (s *kmsService) tagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(key.tags, input.tagList("Tags", "TagKey", "TagValue"))
+
+	return nil, nil
+}
+
+func (s *kmsService) untagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findModifiableKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(key.tags, input.strings("TagKeys"))
+
+	return nil, nil
+}
+
+func (s *kmsService) listResourceTags(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	key, err := s.findKey(input.string("KeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{
+		"Tags":      jsonTags(key.tags, "TagKey", "TagValue"),
+		"Truncated": false,
+	}, nil
+}
+
+func (s *kmsService) createAlias(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("AliasName")
+	if !strings.HasPrefix(name, "alias/") || strings.HasPrefix(name, "alias/aws/") {
+		return nil, errBadRequest("ValidationException", "Alias must start with the prefix \"alias/\" and must not begin with \"alias/aws/\".")
+	}
+	if _, ok := s.aliases[name]; ok {
+		return nil, errBadRequest("AlreadyExistsException", "An alias with the name %s already exists", name)
+	}
+
+	key, err := s.findModifiableKey(input.string("TargetKeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	s.aliases[name] = &kmsAlias{
+		arn:      arnString("kms", regionFrom(ctx), name),
+		created:  time.Now(),
+		keyID:    key.id,
+		modified: time.Now(),
+		name:     name,
+	}
+
+	return nil, nil
+}
+
+func (s *kmsService) updateAlias(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	alias, ok := s.aliases[input.string("AliasName")]
+	if !ok {
+		return nil, errBadRequest("NotFoundException", "Alias %s is not found.", input.string("AliasName"))
+	}
+
+	key, err := s.findModifiableKey(input.string("TargetKeyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	alias.keyID = key.id
+	alias.modified = time.Now()
+
+	return nil, nil
+}
+
+func (s *kmsService) deleteAlias(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("AliasName")
+	if _, ok := s.aliases[name]; !ok {
+		return nil, errBadRequest("NotFoundException", "Alias %s is not found.", name)
+	}
+
+	delete(s.aliases, name)
+
+	return nil, nil
+}
+
+func (s *kmsService) listAliases(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	var keyID string
+	if v := input.string("KeyId"); v != "" {
+		key, err := s.findKey(v)
+		if err != nil {
+			return nil, err
+		}
+		keyID = key.id
+	}
+
+	var names []string
+	for _, name := range sortedKeys(s.aliases) {
+		if keyID == "" || s.aliases[name].keyID == keyID {
+			names = append(names, name)
+		}
+	}
+
+	page, next, err := paginate(names, input.string("Marker"), input.int("Limit", 50))
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := []any{}
+	for _, name := range page {
+		alias := s.aliases[name]
+		aliases = append(aliases, jsonObject{
+			"AliasArn":        alias.arn,
+			"AliasName":       alias.name,
+			"CreationDate":    epochSeconds(alias.created),
+			"LastUpdatedDate": epochSeconds(alias.modified),
+			"TargetKeyId":     alias.keyID,
+		})
+	}
+
+	output := jsonObject{
+		"Aliases":   aliases,
+		"Truncated": next != "",
+	}
+	if next != "" {
+		output["NextMarker"] = next
+	}
+
+	return output, nil
+}
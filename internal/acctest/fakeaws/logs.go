@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// logsService implements Amazon CloudWatch Logs log groups and log streams.
+type logsService struct {
+	store
+	groups     map[string]*logsGroup // Keyed by name.
+	operations map[string]jsonOperation
+}
+
+type logsGroup struct {
+	arn       string // Without the trailing ":*".
+	class     string
+	created   time.Time
+	kmsKeyID  string
+	name      string
+	retention int
+	streams   map[string]time.Time // Creation time keyed by stream name.
+	tags      map[string]string
+}
+
+func newLogsService() *logsService {
+	s := &logsService{
+		groups: make(map[string]*logsGroup),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"AssociateKmsKey":       s.associateKMSKey,
+		"CreateLogGroup":        s.createLogGroup,
+		"CreateLogStream":       s.createLogStream,
+		"DeleteLogGroup":        s.deleteLogGroup,
+		"DeleteLogStream":       s.deleteLogStream,
+		"DeleteRetentionPolicy": s.deleteRetentionPolicy,
+		"DescribeLogGroups":     s.describeLogGroups,
+		"DescribeLogStreams":    s.describeLogStreams,
+		"DisassociateKmsKey":    s.disassociateKMSKey,
+		"ListTagsForResource":   s.listTagsForResource,
+		"PutRetentionPolicy":    s.putRetentionPolicy,
+		"TagResource":           s.tagResource,
+		"UntagResource":         s.untagResource,
+	}
+
+	return s
+}
+
+func (s *logsService) endpoint() string {
+	return "logs"
+}
+
+func (s *logsService) signingName() string {
+	return "logs"
+}
+
+func (s *logsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.1", s.operations, nil)
+}
+
+func (s *logsService) findGroup(name string) (*logsGroup, error) {
+	group, ok := s.groups[name]
+	if !ok {
+		return nil, errBadRequest("ResourceNotFoundException", "The specified log group does not exist.")
+	}
+
+	return group, nil
+}
+
+// findGroupByARN returns the log group with the specified ARN, with or without the trailing ":*".
+func (s *logsService) findGroupByARN(arn string) (*logsGroup, error) {
+	arn = strings.TrimSuffix(arn, ":*")
+
+	for _, group := range s.groups {
+		if group.arn == arn {
+			return group, nil
+		}
+	}
+
+	return nil, errBadRequest("ResourceNotFoundException", "The specified resource does not exist.")
+}
+
+func (s *logsService) createLogGroup(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("logGroupName")
+	if _, ok := s.groups[name]; ok {
+		return nil, errBadRequest("ResourceAlreadyExistsException", "The specified log group already exists")
+	}
+
+	group := &logsGroup{
+		arn:      arnString("logs", regionFrom(ctx), "log-group:"+name),
+		class:    "STANDARD",
+		created:  time.Now(),
+		kmsKeyID: input.string("kmsKeyId"),
+		name:     name,
+		streams:  make(map[string]time.Time),
+		tags:     input.stringMap("tags"),
+	}
+
+	if v := input.string("logGroupClass"); v != "" {
+		group.class = v
+	}
+
+	s.groups[name] = group
+
+	return nil, nil
+}
+
+func (s *logsService) describeLogGroups(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	var names []string
+	for _, name := range sortedKeys(s.groups) {
+		if strings.HasPrefix(name, input.string("logGroupNamePrefix")) {
+			names = append(names, name)
+		}
+	}
+
+	page, next, err := paginate(names, input.string("nextToken"), input.int("limit", 50))
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []any{}
+	for _, name := range page {
+		group := s.groups[name]
+		v := jsonObject{
+			"arn":           group.arn + ":*",
+			"creationTime":  group.created.UnixMilli(),
+			"logGroupArn":   group.arn,
+			"logGroupClass": group.class,
+			"logGroupName":  group.name,
+			"storedBytes":   0,
+		}
+		if group.kmsKeyID != "" {
+			v["kmsKeyId"] = group.kmsKeyID
+		}
+		if group.retention > 0 {
+			v["retentionInDays"] = group.retention
+		}
+		groups = append(groups, v)
+	}
+
+	output := jsonObject{"logGroups": groups}
+	if next != "" {
+		output["nextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *logsService) deleteLogGroup(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.groups, group.name)
+
+	return nil, nil
+}
+
+func (s *logsService) putRetentionPolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	group.retention = input.int("retentionInDays", 0)
+
+	return nil, nil
+}
+
+func (s *logsService) deleteRetentionPolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	group.retention = 0
+
+	return nil, nil
+}
+
+func (s *logsService) associateKMSKey(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	group.kmsKeyID = input.string("kmsKeyId")
+
+	return nil, nil
+}
+
+func (s *logsService) disassociateKMSKey(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	group.kmsKeyID = ""
+
+	return nil, nil
+}
+
+func (s *logsService) createLogStream(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.string("logStreamName")
+	if _, ok := group.streams[name]; ok {
+		return nil, errBadRequest("ResourceAlreadyExistsException", "The specified log stream already exists")
+	}
+
+	group.streams[name] = time.Now()
+
+	return nil, nil
+}
+
+func (s *logsService) describeLogStreams(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, name := range sortedKeys(group.streams) {
+		if strings.HasPrefix(name, input.string("logStreamNamePrefix")) {
+			names = append(names, name)
+		}
+	}
+
+	page, next, err := paginate(names, input.string("nextToken"), input.int("limit", 50))
+	if err != nil {
+		return nil, err
+	}
+
+	streams := []any{}
+	for _, name := range page {
+		streams = append(streams, jsonObject{
+			"arn":           group.arn + ":log-stream:" + name,
+			"creationTime":  group.streams[name].UnixMilli(),
+			"logStreamName": name,
+		})
+	}
+
+	output := jsonObject{"logStreams": streams}
+	if next != "" {
+		output["nextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *logsService) deleteLogStream(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroup(input.string("logGroupName"))
+	if err != nil {
+		return nil, err
+	}
+
+	name := input.string("logStreamName")
+	if _, ok := group.streams[name]; !ok {
+		return nil, errBadRequest("ResourceNotFoundException", "The specified log stream does not exist.")
+	}
+
+	delete(group.streams, name)
+
+	return nil, nil
+}
+
+func (s *logsService) tagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroupByARN(input.string("resourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(group.tags, input.stringMap("tags"))
+
+	return nil, nil
+}
+
+func (s *logsService) untagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroupByARN(input.string("resourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(group.tags, input.strings("tagKeys"))
+
+	return nil, nil
+}
+
+func (s *logsService) listTagsForResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	group, err := s.findGroupByARN(input.string("resourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"tags": group.tags}, nil
+}
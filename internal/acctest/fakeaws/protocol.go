@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+type regionKey struct{}
+
+func withRegion(ctx context.Context, region string) context.Context {
+	return context.WithValue(ctx, regionKey{}, region)
+}
+
+// regionFrom returns the Region of the request being handled.
+func regionFrom(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey{}).(string)
+
+	return v
+}
+
+// apiError is an AWS API error response.
+type apiError struct {
+	code       string
+	message    string
+	statusCode int
+}
+
+func (e *apiError) Error() string {
+	return e.code + ": " + e.message
+}
+
+func newAPIError(statusCode int, code, format string, a ...any) *apiError {
+	return &apiError{
+		code:       code,
+		message:    fmt.Sprintf(format, a...),
+		statusCode: statusCode,
+	}
+}
+
+func errNotFound(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusNotFound, code, format, a...)
+}
+
+func errBadRequest(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusBadRequest, code, format, a...)
+}
+
+func errConflict(code, format string, a ...any) *apiError {
+	return newAPIError(http.StatusConflict, code, format, a...)
+}
+
+// unsupportedOperation is returned for operations that the fake services don't implement.
+func unsupportedOperation(operation string) *apiError {
+	return newAPIError(http.StatusNotImplemented, "NotImplemented", "fake AWS operation not implemented: %s", operation)
+}
+
+func requestID() string {
+	v, _ := uuid.GenerateUUID()
+
+	return v
+}
+
+// newID returns a new random identifier in UUID form.
+func newID() string {
+	v, _ := uuid.GenerateUUID()
+
+	return v
+}
+
+func arnString(service, region, resource string) string {
+	accountID := AccountID
+	if service == "s3" {
+		accountID = ""
+	}
+
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", Partition, service, region, accountID, resource)
+}
+
+// jsonOperation handles an operation of a service using the AWS JSON 1.0 or 1.1 protocol.
+type jsonOperation func(ctx context.Context, input jsonObject) (any, error)
+
+// serveJSON serves a request for a service using an AWS JSON protocol.
+// Operations are looked up by the X-Amz-Target header's operation name.
+func serveJSON(w http.ResponseWriter, r *http.Request, contentType string, operations map[string]jsonOperation, errorHeaders func(*apiError) http.Header) {
+	_, name, _ := strings.Cut(r.Header.Get("X-Amz-Target"), ".")
+
+	var input jsonObject
+	if b, err := io.ReadAll(r.Body); err != nil {
+		writeJSONError(w, contentType, errBadRequest("SerializationException", "%s", err), errorHeaders)
+		return
+	} else if len(b) > 0 {
+		if err := json.Unmarshal(b, &input); err != nil {
+			writeJSONError(w, contentType, errBadRequest("SerializationException", "%s", err), errorHeaders)
+			return
+		}
+	}
+	if input == nil {
+		input = make(jsonObject)
+	}
+
+	operation, ok := operations[name]
+	if !ok {
+		writeJSONError(w, contentType, unsupportedOperation(name), errorHeaders)
+		return
+	}
+
+	output, err := operation(r.Context(), input)
+	if err != nil {
+		writeJSONError(w, contentType, err, errorHeaders)
+		return
+	}
+	if output == nil {
+		output = jsonObject{}
+	}
+
+	b, err := json.Marshal(output)
+	if err != nil {
+		writeJSONError(w, contentType, err, errorHeaders)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-RequestId", requestID())
+	w.Write(b) //nolint:errcheck // The response has been committed.
+}
+
+func writeJSONError(w http.ResponseWriter, contentType string, err error, errorHeaders func(*apiError) http.Header) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+	}
+	if apiErr.statusCode == http.StatusNotFound {
+		// AWS JSON protocols report client errors with 400 Bad Request.
+		apiErr.statusCode = http.StatusBadRequest
+	}
+
+	if errorHeaders != nil {
+		for k, v := range errorHeaders(apiErr) {
+			w.Header()[k] = v
+		}
+	}
+
+	b, _ := json.Marshal(jsonObject{
+		"__type":  apiErr.code,
+		"message": apiErr.message,
+	})
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("X-Amzn-RequestId", requestID())
+	w.WriteHeader(apiErr.statusCode)
+	w.Write(b) //nolint:errcheck // The response has been committed.
+}
+
+// jsonObject is a decoded JSON request object.
+type jsonObject map[string]any
+
+func (o jsonObject) string(k string) string {
+	v, _ := o[k].(string)
+
+	return v
+}
+
+func (o jsonObject) bool(k string) bool {
+	v, _ := o[k].(bool)
+
+	return v
+}
+
+func (o jsonObject) int(k string, defaultValue int) int {
+	if v, ok := o[k].(float64); ok {
+		return int(v)
+	}
+
+	return defaultValue
+}
+
+func (o jsonObject) object(k string) jsonObject {
+	v, _ := o[k].(map[string]any)
+
+	return v
+}
+
+func (o jsonObject) list(k string) []any {
+	v, _ := o[k].([]any)
+
+	return v
+}
+
+func (o jsonObject) strings(k string) []string {
+	var s []string
+
+	for _, v := range o.list(k) {
+		if v, ok := v.(string); ok {
+			s = append(s, v)
+		}
+	}
+
+	return s
+}
+
+// stringMap returns a JSON object of strings, e.g. SQS queue attributes.
+func (o jsonObject) stringMap(k string) map[string]string {
+	m := make(map[string]string)
+
+	for k, v := range o.object(k) {
+		if v, ok := v.(string); ok {
+			m[k] = v
+		}
+	}
+
+	return m
+}
+
+// tagList returns a list of tags with the specified key and value field names.
+func (o jsonObject) tagList(k, keyField, valueField string) map[string]string {
+	tags := make(map[string]string)
+
+	for _, v := range o.list(k) {
+		if v, ok := v.(map[string]any); ok {
+			tags[jsonObject(v).string(keyField)] = jsonObject(v).string(valueField)
+		}
+	}
+
+	return tags
+}
+
+func epochSeconds(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// queryOperation handles an operation of a service using the AWS Query protocol.
+type queryOperation func(ctx context.Context, input url.Values) (any, error)
+
+// serveQuery serves a request for a service using the AWS Query protocol.
+// Results are encoded using encodeXML within the standard response wrapper.
+func serveQuery(w http.ResponseWriter, r *http.Request, xmlns string, operations map[string]queryOperation) {
+	if err := r.ParseForm(); err != nil {
+		writeQueryError(w, xmlns, errBadRequest("MalformedQueryString", "%s", err))
+		return
+	}
+
+	name := r.Form.Get("Action")
+	operation, ok := operations[name]
+	if !ok {
+		writeQueryError(w, xmlns, unsupportedOperation(name))
+		return
+	}
+
+	output, err := operation(r.Context(), r.Form)
+	if err != nil {
+		writeQueryError(w, xmlns, err)
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, `<%sResponse xmlns="%s">`, name, xmlns)
+	if output != nil {
+		encodeXML(&sb, name+"Result", output)
+	}
+	fmt.Fprintf(&sb, `<ResponseMetadata><RequestId>%s</RequestId></ResponseMetadata></%sResponse>`, requestID(), name)
+
+	w.Header().Set("Content-Type", "text/xml")
+	io.WriteString(w, sb.String()) //nolint:errcheck // The response has been committed.
+}
+
+func writeQueryError(w http.ResponseWriter, xmlns string, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalFailure", "%s", err)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	fmt.Fprintf(&sb, `<ErrorResponse xmlns="%s"><Error><Type>Sender</Type>`, xmlns)
+	encodeXML(&sb, "Code", apiErr.code)
+	encodeXML(&sb, "Message", apiErr.message)
+	fmt.Fprintf(&sb, `</Error><RequestId>%s</RequestId></ErrorResponse>`, requestID())
+
+	w.Header().Set("Content-Type", "text/xml")
+	w.WriteHeader(apiErr.statusCode)
+	io.WriteString(w, sb.String()) //nolint:errcheck // The response has been committed.
+}
+
+// queryList returns the structures of a Query protocol list, e.g. "Tags.member.1.Key", "Tags.member.1.Value", ...
+func queryList(values url.Values, prefix string) []map[string]string {
+	var list []map[string]string
+
+	for i := 1; ; i++ {
+		p := prefix + "." + strconv.Itoa(i)
+		item := make(map[string]string)
+
+		for k, v := range values {
+			if k == p {
+				item[""] = v[0]
+			} else if rest, ok := strings.CutPrefix(k, p+"."); ok {
+				item[rest] = v[0]
+			}
+		}
+
+		if len(item) == 0 {
+			return list
+		}
+
+		list = append(list, item)
+	}
+}
+
+// queryStrings returns the values of a Query protocol list of strings, e.g. "TagKeys.member.1", ...
+func queryStrings(values url.Values, prefix string) []string {
+	var s []string
+
+	for _, v := range queryList(values, prefix) {
+		s = append(s, v[""])
+	}
+
+	return s
+}
+
+// queryMap returns a Query protocol map, e.g. "Attributes.entry.1.key", "Attributes.entry.1.value", ...
+func queryMap(values url.Values, prefix string) map[string]string {
+	m := make(map[string]string)
+
+	for _, v := range queryList(values, prefix) {
+		m[v["key"]] = v["value"]
+	}
+
+	return m
+}
+
+// queryTags returns the tags in a Query protocol list of Key/Value structures.
+func queryTags(values url.Values, prefix string) map[string]string {
+	tags := make(map[string]string)
+
+	for _, v := range queryList(values, prefix) {
+		tags[v["Key"]] = v["Value"]
+	}
+
+	return tags
+}
+
+func queryInt(values url.Values, k string, defaultValue int) int {
+	if v, err := strconv.Atoi(values.Get(k)); err == nil {
+		return v
+	}
+
+	return defaultValue
+}
+
+type (
+	// xmlObject is encoded as elements named by its keys, in key order.
+	xmlObject map[string]any
+	// xmlMembers is encoded as a Query protocol list of "member" elements.
+	xmlMembers []any
+	// xmlEntries is encoded as a Query protocol map of "entry" elements.
+	xmlEntries map[string]string
+	// xmlFlattened is encoded as repeated elements of the same name, e.g. the Contents of an S3 object listing.
+	xmlFlattened []any
+)
+
+// encodeXML writes the XML element with the specified name and value to sb.
+func encodeXML(sb *strings.Builder, name string, value any) {
+	switch value := value.(type) {
+	case nil:
+		return
+	case xmlObject:
+		sb.WriteString("<" + name + ">")
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			encodeXML(sb, k, value[k])
+		}
+		sb.WriteString("</" + name + ">")
+	case xmlMembers:
+		sb.WriteString("<" + name + ">")
+		for _, v := range value {
+			encodeXML(sb, "member", v)
+		}
+		sb.WriteString("</" + name + ">")
+	case xmlFlattened:
+		for _, v := range value {
+			encodeXML(sb, name, v)
+		}
+	case xmlEntries:
+		sb.WriteString("<" + name + ">")
+		keys := make([]string, 0, len(value))
+		for k := range value {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			sb.WriteString("<entry>")
+			encodeXML(sb, "key", k)
+			encodeXML(sb, "value", value[k])
+			sb.WriteString("</entry>")
+		}
+		sb.WriteString("</" + name + ">")
+	case time.Time:
+		encodeXML(sb, name, value.UTC().Format(time.RFC3339))
+	case bool:
+		encodeXML(sb, name, strconv.FormatBool(value))
+	case int:
+		encodeXML(sb, name, strconv.Itoa(value))
+	case string:
+		sb.WriteString("<" + name + ">")
+		xml.EscapeText(sb, []byte(value)) //nolint:errcheck // strings.Builder doesn't return errors.
+		sb.WriteString("</" + name + ">")
+	default:
+		encodeXML(sb, name, fmt.Sprint(value))
+	}
+}
+
+// xmlTags returns tags as a Query protocol list of Key/Value structures.
+func xmlTags(tags map[string]string) xmlMembers {
+	members := xmlMembers{}
+
+	for _, k := range sortedKeys(tags) {
+		members = append(members, xmlObject{"Key": k, "Value": tags[k]})
+	}
+
+	return members
+}
+
+// jsonTags returns tags as a JSON list of structures with the specified key and value field names.
+func jsonTags(tags map[string]string, keyField, valueField string) []any {
+	list := []any{}
+
+	for _, k := range sortedKeys(tags) {
+		list = append(list, map[string]any{keyField: k, valueField: tags[k]})
+	}
+
+	return list
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// paginate returns the page of items starting at the position encoded in token and the token for the next page, if any.
+// Items must be in a stable order, e.g. sorted by name.
+func paginate[T any](items []T, token string, limit int) ([]T, string, error) {
+	start := 0
+
+	if token != "" {
+		v, err := strconv.Atoi(token)
+		if err != nil || v < 0 || v > len(items) {
+			return nil, "", errBadRequest("InvalidNextToken", "invalid pagination token: %s", token)
+		}
+		start = v
+	}
+
+	end := len(items)
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+
+	var next string
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	}
+
+	return items[start:end], next, nil
+}
+
+// updateTags adds the specified tags, overwriting existing values.
+func updateTags(tags, add map[string]string) {
+	for k, v := range add {
+		tags[k] = v
+	}
+}
+
+// removeTags removes the specified tag keys.
+func removeTags(tags map[string]string, keys []string) {
+	for _, k := range keys {
+		delete(tags, k)
+	}
+}
@@ -0,0 +1,1244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5" // nosemgrep:ci.avoid-crypto-md5 // S3 ETags are MD5 digests.
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// s3Service implements Amazon S3 general purpose buckets and objects using path-style addressing.
+// Bucket configurations are stored as the XML documents sent by the client.
+type s3Service struct {
+	store
+	buckets map[string]*s3Bucket // Keyed by name.
+}
+
+type s3Bucket struct {
+	created      time.Time
+	name         string
+	objects      map[string][]*s3Object // Versions, oldest first, keyed by object key.
+	region       string
+	subresources map[string][]byte
+	uploads      map[string]*s3Upload // Keyed by upload ID.
+}
+
+type s3Object struct {
+	acl          []byte
+	body         []byte
+	checksums    http.Header // x-amz-checksum-* values.
+	deleteMarker bool
+	etag         string
+	headers      http.Header // Stored system and user-defined metadata.
+	key          string
+	modified     time.Time
+	tags         map[string]string
+	versionID    string
+}
+
+type s3Upload struct {
+	acl     []byte
+	headers http.Header
+	key     string
+	parts   map[int][]byte
+	tags    map[string]string
+}
+
+const (
+	s3CanonicalUserID   = "75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a"
+	s3DefaultRegion     = "us-east-1"
+	s3NullVersionID     = "null"
+	s3VersioningEnabled = "Enabled"
+)
+
+// s3BucketSubresources maps the bucket configuration subresources to the error returned when getting an unset configuration.
+// A nil error means that the default configuration is returned instead.
+var s3BucketSubresources = map[string]func() *apiError{
+	"accelerate": nil,
+	"acl":        nil,
+	"cors": func() *apiError {
+		return errNotFound("NoSuchCORSConfiguration", "The CORS configuration does not exist")
+	},
+	"encryption": nil,
+	"lifecycle": func() *apiError {
+		return errNotFound("NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist")
+	},
+	"logging":      nil,
+	"notification": nil,
+	"object-lock": func() *apiError {
+		return errNotFound("ObjectLockConfigurationNotFoundError", "Object Lock configuration does not exist for this bucket")
+	},
+	"ownershipControls": func() *apiError {
+		return errNotFound("OwnershipControlsNotFoundError", "The bucket ownership controls were not found")
+	},
+	"policy": func() *apiError {
+		return errNotFound("NoSuchBucketPolicy", "The bucket policy does not exist")
+	},
+	"publicAccessBlock": func() *apiError {
+		return errNotFound("NoSuchPublicAccessBlockConfiguration", "The public access block configuration was not found")
+	},
+	"replication": func() *apiError {
+		return errNotFound("ReplicationConfigurationNotFoundError", "The replication configuration was not found")
+	},
+	"requestPayment": nil,
+	"tagging": func() *apiError {
+		return errNotFound("NoSuchTagSet", "The TagSet does not exist")
+	},
+	"versioning": nil,
+	"website": func() *apiError {
+		return errNotFound("NoSuchWebsiteConfiguration", "The specified bucket does not have a website configuration")
+	},
+}
+
+// s3DefaultBucketSubresources are the configurations of a new bucket.
+var s3DefaultBucketSubresources = map[string]string{
+	"accelerate":        `<AccelerateConfiguration/>`,
+	"encryption":        `<ServerSideEncryptionConfiguration><Rule><ApplyServerSideEncryptionByDefault><SSEAlgorithm>AES256</SSEAlgorithm></ApplyServerSideEncryptionByDefault><BucketKeyEnabled>false</BucketKeyEnabled></Rule></ServerSideEncryptionConfiguration>`,
+	"logging":           `<BucketLoggingStatus/>`,
+	"notification":      `<NotificationConfiguration/>`,
+	"ownershipControls": `<OwnershipControls><Rule><ObjectOwnership>BucketOwnerEnforced</ObjectOwnership></Rule></OwnershipControls>`,
+	"publicAccessBlock": `<PublicAccessBlockConfiguration><BlockPublicAcls>true</BlockPublicAcls><IgnorePublicAcls>true</IgnorePublicAcls><BlockPublicPolicy>true</BlockPublicPolicy><RestrictPublicBuckets>true</RestrictPublicBuckets></PublicAccessBlockConfiguration>`,
+	"requestPayment":    `<RequestPaymentConfiguration><Payer>BucketOwner</Payer></RequestPaymentConfiguration>`,
+	"versioning":        `<VersioningConfiguration/>`,
+}
+
+// s3ObjectHeaders are the request headers stored with an object and returned by GetObject and HeadObject.
+// User-defined metadata (x-amz-meta-*) is also stored.
+var s3ObjectHeaders = []string{
+	"Cache-Control",
+	"Content-Disposition",
+	"Content-Encoding",
+	"Content-Language",
+	"Content-Type",
+	"Expires",
+	"X-Amz-Server-Side-Encryption",
+	"X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id",
+	"X-Amz-Server-Side-Encryption-Bucket-Key-Enabled",
+	"X-Amz-Storage-Class",
+	"X-Amz-Website-Redirect-Location",
+}
+
+func newS3Service() *s3Service {
+	return &s3Service{
+		buckets: make(map[string]*s3Bucket),
+	}
+}
+
+func (s *s3Service) endpoint() string {
+	return "s3"
+}
+
+func (s *s3Service) signingName() string {
+	return "s3"
+}
+
+func (s *s3Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer s.lock()()
+
+	w.Header().Set("X-Amz-Request-Id", requestID())
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	query.Del("x-id")
+
+	var err error
+	switch {
+	case bucket == "":
+		err = s.listBuckets(w, r)
+	case key == "":
+		err = s.serveBucket(w, r, bucket, query)
+	default:
+		err = s.serveObject(w, r, bucket, key, query)
+	}
+
+	if err != nil {
+		writeS3Error(w, r, err)
+	}
+}
+
+func writeS3Error(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr, ok := err.(*apiError)
+	if !ok {
+		apiErr = newAPIError(http.StatusInternalServerError, "InternalError", "%s", err)
+	}
+
+	if r.Method == http.MethodHead {
+		// HEAD responses have no body.
+		w.WriteHeader(apiErr.statusCode)
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	encodeXML(&sb, "Error", xmlObject{
+		"Code":      apiErr.code,
+		"Message":   apiErr.message,
+		"RequestId": w.Header().Get("X-Amz-Request-Id"),
+	})
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(apiErr.statusCode)
+	io.WriteString(w, sb.String()) //nolint:errcheck // The response has been committed.
+}
+
+func writeS3XML(w http.ResponseWriter, name string, value any) {
+	var sb strings.Builder
+	sb.WriteString(xml.Header)
+	encodeXML(&sb, name, value)
+
+	w.Header().Set("Content-Type", "application/xml")
+	io.WriteString(w, sb.String()) //nolint:errcheck // The response has been committed.
+}
+
+func s3Owner() xmlObject {
+	return xmlObject{
+		"DisplayName": "fake-aws",
+		"ID":          s3CanonicalUserID,
+	}
+}
+
+// s3CannedACL returns the access control policy document for a canned ACL.
+func s3CannedACL(acl string) []byte {
+	const (
+		allUsers           = "http://acs.amazonaws.com/groups/global/AllUsers"
+		authenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+		logDelivery        = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+	)
+
+	var sb strings.Builder
+	grant := func(grantee, permission string) {
+		sb.WriteString(`<Grant><Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" `)
+		if grantee == s3CanonicalUserID {
+			fmt.Fprintf(&sb, `xsi:type="CanonicalUser"><ID>%s</ID><DisplayName>fake-aws</DisplayName>`, grantee)
+		} else {
+			fmt.Fprintf(&sb, `xsi:type="Group"><URI>%s</URI>`, grantee)
+		}
+		fmt.Fprintf(&sb, `</Grantee><Permission>%s</Permission></Grant>`, permission)
+	}
+
+	fmt.Fprintf(&sb, `<AccessControlPolicy><Owner><ID>%s</ID><DisplayName>fake-aws</DisplayName></Owner><AccessControlList>`, s3CanonicalUserID)
+	grant(s3CanonicalUserID, "FULL_CONTROL")
+	switch acl {
+	case "authenticated-read":
+		grant(authenticatedUsers, "READ")
+	case "log-delivery-write":
+		grant(logDelivery, "WRITE")
+		grant(logDelivery, "READ_ACP")
+	case "public-read":
+		grant(allUsers, "READ")
+	case "public-read-write":
+		grant(allUsers, "READ")
+		grant(allUsers, "WRITE")
+	}
+	sb.WriteString(`</AccessControlList></AccessControlPolicy>`)
+
+	return []byte(sb.String())
+}
+
+// readS3Body returns the request's payload and any x-amz-checksum-* values sent as headers or trailers.
+func readS3Body(r *http.Request) ([]byte, http.Header, error) {
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	trailers := make(http.Header)
+	if strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		b, trailers, err = decodeAWSChunked(b)
+		if err != nil {
+			return nil, nil, errBadRequest("IncompleteBody", "%s", err)
+		}
+	}
+
+	checksums := make(http.Header)
+	for _, h := range []http.Header{r.Header, trailers} {
+		for k, v := range h {
+			if strings.HasPrefix(k, "X-Amz-Checksum-") && k != "X-Amz-Checksum-Algorithm" {
+				checksums[k] = v
+			}
+		}
+	}
+
+	return b, checksums, nil
+}
+
+// decodeAWSChunked decodes an aws-chunked encoded payload, returning the data and any trailing headers.
+func decodeAWSChunked(b []byte) ([]byte, http.Header, error) {
+	var data []byte
+	br := bufio.NewReader(bytes.NewReader(b))
+
+	for {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil, nil, err
+		}
+
+		v, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(v, 16, 64)
+		if err != nil {
+			return nil, nil, err
+		}
+		if size == 0 {
+			break
+		}
+
+		chunk := make([]byte, size)
+		if _, err := io.ReadFull(br, chunk); err != nil {
+			return nil, nil, err
+		}
+		data = append(data, chunk...)
+
+		if _, err := br.ReadString('\n'); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	trailers := make(http.Header)
+	for {
+		line, err := br.ReadString('\n')
+		if k, v, ok := strings.Cut(strings.TrimSpace(line), ":"); ok {
+			trailers.Add(k, v)
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	return data, trailers, nil
+}
+
+// s3ObjectRequestHeaders returns the headers of a PutObject, CreateMultipartUpload or CopyObject request to store with an object.
+func s3ObjectRequestHeaders(r *http.Request) http.Header {
+	headers := make(http.Header)
+
+	for k, v := range r.Header {
+		if slices.Contains(s3ObjectHeaders, k) || strings.HasPrefix(k, "X-Amz-Meta-") {
+			headers[k] = v
+		}
+	}
+
+	if v := headers.Get("Content-Encoding"); strings.Contains(v, "aws-chunked") {
+		v = strings.Trim(strings.ReplaceAll(strings.ReplaceAll(v, "aws-chunked", ""), ",,", ","), ", ")
+		if v == "" {
+			headers.Del("Content-Encoding")
+		} else {
+			headers.Set("Content-Encoding", v)
+		}
+	}
+	if headers.Get("Content-Type") == "" {
+		headers.Set("Content-Type", "binary/octet-stream")
+	}
+	if headers.Get("X-Amz-Server-Side-Encryption") == "" {
+		headers.Set("X-Amz-Server-Side-Encryption", "AES256")
+	}
+
+	return headers
+}
+
+// s3RequestTags returns the tags in a request's x-amz-tagging header.
+func s3RequestTags(r *http.Request) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	values, err := url.ParseQuery(r.Header.Get("X-Amz-Tagging"))
+	if err != nil {
+		return nil, errBadRequest("InvalidArgument", "The header 'x-amz-tagging' shall be encoded as UTF-8 then URLEncoded URL query parameters without tag name duplicates.")
+	}
+
+	for k := range values {
+		tags[k] = values.Get(k)
+	}
+
+	return tags, nil
+}
+
+type s3Tagging struct {
+	TagSet []struct {
+		Key   string
+		Value string
+	} `xml:"TagSet>Tag"`
+}
+
+func s3TaggingOutput(tags map[string]string) xmlObject {
+	var list xmlFlattened
+
+	for _, k := range sortedKeys(tags) {
+		list = append(list, xmlObject{"Key": k, "Value": tags[k]})
+	}
+
+	return xmlObject{"TagSet": xmlObject{"Tag": list}}
+}
+
+func s3ETag(b []byte) string {
+	v := md5.Sum(b) // nosemgrep:ci.avoid-crypto-md5
+
+	return `"` + hex.EncodeToString(v[:]) + `"`
+}
+
+func newS3VersionID() string {
+	return strings.ReplaceAll(newID(), "-", "")
+}
+
+func (s *s3Service) findBucket(name string) (*s3Bucket, error) {
+	bucket, ok := s.buckets[name]
+	if !ok {
+		return nil, errNotFound("NoSuchBucket", "The specified bucket does not exist")
+	}
+
+	return bucket, nil
+}
+
+func (bucket *s3Bucket) versioningStatus() string {
+	var config struct {
+		Status string
+	}
+	xml.Unmarshal(bucket.subresources["versioning"], &config) //nolint:errcheck // Stored configurations were validated when put.
+
+	return config.Status
+}
+
+// current returns the current version of the object, or nil if the object doesn't exist or is deleted.
+func (bucket *s3Bucket) current(key string) *s3Object {
+	versions := bucket.objects[key]
+	if len(versions) == 0 {
+		return nil
+	}
+
+	if v := versions[len(versions)-1]; !v.deleteMarker {
+		return v
+	}
+
+	return nil
+}
+
+func (bucket *s3Bucket) findObject(key, versionID string) (*s3Object, error) {
+	if versionID == "" {
+		if object := bucket.current(key); object != nil {
+			return object, nil
+		}
+
+		return nil, errNotFound("NoSuchKey", "The specified key does not exist.")
+	}
+
+	for _, object := range bucket.objects[key] {
+		if object.versionID == versionID && !object.deleteMarker {
+			return object, nil
+		}
+	}
+
+	return nil, errNotFound("NoSuchVersion", "The specified version does not exist.")
+}
+
+// putObject adds a new version of an object, replacing the "null" version unless versioning is enabled.
+func (bucket *s3Bucket) putObject(object *s3Object) {
+	object.modified = time.Now()
+	object.versionID = s3NullVersionID
+	if bucket.versioningStatus() == s3VersioningEnabled {
+		object.versionID = newS3VersionID()
+	}
+
+	versions := slices.DeleteFunc(bucket.objects[object.key], func(v *s3Object) bool {
+		return v.versionID == s3NullVersionID && object.versionID == s3NullVersionID
+	})
+	bucket.objects[object.key] = append(versions, object)
+}
+
+// deleteObject deletes an object version or, if no version is specified, adds a delete marker to versioned buckets.
+// It returns the version ID of the deleted version or new delete marker and whether that is a delete marker.
+func (bucket *s3Bucket) deleteObject(key, versionID string) (string, bool) {
+	versions := bucket.objects[key]
+	status := bucket.versioningStatus()
+
+	var deleteMarker bool
+	switch {
+	case versionID != "":
+		versions = slices.DeleteFunc(versions, func(v *s3Object) bool {
+			if v.versionID == versionID {
+				deleteMarker = v.deleteMarker
+				return true
+			}
+			return false
+		})
+	case status == "":
+		versions = nil
+	default:
+		if len(versions) == 0 {
+			return "", false
+		}
+
+		marker := &s3Object{
+			deleteMarker: true,
+			key:          key,
+		}
+		bucket.putObject(marker)
+		return marker.versionID, true
+	}
+
+	if len(versions) == 0 {
+		delete(bucket.objects, key)
+	} else {
+		bucket.objects[key] = versions
+	}
+
+	return versionID, deleteMarker
+}
+
+func (s *s3Service) listBuckets(w http.ResponseWriter, r *http.Request) error {
+	if r.Method != http.MethodGet {
+		return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+	}
+
+	region := r.URL.Query().Get("bucket-region")
+
+	var buckets xmlFlattened
+	for _, name := range sortedKeys(s.buckets) {
+		if region != "" && s.buckets[name].region != region {
+			continue
+		}
+
+		buckets = append(buckets, xmlObject{
+			"BucketRegion": s.buckets[name].region,
+			"CreationDate": s.buckets[name].created,
+			"Name":         name,
+		})
+	}
+
+	writeS3XML(w, "ListAllMyBucketsResult", xmlObject{
+		"Buckets": xmlObject{"Bucket": buckets},
+		"Owner":   s3Owner(),
+	})
+
+	return nil
+}
+
+func (s *s3Service) serveBucket(w http.ResponseWriter, r *http.Request, name string, query url.Values) error {
+	if r.Method == http.MethodPut && len(query) == 0 {
+		return s.createBucket(w, r, name)
+	}
+
+	bucket, err := s.findBucket(name)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Method == http.MethodHead:
+		w.Header().Set("X-Amz-Bucket-Region", bucket.region)
+		return nil
+	case r.Method == http.MethodDelete && len(query) == 0:
+		return s.deleteBucket(w, bucket)
+	case r.Method == http.MethodPost && query.Has("delete"):
+		return s.deleteObjects(w, r, bucket)
+	case r.Method == http.MethodGet && query.Has("location"):
+		location := bucket.region
+		if location == s3DefaultRegion {
+			location = ""
+		}
+		writeS3XML(w, "LocationConstraint", location)
+		return nil
+	case r.Method == http.MethodGet && query.Has("versions"):
+		return s.listObjectVersions(w, bucket, query)
+	}
+
+	for k, notFound := range s3BucketSubresources {
+		if query.Has(k) {
+			return s.serveBucketSubresource(w, r, bucket, k, notFound)
+		}
+	}
+
+	if r.Method == http.MethodGet && !slices.ContainsFunc([]string{"analytics", "intelligent-tiering", "inventory", "metrics", "uploads"}, query.Has) {
+		return s.listObjects(w, bucket, query, query.Get("list-type") == "2")
+	}
+
+	return unsupportedOperation(r.Method + " bucket " + r.URL.RawQuery)
+}
+
+func (s *s3Service) createBucket(w http.ResponseWriter, r *http.Request, name string) error {
+	if _, ok := s.buckets[name]; ok {
+		return errConflict("BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	var config struct {
+		LocationConstraint string
+	}
+	if b, err := io.ReadAll(r.Body); err != nil {
+		return err
+	} else if len(b) > 0 {
+		if err := xml.Unmarshal(b, &config); err != nil {
+			return errBadRequest("MalformedXML", "%s", err)
+		}
+	}
+
+	region := regionFrom(r.Context())
+	if config.LocationConstraint != "" && config.LocationConstraint != region {
+		return errBadRequest("IllegalLocationConstraintException", "The %s location constraint is incompatible for the region specific endpoint this request was sent to.", config.LocationConstraint)
+	}
+	if config.LocationConstraint == "" && region != s3DefaultRegion {
+		return errBadRequest("IllegalLocationConstraintException", "The unspecified location constraint is incompatible for the region specific endpoint this request was sent to.")
+	}
+
+	bucket := &s3Bucket{
+		created:      time.Now(),
+		name:         name,
+		objects:      make(map[string][]*s3Object),
+		region:       region,
+		subresources: make(map[string][]byte),
+		uploads:      make(map[string]*s3Upload),
+	}
+
+	for k, v := range s3DefaultBucketSubresources {
+		bucket.subresources[k] = []byte(v)
+	}
+	bucket.subresources["acl"] = s3CannedACL(r.Header.Get("X-Amz-Acl"))
+	if v := r.Header.Get("X-Amz-Object-Ownership"); v != "" {
+		bucket.subresources["ownershipControls"] = []byte(`<OwnershipControls><Rule><ObjectOwnership>` + v + `</ObjectOwnership></Rule></OwnershipControls>`)
+	}
+	if r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled") == "true" {
+		bucket.subresources["object-lock"] = []byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`)
+		bucket.subresources["versioning"] = []byte(`<VersioningConfiguration><Status>Enabled</Status></VersioningConfiguration>`)
+	}
+
+	s.buckets[name] = bucket
+
+	w.Header().Set("Location", "/"+name)
+
+	return nil
+}
+
+func (s *s3Service) deleteBucket(w http.ResponseWriter, bucket *s3Bucket) error {
+	if len(bucket.objects) > 0 {
+		return errConflict("BucketNotEmpty", "The bucket you tried to delete is not empty")
+	}
+
+	delete(s.buckets, bucket.name)
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return nil
+}
+
+func (s *s3Service) serveBucketSubresource(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, name string, notFound func() *apiError) error {
+	switch r.Method {
+	case http.MethodGet:
+		b, ok := bucket.subresources[name]
+		if !ok {
+			if notFound == nil {
+				return unsupportedOperation("GET bucket " + name)
+			}
+			return notFound()
+		}
+
+		if name == "policy" {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/xml")
+			io.WriteString(w, xml.Header) //nolint:errcheck // The response has been committed.
+		}
+		w.Write(b) //nolint:errcheck // The response has been committed.
+
+		return nil
+	case http.MethodPut:
+		b, _, err := readS3Body(r)
+		if err != nil {
+			return err
+		}
+
+		if name == "acl" && len(b) == 0 {
+			b = s3CannedACL(r.Header.Get("X-Amz-Acl"))
+		}
+		if name != "policy" {
+			if err := xml.Unmarshal(b, new(struct{})); err != nil {
+				return errBadRequest("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+			}
+		}
+
+		bucket.subresources[name] = b
+
+		if name == "policy" {
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		return nil
+	case http.MethodDelete:
+		if notFound == nil {
+			return unsupportedOperation("DELETE bucket " + name)
+		}
+
+		delete(bucket.subresources, name)
+
+		w.WriteHeader(http.StatusNoContent)
+
+		return nil
+	}
+
+	return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
+
+// listObjects implements ListObjects and ListObjectsV2.
+func (s *s3Service) listObjects(w http.ResponseWriter, bucket *s3Bucket, query url.Values, v2 bool) error {
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+	maxKeys := 1000
+	if v := query.Get("max-keys"); v != "" {
+		var err error
+		if maxKeys, err = strconv.Atoi(v); err != nil || maxKeys < 0 {
+			return errBadRequest("InvalidArgument", "Provided max-keys not an integer or within integer range")
+		}
+	}
+
+	marker := query.Get("marker")
+	if v2 {
+		marker = query.Get("start-after")
+		if v := query.Get("continuation-token"); v != "" {
+			marker = v
+		}
+	}
+
+	var contents, commonPrefixes xmlFlattened
+	var last string
+	truncated := false
+
+	for _, key := range sortedKeys(bucket.objects) {
+		object := bucket.current(key)
+		if object == nil || !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+
+		entry := key
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				entry = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if entry == last || (entry != key && strings.HasPrefix(marker, entry)) {
+			// The common prefix has already been returned.
+			continue
+		}
+
+		if len(contents)+len(commonPrefixes) == maxKeys {
+			truncated = true
+			break
+		}
+
+		last = entry
+		if entry == key {
+			contents = append(contents, xmlObject{
+				"ETag":         object.etag,
+				"Key":          key,
+				"LastModified": object.modified,
+				"Size":         len(object.body),
+				"StorageClass": "STANDARD",
+			})
+		} else {
+			commonPrefixes = append(commonPrefixes, xmlObject{"Prefix": entry})
+		}
+	}
+
+	output := xmlObject{
+		"CommonPrefixes": commonPrefixes,
+		"Contents":       contents,
+		"IsTruncated":    truncated,
+		"MaxKeys":        maxKeys,
+		"Name":           bucket.name,
+		"Prefix":         prefix,
+	}
+	if delimiter != "" {
+		output["Delimiter"] = delimiter
+	}
+
+	if v2 {
+		output["KeyCount"] = len(contents) + len(commonPrefixes)
+		if v := query.Get("continuation-token"); v != "" {
+			output["ContinuationToken"] = v
+		}
+		if v := query.Get("start-after"); v != "" {
+			output["StartAfter"] = v
+		}
+		if truncated {
+			output["NextContinuationToken"] = last
+		}
+	} else {
+		output["Marker"] = marker
+		if truncated && delimiter != "" {
+			output["NextMarker"] = last
+		}
+	}
+
+	writeS3XML(w, "ListBucketResult", output)
+
+	return nil
+}
+
+func (s *s3Service) listObjectVersions(w http.ResponseWriter, bucket *s3Bucket, query url.Values) error {
+	prefix := query.Get("prefix")
+	keyMarker, versionIDMarker := query.Get("key-marker"), query.Get("version-id-marker")
+	maxKeys := 1000
+	if v := query.Get("max-keys"); v != "" {
+		var err error
+		if maxKeys, err = strconv.Atoi(v); err != nil || maxKeys < 0 {
+			return errBadRequest("InvalidArgument", "Provided max-keys not an integer or within integer range")
+		}
+	}
+
+	var versions, deleteMarkers xmlFlattened
+	var nextKey, nextVersionID string
+	truncated := false
+	// Versions are listed by key and then newest first.
+	skipping := versionIDMarker != ""
+
+	for _, key := range sortedKeys(bucket.objects) {
+		if !strings.HasPrefix(key, prefix) || key < keyMarker || (key == keyMarker && !skipping) {
+			continue
+		}
+
+		objects := bucket.objects[key]
+		for i := len(objects) - 1; i >= 0; i-- {
+			object := objects[i]
+			if skipping {
+				if key == keyMarker && object.versionID == versionIDMarker {
+					skipping = false
+				}
+				continue
+			}
+
+			if len(versions)+len(deleteMarkers) == maxKeys {
+				truncated = true
+				break
+			}
+
+			nextKey, nextVersionID = key, object.versionID
+			v := xmlObject{
+				"IsLatest":     i == len(objects)-1,
+				"Key":          key,
+				"LastModified": object.modified,
+				"Owner":        s3Owner(),
+				"VersionId":    object.versionID,
+			}
+			if object.deleteMarker {
+				deleteMarkers = append(deleteMarkers, v)
+			} else {
+				v["ETag"] = object.etag
+				v["Size"] = len(object.body)
+				v["StorageClass"] = "STANDARD"
+				versions = append(versions, v)
+			}
+		}
+
+		if skipping && key == keyMarker {
+			// The version marker wasn't found; continue from the next key.
+			skipping = false
+		}
+		if truncated {
+			break
+		}
+	}
+
+	output := xmlObject{
+		"DeleteMarker":    deleteMarkers,
+		"IsTruncated":     truncated,
+		"KeyMarker":       keyMarker,
+		"MaxKeys":         maxKeys,
+		"Name":            bucket.name,
+		"Prefix":          prefix,
+		"Version":         versions,
+		"VersionIdMarker": versionIDMarker,
+	}
+	if truncated {
+		output["NextKeyMarker"] = nextKey
+		output["NextVersionIdMarker"] = nextVersionID
+	}
+
+	writeS3XML(w, "ListVersionsResult", output)
+
+	return nil
+}
+
+func (s *s3Service) deleteObjects(w http.ResponseWriter, r *http.Request, bucket *s3Bucket) error {
+	b, _, err := readS3Body(r)
+	if err != nil {
+		return err
+	}
+
+	var input struct {
+		Objects []struct {
+			Key       string
+			VersionId string //nolint:revive,stylecheck // Matches the XML element name.
+		} `xml:"Object"`
+		Quiet bool
+	}
+	if err := xml.Unmarshal(b, &input); err != nil {
+		return errBadRequest("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+	}
+
+	var deleted xmlFlattened
+	for _, v := range input.Objects {
+		versionID, deleteMarker := bucket.deleteObject(v.Key, v.VersionId)
+
+		if input.Quiet {
+			continue
+		}
+
+		result := xmlObject{"Key": v.Key}
+		if v.VersionId != "" {
+			result["VersionId"] = v.VersionId
+		}
+		if deleteMarker {
+			result["DeleteMarker"] = true
+			result["DeleteMarkerVersionId"] = versionID
+		}
+		deleted = append(deleted, result)
+	}
+
+	writeS3XML(w, "DeleteResult", xmlObject{"Deleted": deleted})
+
+	return nil
+}
+
+func (s *s3Service) serveObject(w http.ResponseWriter, r *http.Request, bucketName, key string, query url.Values) error {
+	bucket, err := s.findBucket(bucketName)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		return s.createMultipartUpload(w, r, bucket, key)
+	case query.Has("uploadId"):
+		return s.serveMultipartUpload(w, r, bucket, key, query)
+	case query.Has("tagging"):
+		return s.serveObjectTagging(w, r, bucket, key, query)
+	case query.Has("acl"):
+		return s.serveObjectACL(w, r, bucket, key, query)
+	case len(query) > 0 && !query.Has("versionId"):
+		return unsupportedOperation(r.Method + " object " + r.URL.RawQuery)
+	case r.Method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "":
+		return s.copyObject(w, r, bucket, key)
+	case r.Method == http.MethodPut:
+		return s.putObject(w, r, bucket, key)
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		return s.getObject(w, r, bucket, key, query)
+	case r.Method == http.MethodDelete:
+		versionID, deleteMarker := bucket.deleteObject(key, query.Get("versionId"))
+		if versionID != "" {
+			w.Header().Set("X-Amz-Version-Id", versionID)
+		}
+		if deleteMarker {
+			w.Header().Set("X-Amz-Delete-Marker", "true")
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
+
+func (s *s3Service) putObject(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string) error {
+	body, checksums, err := readS3Body(r)
+	if err != nil {
+		return err
+	}
+
+	tags, err := s3RequestTags(r)
+	if err != nil {
+		return err
+	}
+
+	object := &s3Object{
+		acl:       s3CannedACL(r.Header.Get("X-Amz-Acl")),
+		body:      body,
+		checksums: checksums,
+		etag:      s3ETag(body),
+		headers:   s3ObjectRequestHeaders(r),
+		key:       key,
+		tags:      tags,
+	}
+	bucket.putObject(object)
+
+	writeS3ObjectHeaders(w, object, false)
+
+	return nil
+}
+
+func (s *s3Service) copyObject(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string) error {
+	source, err := url.PathUnescape(strings.TrimPrefix(r.Header.Get("X-Amz-Copy-Source"), "/"))
+	if err != nil {
+		return errBadRequest("InvalidArgument", "Invalid copy source encoding")
+	}
+
+	source, versionID, _ := strings.Cut(source, "?versionId=")
+	sourceBucketName, sourceKey, _ := strings.Cut(source, "/")
+
+	sourceBucket, err := s.findBucket(sourceBucketName)
+	if err != nil {
+		return err
+	}
+
+	sourceObject, err := sourceBucket.findObject(sourceKey, versionID)
+	if err != nil {
+		return err
+	}
+
+	object := &s3Object{
+		acl:       s3CannedACL(r.Header.Get("X-Amz-Acl")),
+		body:      sourceObject.body,
+		checksums: sourceObject.checksums,
+		etag:      sourceObject.etag,
+		headers:   sourceObject.headers.Clone(),
+		key:       key,
+		tags:      sourceObject.tags,
+	}
+
+	if r.Header.Get("X-Amz-Metadata-Directive") == "REPLACE" {
+		object.headers = s3ObjectRequestHeaders(r)
+	}
+	if r.Header.Get("X-Amz-Tagging-Directive") == "REPLACE" {
+		if object.tags, err = s3RequestTags(r); err != nil {
+			return err
+		}
+	}
+
+	bucket.putObject(object)
+
+	if sourceObject.versionID != s3NullVersionID {
+		w.Header().Set("X-Amz-Copy-Source-Version-Id", sourceObject.versionID)
+	}
+	if object.versionID != s3NullVersionID {
+		w.Header().Set("X-Amz-Version-Id", object.versionID)
+	}
+
+	writeS3XML(w, "CopyObjectResult", xmlObject{
+		"ETag":         object.etag,
+		"LastModified": object.modified,
+	})
+
+	return nil
+}
+
+// writeS3ObjectHeaders writes an object's metadata as response headers.
+func writeS3ObjectHeaders(w http.ResponseWriter, object *s3Object, checksums bool) {
+	for k, v := range object.headers {
+		if k == "X-Amz-Storage-Class" && v[0] == "STANDARD" {
+			continue
+		}
+		w.Header()[k] = v
+	}
+
+	if checksums {
+		for k, v := range object.checksums {
+			w.Header()[k] = v
+		}
+	}
+
+	w.Header().Set("ETag", object.etag)
+	if object.versionID != s3NullVersionID {
+		w.Header().Set("X-Amz-Version-Id", object.versionID)
+	}
+}
+
+func (s *s3Service) getObject(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string, query url.Values) error {
+	object, err := bucket.findObject(key, query.Get("versionId"))
+	if err != nil {
+		return err
+	}
+
+	writeS3ObjectHeaders(w, object, r.Header.Get("X-Amz-Checksum-Mode") == "ENABLED")
+	if len(object.tags) > 0 {
+		w.Header().Set("X-Amz-Tagging-Count", strconv.Itoa(len(object.tags)))
+	}
+
+	http.ServeContent(w, r, "", object.modified, bytes.NewReader(object.body))
+
+	return nil
+}
+
+func (s *s3Service) serveObjectTagging(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string, query url.Values) error {
+	object, err := bucket.findObject(key, query.Get("versionId"))
+	if err != nil {
+		return err
+	}
+
+	if object.versionID != s3NullVersionID {
+		w.Header().Set("X-Amz-Version-Id", object.versionID)
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeS3XML(w, "Tagging", s3TaggingOutput(object.tags))
+		return nil
+	case http.MethodPut:
+		b, _, err := readS3Body(r)
+		if err != nil {
+			return err
+		}
+
+		var input s3Tagging
+		if err := xml.Unmarshal(b, &input); err != nil {
+			return errBadRequest("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		}
+
+		object.tags = make(map[string]string)
+		for _, v := range input.TagSet {
+			object.tags[v.Key] = v.Value
+		}
+
+		return nil
+	case http.MethodDelete:
+		object.tags = make(map[string]string)
+
+		w.WriteHeader(http.StatusNoContent)
+
+		return nil
+	}
+
+	return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
+
+func (s *s3Service) serveObjectACL(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string, query url.Values) error {
+	object, err := bucket.findObject(key, query.Get("versionId"))
+	if err != nil {
+		return err
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/xml")
+		io.WriteString(w, xml.Header) //nolint:errcheck // The response has been committed.
+		w.Write(object.acl)           //nolint:errcheck // The response has been committed.
+		return nil
+	case http.MethodPut:
+		b, _, err := readS3Body(r)
+		if err != nil {
+			return err
+		}
+
+		if len(b) == 0 {
+			b = s3CannedACL(r.Header.Get("X-Amz-Acl"))
+		}
+		object.acl = b
+
+		return nil
+	}
+
+	return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
+
+func (s *s3Service) createMultipartUpload(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string) error {
+	tags, err := s3RequestTags(r)
+	if err != nil {
+		return err
+	}
+
+	id := newS3VersionID()
+	bucket.uploads[id] = &s3Upload{
+		acl:     s3CannedACL(r.Header.Get("X-Amz-Acl")),
+		headers: s3ObjectRequestHeaders(r),
+		key:     key,
+		parts:   make(map[int][]byte),
+		tags:    tags,
+	}
+
+	writeS3XML(w, "InitiateMultipartUploadResult", xmlObject{
+		"Bucket":   bucket.name,
+		"Key":      key,
+		"UploadId": id,
+	})
+
+	return nil
+}
+
+// serveMultipartUpload implements UploadPart, CompleteMultipartUpload and AbortMultipartUpload.
+func (s *s3Service) serveMultipartUpload(w http.ResponseWriter, r *http.Request, bucket *s3Bucket, key string, query url.Values) error {
+	id := query.Get("uploadId")
+	upload, ok := bucket.uploads[id]
+	if !ok || upload.key != key {
+		return errNotFound("NoSuchUpload", "The specified upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.")
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		n, err := strconv.Atoi(query.Get("partNumber"))
+		if err != nil || n < 1 || n > 10000 {
+			return errBadRequest("InvalidArgument", "Part number must be an integer between 1 and 10000, inclusive")
+		}
+
+		b, _, err := readS3Body(r)
+		if err != nil {
+			return err
+		}
+
+		upload.parts[n] = b
+
+		w.Header().Set("ETag", s3ETag(b))
+
+		return nil
+	case http.MethodPost:
+		b, _, err := readS3Body(r)
+		if err != nil {
+			return err
+		}
+
+		var input struct {
+			Parts []struct {
+				PartNumber int
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(b, &input); err != nil {
+			return errBadRequest("MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema")
+		}
+
+		var body, digests []byte
+		for _, v := range input.Parts {
+			part, ok := upload.parts[v.PartNumber]
+			if !ok {
+				return errBadRequest("InvalidPart", "One or more of the specified parts could not be found.")
+			}
+
+			body = append(body, part...)
+			digest := md5.Sum(part) // nosemgrep:ci.avoid-crypto-md5
+			digests = append(digests, digest[:]...)
+		}
+
+		etag := s3ETag(digests)
+		object := &s3Object{
+			acl:     upload.acl,
+			body:    body,
+			etag:    strings.TrimSuffix(etag, `"`) + "-" + strconv.Itoa(len(input.Parts)) + `"`,
+			headers: upload.headers,
+			key:     key,
+			tags:    upload.tags,
+		}
+		bucket.putObject(object)
+		delete(bucket.uploads, id)
+
+		if object.versionID != s3NullVersionID {
+			w.Header().Set("X-Amz-Version-Id", object.versionID)
+		}
+
+		writeS3XML(w, "CompleteMultipartUploadResult", xmlObject{
+			"Bucket":   bucket.name,
+			"ETag":     object.etag,
+			"Key":      key,
+			"Location": "/" + bucket.name + "/" + key,
+		})
+
+		return nil
+	case http.MethodDelete:
+		delete(bucket.uploads, id)
+
+		w.WriteHeader(http.StatusNoContent)
+
+		return nil
+	}
+
+	return newAPIError(http.StatusMethodNotAllowed, "MethodNotAllowed", "The specified method is not allowed against this resource.")
+}
@@ -0,0 +1,589 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// secretsManagerService implements AWS Secrets Manager.
+type secretsManagerService struct {
+	store
+	operations map[string]jsonOperation
+	secrets    map[string]*secretsManagerSecret // Keyed by name.
+}
+
+type secretsManagerSecret struct {
+	arn         string
+	created     time.Time
+	deleted     time.Time
+	description string
+	kmsKeyID    string
+	name        string
+	policy      string
+	tags        map[string]string
+	versions    map[string]*secretsManagerSecretVersion // Keyed by version ID.
+}
+
+type secretsManagerSecretVersion struct {
+	binary  string // Base64 encoded.
+	created time.Time
+	id      string
+	stages  []string
+	str     string
+}
+
+const (
+	secretsManagerStageCurrent  = "AWSCURRENT"
+	secretsManagerStagePrevious = "AWSPREVIOUS"
+)
+
+func newSecretsManagerService() *secretsManagerService {
+	s := &secretsManagerService{
+		secrets: make(map[string]*secretsManagerSecret),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"CreateSecret":             s.createSecret,
+		"DeleteResourcePolicy":     s.deleteResourcePolicy,
+		"DeleteSecret":             s.deleteSecret,
+		"DescribeSecret":           s.describeSecret,
+		"GetResourcePolicy":        s.getResourcePolicy,
+		"GetSecretValue":           s.getSecretValue,
+		"ListSecretVersionIds":     s.listSecretVersionIDs,
+		"ListSecrets":              s.listSecrets,
+		"PutResourcePolicy":        s.putResourcePolicy,
+		"PutSecretValue":           s.putSecretValue,
+		"RestoreSecret":            s.restoreSecret,
+		"TagResource":              s.tagResource,
+		"UntagResource":            s.untagResource,
+		"UpdateSecret":             s.updateSecret,
+		"UpdateSecretVersionStage": s.updateSecretVersionStage,
+	}
+
+	return s
+}
+
+func (s *secretsManagerService) endpoint() string {
+	return "secretsmanager"
+}
+
+func (s *secretsManagerService) signingName() string {
+	return "secretsmanager"
+}
+
+func (s *secretsManagerService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.1", s.operations, nil)
+}
+
+// findSecret returns the secret with the specified name or ARN, including secrets scheduled for deletion.
+func (s *secretsManagerService) findSecret(id string) (*secretsManagerSecret, error) {
+	if secret, ok := s.secrets[id]; ok {
+		return secret, nil
+	}
+
+	for _, secret := range s.secrets {
+		if secret.arn == id {
+			return secret, nil
+		}
+	}
+
+	return nil, errBadRequest("ResourceNotFoundException", "Secrets Manager can't find the specified secret.")
+}
+
+// findActiveSecret returns the secret with the specified name or ARN, failing if it's scheduled for deletion.
+func (s *secretsManagerService) findActiveSecret(id string) (*secretsManagerSecret, error) {
+	secret, err := s.findSecret(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !secret.deleted.IsZero() {
+		return nil, errBadRequest("InvalidRequestException", "You can't perform this operation on the secret because it was marked for deletion.")
+	}
+
+	return secret, nil
+}
+
+// versionWithStage returns the version of the secret with the specified staging label, if any.
+func (secret *secretsManagerSecret) versionWithStage(stage string) *secretsManagerSecretVersion {
+	for _, version := range secret.versions {
+		if slices.Contains(version.stages, stage) {
+			return version
+		}
+	}
+
+	return nil
+}
+
+// moveStage attaches the staging label to the specified version, removing it from any other version.
+// Moving AWSCURRENT labels the previously current version AWSPREVIOUS.
+func (secret *secretsManagerSecret) moveStage(stage string, to *secretsManagerSecretVersion) {
+	from := secret.versionWithStage(stage)
+	if from == to {
+		return
+	}
+
+	if from != nil {
+		from.stages = slices.DeleteFunc(from.stages, func(v string) bool { return v == stage })
+	}
+	to.stages = append(to.stages, stage)
+
+	if stage == secretsManagerStageCurrent && from != nil {
+		secret.moveStage(secretsManagerStagePrevious, from)
+	}
+}
+
+// putVersion adds a new version of the secret's value with the specified staging labels.
+func (secret *secretsManagerSecret) putVersion(input jsonObject) (*secretsManagerSecretVersion, error) {
+	versionID := input.string("ClientRequestToken")
+	if versionID == "" {
+		versionID = newID()
+	}
+
+	if version, ok := secret.versions[versionID]; ok {
+		if version.str != input.string("SecretString") || version.binary != input.string("SecretBinary") {
+			return nil, errBadRequest("ResourceExistsException", "You can't modify an existing version, you can only create a new version.")
+		}
+
+		return version, nil
+	}
+
+	version := &secretsManagerSecretVersion{
+		binary:  input.string("SecretBinary"),
+		created: time.Now(),
+		id:      versionID,
+		str:     input.string("SecretString"),
+	}
+	secret.versions[versionID] = version
+
+	stages := input.strings("VersionStages")
+	if len(stages) == 0 {
+		stages = []string{secretsManagerStageCurrent}
+	}
+	for _, stage := range stages {
+		secret.moveStage(stage, version)
+	}
+
+	return version, nil
+}
+
+func (secret *secretsManagerSecret) output() jsonObject {
+	stages := make(map[string]any)
+	for id, version := range secret.versions {
+		if len(version.stages) > 0 {
+			stages[id] = version.stages
+		}
+	}
+
+	v := jsonObject{
+		"ARN":                secret.arn,
+		"CreatedDate":        epochSeconds(secret.created),
+		"LastChangedDate":    epochSeconds(secret.created),
+		"Name":               secret.name,
+		"Tags":               jsonTags(secret.tags, "Key", "Value"),
+		"VersionIdsToStages": stages,
+	}
+
+	if !secret.deleted.IsZero() {
+		v["DeletedDate"] = epochSeconds(secret.deleted)
+	}
+	if secret.description != "" {
+		v["Description"] = secret.description
+	}
+	if secret.kmsKeyID != "" {
+		v["KmsKeyId"] = secret.kmsKeyID
+	}
+
+	return v
+}
+
+func (s *secretsManagerService) createSecret(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("Name")
+	if secret, ok := s.secrets[name]; ok {
+		if !secret.deleted.IsZero() {
+			return nil, errBadRequest("InvalidRequestException", "You can't create this secret because a secret with this name is already scheduled for deletion.")
+		}
+
+		if _, ok := secret.versions[input.string("ClientRequestToken")]; ok {
+			// Retried request.
+			return jsonObject{
+				"ARN":  secret.arn,
+				"Name": secret.name,
+			}, nil
+		}
+
+		return nil, errBadRequest("ResourceExistsException", "The operation failed because the secret %s already exists.", name)
+	}
+
+	if len(input.list("AddReplicaRegions")) > 0 {
+		return nil, unsupportedOperation("CreateSecret with AddReplicaRegions")
+	}
+
+	suffix, _, _ := strings.Cut(newID(), "-")
+	secret := &secretsManagerSecret{
+		arn:         arnString("secretsmanager", regionFrom(ctx), "secret:"+name+"-"+suffix[:6]),
+		created:     time.Now(),
+		description: input.string("Description"),
+		kmsKeyID:    input.string("KmsKeyId"),
+		name:        name,
+		tags:        input.tagList("Tags", "Key", "Value"),
+		versions:    make(map[string]*secretsManagerSecretVersion),
+	}
+
+	output := jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+
+	if _, hasString := input["SecretString"]; hasString || input.string("SecretBinary") != "" {
+		version, err := secret.putVersion(input)
+		if err != nil {
+			return nil, err
+		}
+
+		output["VersionId"] = version.id
+	}
+
+	s.secrets[name] = secret
+
+	return output, nil
+}
+
+func (s *secretsManagerService) describeSecret(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	return secret.output(), nil
+}
+
+func (s *secretsManagerService) listSecrets(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	var names []string
+	for _, name := range sortedKeys(s.secrets) {
+		if s.secrets[name].deleted.IsZero() || input.bool("IncludePlannedDeletion") {
+			names = append(names, name)
+		}
+	}
+
+	page, next, err := paginate(names, input.string("NextToken"), input.int("MaxResults", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []any{}
+	for _, name := range page {
+		secrets = append(secrets, s.secrets[name].output())
+	}
+
+	output := jsonObject{"SecretList": secrets}
+	if next != "" {
+		output["NextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) updateSecret(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := input["Description"]; ok {
+		secret.description = input.string("Description")
+	}
+	if _, ok := input["KmsKeyId"]; ok {
+		secret.kmsKeyID = input.string("KmsKeyId")
+	}
+
+	output := jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+
+	if _, hasString := input["SecretString"]; hasString || input.string("SecretBinary") != "" {
+		version, err := secret.putVersion(input)
+		if err != nil {
+			return nil, err
+		}
+
+		output["VersionId"] = version.id
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) deleteSecret(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	deletionDate := time.Now()
+	if input.bool("ForceDeleteWithoutRecovery") {
+		delete(s.secrets, secret.name)
+	} else {
+		if secret.deleted.IsZero() {
+			secret.deleted = time.Now()
+		}
+		deletionDate = secret.deleted.AddDate(0, 0, input.int("RecoveryWindowInDays", 30))
+	}
+
+	return jsonObject{
+		"ARN":          secret.arn,
+		"DeletionDate": epochSeconds(deletionDate),
+		"Name":         secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) restoreSecret(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	secret.deleted = time.Time{}
+
+	return jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) putSecretValue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := secret.putVersion(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{
+		"ARN":           secret.arn,
+		"Name":          secret.name,
+		"VersionId":     version.id,
+		"VersionStages": version.stages,
+	}, nil
+}
+
+func (s *secretsManagerService) getSecretValue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	var version *secretsManagerSecretVersion
+	if v := input.string("VersionId"); v != "" {
+		version = secret.versions[v]
+		if version != nil && input.string("VersionStage") != "" && !slices.Contains(version.stages, input.string("VersionStage")) {
+			version = nil
+		}
+	} else {
+		stage := input.string("VersionStage")
+		if stage == "" {
+			stage = secretsManagerStageCurrent
+		}
+		version = secret.versionWithStage(stage)
+	}
+
+	if version == nil {
+		return nil, errBadRequest("ResourceNotFoundException", "Secrets Manager can't find the specified secret value.")
+	}
+
+	output := jsonObject{
+		"ARN":           secret.arn,
+		"CreatedDate":   epochSeconds(version.created),
+		"Name":          secret.name,
+		"VersionId":     version.id,
+		"VersionStages": version.stages,
+	}
+	if version.binary != "" {
+		output["SecretBinary"] = version.binary
+	} else {
+		output["SecretString"] = version.str
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) listSecretVersionIDs(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, id := range sortedKeys(secret.versions) {
+		if len(secret.versions[id].stages) > 0 || input.bool("IncludeDeprecated") {
+			ids = append(ids, id)
+		}
+	}
+
+	page, next, err := paginate(ids, input.string("NextToken"), input.int("MaxResults", 100))
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []any{}
+	for _, id := range page {
+		version := secret.versions[id]
+		versions = append(versions, jsonObject{
+			"CreatedDate":   epochSeconds(version.created),
+			"VersionId":     version.id,
+			"VersionStages": version.stages,
+		})
+	}
+
+	output := jsonObject{
+		"ARN":      secret.arn,
+		"Name":     secret.name,
+		"Versions": versions,
+	}
+	if next != "" {
+		output["NextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) updateSecretVersionStage(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	stage := input.string("VersionStage")
+
+	if v := input.string("RemoveFromVersionId"); v != "" {
+		version, ok := secret.versions[v]
+		if !ok || !slices.Contains(version.stages, stage) {
+			return nil, errBadRequest("InvalidParameterException", "The staging label %s is not attached to version %s.", stage, v)
+		}
+		if stage == secretsManagerStageCurrent && input.string("MoveToVersionId") == "" {
+			return nil, errBadRequest("InvalidParameterException", "You can only move staging label AWSCURRENT to a different secret version. It can't be completely removed.")
+		}
+
+		version.stages = slices.DeleteFunc(version.stages, func(v string) bool { return v == stage })
+	}
+
+	if v := input.string("MoveToVersionId"); v != "" {
+		version, ok := secret.versions[v]
+		if !ok {
+			return nil, errBadRequest("ResourceNotFoundException", "Secrets Manager can't find the specified secret version.")
+		}
+
+		secret.moveStage(stage, version)
+	}
+
+	return jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) getResourcePolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	output := jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}
+	if secret.policy != "" {
+		output["ResourcePolicy"] = secret.policy
+	}
+
+	return output, nil
+}
+
+func (s *secretsManagerService) putResourcePolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = input.string("ResourcePolicy")
+
+	return jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) deleteResourcePolicy(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	secret.policy = ""
+
+	return jsonObject{
+		"ARN":  secret.arn,
+		"Name": secret.name,
+	}, nil
+}
+
+func (s *secretsManagerService) tagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(secret.tags, input.tagList("Tags", "Key", "Value"))
+
+	return nil, nil
+}
+
+func (s *secretsManagerService) untagResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	secret, err := s.findActiveSecret(input.string("SecretId"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(secret.tags, input.strings("TagKeys"))
+
+	return nil, nil
+}
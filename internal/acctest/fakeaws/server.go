@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeaws implements an in-process stand-in for a core set of AWS services.
+// It models enough of each service's state for acceptance tests to create, read, update, delete and list resources
+// without network access.
+package fakeaws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+)
+
+const (
+	// AccountID is the AWS account ID of all fake resources.
+	AccountID = "123456789012"
+	// AccessKeyID and SecretAccessKey are credentials accepted by the fake services.
+	// Requests are routed by the service in their SigV4 credential scope; signatures are not verified.
+	AccessKeyID     = "AKIAFAKEAWS000000000"
+	SecretAccessKey = "fake-aws-secret-access-key"
+	// Partition is the AWS partition of all fake resources.
+	Partition = "aws"
+)
+
+// service handles the requests for a single AWS service.
+type service interface {
+	// endpoint returns the name of the service's key in the provider's endpoints configuration block.
+	endpoint() string
+	// signingName returns the service's SigV4 signing name.
+	signingName() string
+	http.Handler
+}
+
+// Server is an HTTP server implementing the fake AWS services.
+// All services share a single listener; requests are routed using the service named in the SigV4 credential scope.
+type Server struct {
+	server   *httptest.Server
+	services map[string]service
+}
+
+// NewServer starts and returns a new Server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		services: make(map[string]service),
+	}
+
+	for _, v := range []service{
+		newDynamoDBService(),
+		newIAMService(),
+		newKMSService(),
+		newLogsService(),
+		newS3Service(),
+		newSecretsManagerService(),
+		newSNSService(),
+		newSQSService(),
+		newSSMService(),
+		newSTSService(),
+	} {
+		s.services[v.signingName()] = v
+	}
+
+	s.server = httptest.NewServer(s)
+
+	for _, v := range s.services {
+		if v, ok := v.(interface{ setURL(string) }); ok {
+			v.setURL(s.server.URL)
+		}
+	}
+
+	return s
+}
+
+// URL returns the base URL of the server, of the form http://ipaddr:port with no trailing slash.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Endpoints returns the provider's endpoints configuration for the fake services, keyed by service.
+func (s *Server) Endpoints() map[string]string {
+	endpoints := make(map[string]string, len(s.services))
+
+	for _, v := range s.services {
+		endpoints[v.endpoint()] = s.URL()
+	}
+
+	return endpoints
+}
+
+var credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/[0-9]{8}/([^/]+)/([^/]+)/aws4_request`)
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		// Presigned URLs carry the credential scope in the query string.
+		m = credentialScopeRegexp.FindStringSubmatch("Credential=" + r.URL.Query().Get("X-Amz-Credential"))
+	}
+	if m == nil {
+		http.Error(w, "missing SigV4 credential scope", http.StatusForbidden)
+		return
+	}
+
+	v, ok := s.services[m[2]]
+	if !ok {
+		http.Error(w, "unsupported service: "+m[2], http.StatusNotImplemented)
+		return
+	}
+
+	v.ServeHTTP(w, r.WithContext(withRegion(r.Context(), m[1])))
+}
+
+// store is embedded in each service to serialize access to its state.
+type store struct {
+	mu sync.Mutex
+}
+
+func (s *store) lock() func() {
+	s.mu.Lock()
+
+	return s.mu.Unlock
+}
@@ -0,0 +1,622 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func testConfig(t *testing.T) aws.Config {
+	t.Helper()
+
+	server := fakeaws.NewServer()
+	t.Cleanup(server.Close)
+
+	return aws.Config{
+		BaseEndpoint: aws.String(server.URL()),
+		Credentials:  credentials.NewStaticCredentialsProvider(fakeaws.AccessKeyID, fakeaws.SecretAccessKey, ""),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	}
+}
+
+func TestServerEndpoints(t *testing.T) {
+	t.Parallel()
+
+	server := fakeaws.NewServer()
+	defer server.Close()
+
+	endpoints := server.Endpoints()
+
+	for _, k := range []string{"dynamodb", "iam", "kms", "logs", "s3", "secretsmanager", "sns", "sqs", "ssm", "sts"} {
+		if got, want := endpoints[k], server.URL(); got != want {
+			t.Errorf("Endpoints()[%q] = %q, want %q", k, got, want)
+		}
+	}
+}
+
+func TestSTS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sts.NewFromConfig(testConfig(t))
+
+	output, err := conn.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatalf("GetCallerIdentity: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Account), fakeaws.AccountID; got != want {
+		t.Errorf("Account = %q, want %q", got, want)
+	}
+}
+
+func TestIAM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := iam.NewFromConfig(testConfig(t))
+	const policy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	_, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(policy),
+		RoleName:                 aws.String("test"),
+		Tags:                     []iamtypes.Tag{{Key: aws.String("k1"), Value: aws.String("v1")}},
+	})
+	if err != nil {
+		t.Fatalf("CreateRole: %s", err)
+	}
+
+	output, err := conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("GetRole: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Role.Arn), "arn:aws:iam::"+fakeaws.AccountID+":role/test"; got != want {
+		t.Errorf("Arn = %q, want %q", got, want)
+	}
+	if got, want := len(output.Role.Tags), 1; got != want {
+		t.Errorf("len(Tags) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteRole(ctx, &iam.DeleteRoleInput{RoleName: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteRole: %s", err)
+	}
+
+	_, err = conn.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String("test")})
+	if !errs.IsA[*iamtypes.NoSuchEntityException](err) {
+		t.Errorf("GetRole after delete: %v, want NoSuchEntityException", err)
+	}
+}
+
+func TestSQS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sqs.NewFromConfig(testConfig(t))
+
+	output, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNameVisibilityTimeout): "60"},
+		QueueName:  aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	queueURL := aws.ToString(output.QueueUrl)
+
+	attributes, err := conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameAll},
+		QueueUrl:       aws.String(queueURL),
+	})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes: %s", err)
+	}
+
+	if got, want := attributes.Attributes[string(sqstypes.QueueAttributeNameVisibilityTimeout)], "60"; got != want {
+		t.Errorf("VisibilityTimeout = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes[string(sqstypes.QueueAttributeNameQueueArn)], "arn:aws:sqs:us-west-2:"+fakeaws.AccountID+":test"; got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("QueueArn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: aws.String(queueURL)}); err != nil {
+		t.Fatalf("DeleteQueue: %s", err)
+	}
+
+	_, err = conn.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{QueueUrl: aws.String(queueURL)})
+	if !errs.IsA[*sqstypes.QueueDoesNotExist](err) {
+		t.Errorf("GetQueueAttributes after delete: %v, want QueueDoesNotExist", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := sns.NewFromConfig(testConfig(t))
+
+	output, err := conn.CreateTopic(ctx, &sns.CreateTopicInput{
+		Name: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateTopic: %s", err)
+	}
+
+	topicARN := aws.ToString(output.TopicArn)
+
+	_, err = conn.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		AttributeName:  aws.String("DisplayName"),
+		AttributeValue: aws.String("Test"),
+		TopicArn:       aws.String(topicARN),
+	})
+	if err != nil {
+		t.Fatalf("SetTopicAttributes: %s", err)
+	}
+
+	attributes, err := conn.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{
+		TopicArn: aws.String(topicARN),
+	})
+	if err != nil {
+		t.Fatalf("GetTopicAttributes: %s", err)
+	}
+
+	if got, want := attributes.Attributes["DisplayName"], "Test"; got != want {
+		t.Errorf("DisplayName = %q, want %q", got, want)
+	}
+	if got, want := attributes.Attributes["TopicArn"], topicARN; got != want {
+		t.Errorf("TopicArn = %q, want %q", got, want)
+	}
+
+	if _, err := conn.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: aws.String(topicARN)}); err != nil {
+		t.Fatalf("DeleteTopic: %s", err)
+	}
+}
+
+func TestDynamoDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := dynamodb.NewFromConfig(testConfig(t))
+
+	_, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{{
+			AttributeName: aws.String("id"),
+			AttributeType: dynamodbtypes.ScalarAttributeTypeS,
+		}},
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+		KeySchema: []dynamodbtypes.KeySchemaElement{{
+			AttributeName: aws.String("id"),
+			KeyType:       dynamodbtypes.KeyTypeHash,
+		}},
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateTable: %s", err)
+	}
+
+	_, err = conn.PutItem(ctx, &dynamodb.PutItemInput{
+		Item: map[string]dynamodbtypes.AttributeValue{
+			"id":    &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+			"value": &dynamodbtypes.AttributeValueMemberN{Value: "42"},
+		},
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("PutItem: %s", err)
+	}
+
+	_, err = conn.PutItem(ctx, &dynamodb.PutItemInput{
+		ConditionExpression: aws.String("attribute_not_exists(id)"),
+		Item: map[string]dynamodbtypes.AttributeValue{
+			"id": &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+		},
+		TableName: aws.String("test"),
+	})
+	if !errs.IsA[*dynamodbtypes.ConditionalCheckFailedException](err) {
+		t.Errorf("conditional PutItem: %v, want ConditionalCheckFailedException", err)
+	}
+
+	output, err := conn.GetItem(ctx, &dynamodb.GetItemInput{
+		Key: map[string]dynamodbtypes.AttributeValue{
+			"id": &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+		},
+		TableName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("GetItem: %s", err)
+	}
+
+	if v, ok := output.Item["value"].(*dynamodbtypes.AttributeValueMemberN); !ok || v.Value != "42" {
+		t.Errorf("Item[value] = %#v, want 42", output.Item["value"])
+	}
+
+	if _, err := conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String("test")}); err != nil {
+		t.Fatalf("DeleteTable: %s", err)
+	}
+
+	_, err = conn.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String("test")})
+	if !errs.IsA[*dynamodbtypes.ResourceNotFoundException](err) {
+		t.Errorf("DescribeTable after delete: %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestSSM(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := ssm.NewFromConfig(testConfig(t))
+
+	for _, name := range []string{"/test/a", "/test/b", "/other"} {
+		_, err := conn.PutParameter(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  ssmtypes.ParameterTypeSecureString,
+			Value: aws.String("value" + name),
+		})
+		if err != nil {
+			t.Fatalf("PutParameter: %s", err)
+		}
+	}
+
+	output, err := conn.GetParameter(ctx, &ssm.GetParameterInput{
+		Name:           aws.String("/test/a"),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("GetParameter: %s", err)
+	}
+
+	if got, want := aws.ToString(output.Parameter.Value), "value/test/a"; got != want {
+		t.Errorf("Value = %q, want %q", got, want)
+	}
+
+	parameters, err := conn.DescribeParameters(ctx, &ssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("BeginsWith"),
+			Values: []string{"/test/"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("DescribeParameters: %s", err)
+	}
+
+	if got, want := len(parameters.Parameters), 2; got != want {
+		t.Errorf("len(Parameters) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteParameter(ctx, &ssm.DeleteParameterInput{Name: aws.String("/test/a")}); err != nil {
+		t.Fatalf("DeleteParameter: %s", err)
+	}
+
+	_, err = conn.GetParameter(ctx, &ssm.GetParameterInput{Name: aws.String("/test/a")})
+	if !errs.IsA[*ssmtypes.ParameterNotFound](err) {
+		t.Errorf("GetParameter after delete: %v, want ParameterNotFound", err)
+	}
+}
+
+func TestSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := secretsmanager.NewFromConfig(testConfig(t))
+
+	output, err := conn.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String("test"),
+		SecretString: aws.String("one"),
+	})
+	if err != nil {
+		t.Fatalf("CreateSecret: %s", err)
+	}
+
+	secretARN := aws.ToString(output.ARN)
+
+	if _, err := conn.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(secretARN),
+		SecretString: aws.String("two"),
+	}); err != nil {
+		t.Fatalf("PutSecretValue: %s", err)
+	}
+
+	for stage, want := range map[string]string{"AWSCURRENT": "two", "AWSPREVIOUS": "one"} {
+		value, err := conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId:     aws.String(secretARN),
+			VersionStage: aws.String(stage),
+		})
+		if err != nil {
+			t.Fatalf("GetSecretValue(%s): %s", stage, err)
+		}
+
+		if got := aws.ToString(value.SecretString); got != want {
+			t.Errorf("GetSecretValue(%s) = %q, want %q", stage, got, want)
+		}
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		RecoveryWindowInDays: aws.Int64(7),
+		SecretId:             aws.String(secretARN),
+	}); err != nil {
+		t.Fatalf("DeleteSecret: %s", err)
+	}
+
+	secret, err := conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(secretARN)})
+	if err != nil {
+		t.Fatalf("DescribeSecret: %s", err)
+	}
+
+	if secret.DeletedDate == nil {
+		t.Error("DeletedDate is nil after scheduling deletion")
+	}
+
+	if _, err := conn.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+		SecretId:                   aws.String(secretARN),
+	}); err != nil {
+		t.Fatalf("DeleteSecret: %s", err)
+	}
+
+	_, err = conn.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(secretARN)})
+	if !errs.IsA[*secretsmanagertypes.ResourceNotFoundException](err) {
+		t.Errorf("DescribeSecret after delete: %v, want ResourceNotFoundException", err)
+	}
+}
+
+func TestKMS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := kms.NewFromConfig(testConfig(t))
+
+	output, err := conn.CreateKey(ctx, &kms.CreateKeyInput{
+		Description: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatalf("CreateKey: %s", err)
+	}
+
+	keyID := aws.ToString(output.KeyMetadata.KeyId)
+
+	if _, err := conn.CreateAlias(ctx, &kms.CreateAliasInput{
+		AliasName:   aws.String("alias/test"),
+		TargetKeyId: aws.String(keyID),
+	}); err != nil {
+		t.Fatalf("CreateAlias: %s", err)
+	}
+
+	if _, err := conn.DisableKey(ctx, &kms.DisableKeyInput{KeyId: aws.String("alias/test")}); err != nil {
+		t.Fatalf("DisableKey: %s", err)
+	}
+
+	key, err := conn.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: output.KeyMetadata.Arn})
+	if err != nil {
+		t.Fatalf("DescribeKey: %s", err)
+	}
+
+	if got, want := key.KeyMetadata.KeyState, kmstypes.KeyStateDisabled; got != want {
+		t.Errorf("KeyState = %q, want %q", got, want)
+	}
+
+	if _, err := conn.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+		KeyId:               aws.String(keyID),
+		PendingWindowInDays: aws.Int32(7),
+	}); err != nil {
+		t.Fatalf("ScheduleKeyDeletion: %s", err)
+	}
+
+	_, err = conn.EnableKey(ctx, &kms.EnableKeyInput{KeyId: aws.String(keyID)})
+	if !errs.IsA[*kmstypes.KMSInvalidStateException](err) {
+		t.Errorf("EnableKey after deletion scheduled: %v, want KMSInvalidStateException", err)
+	}
+}
+
+func TestLogs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := cloudwatchlogs.NewFromConfig(testConfig(t))
+
+	if _, err := conn.CreateLogGroup(ctx, &cloudwatchlogs.CreateLogGroupInput{
+		LogGroupName: aws.String("/test/group"),
+		Tags:         map[string]string{"k1": "v1"},
+	}); err != nil {
+		t.Fatalf("CreateLogGroup: %s", err)
+	}
+
+	if _, err := conn.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+		LogGroupName:    aws.String("/test/group"),
+		RetentionInDays: aws.Int32(7),
+	}); err != nil {
+		t.Fatalf("PutRetentionPolicy: %s", err)
+	}
+
+	output, err := conn.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String("/test/"),
+	})
+	if err != nil {
+		t.Fatalf("DescribeLogGroups: %s", err)
+	}
+
+	if got, want := len(output.LogGroups), 1; got != want {
+		t.Fatalf("len(LogGroups) = %d, want %d", got, want)
+	}
+	if got, want := aws.ToInt32(output.LogGroups[0].RetentionInDays), int32(7); got != want {
+		t.Errorf("RetentionInDays = %d, want %d", got, want)
+	}
+
+	tags, err := conn.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: output.LogGroups[0].LogGroupArn,
+	})
+	if err != nil {
+		t.Fatalf("ListTagsForResource: %s", err)
+	}
+
+	if got, want := tags.Tags["k1"], "v1"; got != want {
+		t.Errorf("Tags[k1] = %q, want %q", got, want)
+	}
+}
+
+func TestS3(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := s3.NewFromConfig(testConfig(t), func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	const bucket = "test-bucket"
+
+	if _, err := conn.CreateBucket(ctx, &s3.CreateBucketInput{
+		Bucket: aws.String(bucket),
+		CreateBucketConfiguration: &s3types.CreateBucketConfiguration{
+			LocationConstraint: s3types.BucketLocationConstraintUsWest2,
+		},
+	}); err != nil {
+		t.Fatalf("CreateBucket: %s", err)
+	}
+
+	if _, err := conn.PutBucketVersioning(ctx, &s3.PutBucketVersioningInput{
+		Bucket: aws.String(bucket),
+		VersioningConfiguration: &s3types.VersioningConfiguration{
+			Status: s3types.BucketVersioningStatusEnabled,
+		},
+	}); err != nil {
+		t.Fatalf("PutBucketVersioning: %s", err)
+	}
+
+	for _, key := range []string{"a/1.txt", "a/2.txt", "b.txt"} {
+		if _, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:        strings.NewReader("content of " + key),
+			Bucket:      aws.String(bucket),
+			ContentType: aws.String("text/plain"),
+			Key:         aws.String(key),
+			Metadata:    map[string]string{"k1": "v1"},
+		}); err != nil {
+			t.Fatalf("PutObject(%s): %s", key, err)
+		}
+	}
+
+	object, err := conn.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("a/1.txt"),
+	})
+	if err != nil {
+		t.Fatalf("GetObject: %s", err)
+	}
+	defer object.Body.Close()
+
+	body, err := io.ReadAll(object.Body)
+	if err != nil {
+		t.Fatalf("reading object body: %s", err)
+	}
+
+	if got, want := string(body), "content of a/1.txt"; got != want {
+		t.Errorf("Body = %q, want %q", got, want)
+	}
+	if got, want := aws.ToString(object.ContentType), "text/plain"; got != want {
+		t.Errorf("ContentType = %q, want %q", got, want)
+	}
+	if got, want := object.Metadata["k1"], "v1"; got != want {
+		t.Errorf("Metadata[k1] = %q, want %q", got, want)
+	}
+
+	list, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+		Bucket:    aws.String(bucket),
+		Delimiter: aws.String("/"),
+	})
+	if err != nil {
+		t.Fatalf("ListObjectsV2: %s", err)
+	}
+
+	if got, want := len(list.Contents), 1; got != want {
+		t.Errorf("len(Contents) = %d, want %d", got, want)
+	}
+	if got, want := len(list.CommonPrefixes), 1; got != want {
+		t.Errorf("len(CommonPrefixes) = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("b.txt"),
+	}); err != nil {
+		t.Fatalf("DeleteObject: %s", err)
+	}
+
+	_, err = conn.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String("b.txt"),
+	})
+	if !errs.IsA[*s3types.NotFound](err) {
+		t.Errorf("HeadObject after delete: %v, want NotFound", err)
+	}
+
+	_, err = conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+	if err == nil || !strings.Contains(err.Error(), "BucketNotEmpty") {
+		t.Errorf("DeleteBucket with objects: %v, want BucketNotEmpty", err)
+	}
+
+	// Delete all object versions and delete markers, as force_destroy does.
+	var objects []s3types.ObjectIdentifier
+	pages := s3.NewListObjectVersionsPaginator(conn, &s3.ListObjectVersionsInput{
+		Bucket:  aws.String(bucket),
+		MaxKeys: aws.Int32(2),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			t.Fatalf("ListObjectVersions: %s", err)
+		}
+
+		for _, v := range page.Versions {
+			objects = append(objects, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+		for _, v := range page.DeleteMarkers {
+			objects = append(objects, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+		}
+	}
+
+	if got, want := len(objects), 4; got != want {
+		t.Errorf("object versions = %d, want %d", got, want)
+	}
+
+	if _, err := conn.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: aws.String(bucket),
+		Delete: &s3types.Delete{Objects: objects},
+	}); err != nil {
+		t.Fatalf("DeleteObjects: %s", err)
+	}
+
+	if _, err := conn.DeleteBucket(ctx, &s3.DeleteBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		t.Fatalf("DeleteBucket: %s", err)
+	}
+
+	_, err = conn.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+	if !errs.IsA[*s3types.NotFound](err) {
+		t.Errorf("HeadBucket after delete: %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// snsService implements Amazon Simple Notification Service topics and subscriptions.
+type snsService struct {
+	store
+	operations    map[string]queryOperation
+	subscriptions map[string]*snsSubscription // Keyed by ARN.
+	topics        map[string]*snsTopic        // Keyed by ARN.
+}
+
+type snsTopic struct {
+	arn        string
+	attributes map[string]string
+	tags       map[string]string
+}
+
+type snsSubscription struct {
+	arn        string
+	attributes map[string]string
+	endpoint   string
+	protocol   string
+	topicARN   string
+}
+
+func newSNSService() *snsService {
+	s := &snsService{
+		subscriptions: make(map[string]*snsSubscription),
+		topics:        make(map[string]*snsTopic),
+	}
+
+	s.operations = map[string]queryOperation{
+		"CreateTopic":               s.createTopic,
+		"DeleteTopic":               s.deleteTopic,
+		"GetSubscriptionAttributes": s.getSubscriptionAttributes,
+		"GetTopicAttributes":        s.getTopicAttributes,
+		"ListSubscriptions":         s.listSubscriptions,
+		"ListSubscriptionsByTopic":  s.listSubscriptionsByTopic,
+		"ListTagsForResource":       s.listTagsForResource,
+		"ListTopics":                s.listTopics,
+		"Publish":                   s.publish,
+		"SetSubscriptionAttributes": s.setSubscriptionAttributes,
+		"SetTopicAttributes":        s.setTopicAttributes,
+		"Subscribe":                 s.subscribe,
+		"TagResource":               s.tagResource,
+		"Unsubscribe":               s.unsubscribe,
+		"UntagResource":             s.untagResource,
+	}
+
+	return s
+}
+
+func (s *snsService) endpoint() string {
+	return "sns"
+}
+
+func (s *snsService) signingName() string {
+	return "sns"
+}
+
+func (s *snsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "http://sns.amazonaws.com/doc/2010-03-31/", s.operations)
+}
+
+func (s *snsService) findTopic(arn string) (*snsTopic, error) {
+	topic, ok := s.topics[arn]
+	if !ok {
+		return nil, errNotFound("NotFound", "Topic does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *snsService) findSubscription(arn string) (*snsSubscription, error) {
+	subscription, ok := s.subscriptions[arn]
+	if !ok {
+		return nil, errNotFound("NotFound", "Subscription does not exist")
+	}
+
+	return subscription, nil
+}
+
+// snsDefaultTopicPolicy returns the access policy of a new topic.
+func snsDefaultTopicPolicy(arn string) string {
+	return fmt.Sprintf(`{"Version":"2008-10-17","Id":"__default_policy_ID","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":["SNS:GetTopicAttributes","SNS:SetTopicAttributes","SNS:AddPermission","SNS:RemovePermission","SNS:DeleteTopic","SNS:Subscribe","SNS:ListSubscriptionsByTopic","SNS:Publish"],"Resource":%q,"Condition":{"StringEquals":{"AWS:SourceOwner":%q}}}]}`, arn, AccountID)
+}
+
+func (s *snsService) createTopic(ctx context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	name := input.Get("Name")
+	attributes := queryMap(input, "Attributes.entry")
+	arn := arnString("sns", regionFrom(ctx), name)
+
+	if strings.HasSuffix(name, ".fifo") != (attributes["FifoTopic"] == "true") {
+		return nil, errBadRequest("InvalidParameter", "Invalid parameter: Fifo Topic names must end with .fifo and must be made up of only uppercase and lowercase ASCII letters, numbers, underscores, and hyphens, and must be between 1 and 256 characters long.")
+	}
+
+	// CreateTopic is idempotent.
+	if _, ok := s.topics[arn]; !ok {
+		topic := &snsTopic{
+			arn: arn,
+			attributes: map[string]string{
+				"DisplayName":             "",
+				"EffectiveDeliveryPolicy": `{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false,"defaultRequestPolicy":{"headerContentType":"text/plain; charset=UTF-8"}}}`,
+				"Owner":                   AccountID,
+				"Policy":                  snsDefaultTopicPolicy(arn),
+				"TopicArn":                arn,
+			},
+			tags: queryTags(input, "Tags.member"),
+		}
+		if attributes["FifoTopic"] == "true" {
+			topic.attributes["ContentBasedDeduplication"] = "false"
+		}
+		maps.Copy(topic.attributes, attributes)
+		s.topics[arn] = topic
+	}
+
+	return xmlObject{"TopicArn": arn}, nil
+}
+
+func (s *snsService) getTopicAttributes(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTopic(input.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := maps.Clone(topic.attributes)
+	confirmed := 0
+	for _, subscription := range s.subscriptions {
+		if subscription.topicARN == topic.arn {
+			confirmed++
+		}
+	}
+	attributes["SubscriptionsConfirmed"] = strconv.Itoa(confirmed)
+	attributes["SubscriptionsDeleted"] = "0"
+	attributes["SubscriptionsPending"] = "0"
+
+	return xmlObject{"Attributes": xmlEntries(attributes)}, nil
+}
+
+func (s *snsService) setTopicAttributes(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTopic(input.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	name, value := input.Get("AttributeName"), input.Get("AttributeValue")
+	switch {
+	case name == "Policy" && value == "":
+		topic.attributes[name] = snsDefaultTopicPolicy(topic.arn)
+	case value == "":
+		delete(topic.attributes, name)
+	default:
+		topic.attributes[name] = value
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) deleteTopic(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	// DeleteTopic is idempotent.
+	arn := input.Get("TopicArn")
+	delete(s.topics, arn)
+	for k, subscription := range s.subscriptions {
+		if subscription.topicARN == arn {
+			delete(s.subscriptions, k)
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) listTopics(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	page, next, err := paginate(sortedKeys(s.topics), input.Get("NextToken"), 100)
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, arn := range page {
+		members = append(members, xmlObject{"TopicArn": arn})
+	}
+
+	return snsPage(xmlObject{"Topics": members}, next), nil
+}
+
+// snsPage adds SNS's pagination field to a list result.
+func snsPage(result xmlObject, next string) xmlObject {
+	if next != "" {
+		result["NextToken"] = next
+	}
+
+	return result
+}
+
+func (s *snsService) subscribe(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTopic(input.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Subscriptions are confirmed immediately.
+	subscription := &snsSubscription{
+		arn:        topic.arn + ":" + newID(),
+		attributes: queryMap(input, "Attributes.entry"),
+		endpoint:   input.Get("Endpoint"),
+		protocol:   input.Get("Protocol"),
+		topicARN:   topic.arn,
+	}
+	s.subscriptions[subscription.arn] = subscription
+
+	return xmlObject{"SubscriptionArn": subscription.arn}, nil
+}
+
+func (s *snsService) getSubscriptionAttributes(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	subscription, err := s.findSubscription(input.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]string{
+		"ConfirmationWasAuthenticated": "true",
+		"Endpoint":                     subscription.endpoint,
+		"Owner":                        AccountID,
+		"PendingConfirmation":          "false",
+		"Protocol":                     subscription.protocol,
+		"RawMessageDelivery":           "false",
+		"SubscriptionArn":              subscription.arn,
+		"SubscriptionPrincipal":        "arn:" + Partition + ":iam::" + AccountID + ":user/fake-aws",
+		"TopicArn":                     subscription.topicARN,
+	}
+	maps.Copy(attributes, subscription.attributes)
+
+	return xmlObject{"Attributes": xmlEntries(attributes)}, nil
+}
+
+func (s *snsService) setSubscriptionAttributes(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	subscription, err := s.findSubscription(input.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	if name, value := input.Get("AttributeName"), input.Get("AttributeValue"); value == "" {
+		delete(subscription.attributes, name)
+	} else {
+		subscription.attributes[name] = value
+	}
+
+	return nil, nil
+}
+
+func (s *snsService) unsubscribe(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	subscription, err := s.findSubscription(input.Get("SubscriptionArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.subscriptions, subscription.arn)
+
+	return nil, nil
+}
+
+func (s *snsService) listSubscriptionsPage(input url.Values, topicARN string) (any, error) {
+	var arns []string
+	for _, arn := range sortedKeys(s.subscriptions) {
+		if topicARN == "" || s.subscriptions[arn].topicARN == topicARN {
+			arns = append(arns, arn)
+		}
+	}
+
+	page, next, err := paginate(arns, input.Get("NextToken"), 100)
+	if err != nil {
+		return nil, err
+	}
+
+	members := xmlMembers{}
+	for _, arn := range page {
+		subscription := s.subscriptions[arn]
+		members = append(members, xmlObject{
+			"Endpoint":        subscription.endpoint,
+			"Owner":           AccountID,
+			"Protocol":        subscription.protocol,
+			"SubscriptionArn": subscription.arn,
+			"TopicArn":        subscription.topicARN,
+		})
+	}
+
+	return snsPage(xmlObject{"Subscriptions": members}, next), nil
+}
+
+func (s *snsService) listSubscriptions(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	return s.listSubscriptionsPage(input, "")
+}
+
+func (s *snsService) listSubscriptionsByTopic(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTopic(input.Get("TopicArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listSubscriptionsPage(input, topic.arn)
+}
+
+func (s *snsService) publish(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	if _, err := s.findTopic(input.Get("TopicArn")); err != nil {
+		return nil, err
+	}
+
+	return xmlObject{"MessageId": newID()}, nil
+}
+
+func (s *snsService) findTaggedTopic(arn string) (*snsTopic, error) {
+	topic, ok := s.topics[arn]
+	if !ok {
+		return nil, errNotFound("ResourceNotFound", "Resource does not exist")
+	}
+
+	return topic, nil
+}
+
+func (s *snsService) tagResource(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTaggedTopic(input.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(topic.tags, queryTags(input, "Tags.member"))
+
+	return nil, nil
+}
+
+func (s *snsService) untagResource(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTaggedTopic(input.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(topic.tags, queryStrings(input, "TagKeys.member"))
+
+	return nil, nil
+}
+
+func (s *snsService) listTagsForResource(_ context.Context, input url.Values) (any, error) {
+	defer s.lock()()
+
+	topic, err := s.findTaggedTopic(input.Get("ResourceArn"))
+	if err != nil {
+		return nil, err
+	}
+
+	return xmlObject{"Tags": xmlTags(topic.tags)}, nil
+}
@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // MD5 digests are part of the SQS API.
+	"encoding/hex"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqsService implements Amazon Simple Queue Service queues and messages.
+type sqsService struct {
+	store
+	baseURL    string
+	operations map[string]jsonOperation
+	queues     map[string]*sqsQueue // Keyed by name.
+}
+
+type sqsQueue struct {
+	arn        string
+	attributes map[string]string
+	created    time.Time
+	messages   []*sqsMessage
+	modified   time.Time
+	name       string
+	tags       map[string]string
+	url        string
+}
+
+type sqsMessage struct {
+	body          string
+	id            string
+	receiptHandle string
+	visibleAt     time.Time
+}
+
+// sqsQueryErrorCodes maps SQS error types to the legacy error codes returned for compatibility with the AWS Query protocol.
+var sqsQueryErrorCodes = map[string]string{
+	"QueueDeletedRecently":   "AWS.SimpleQueueService.QueueDeletedRecently",
+	"QueueDoesNotExist":      "AWS.SimpleQueueService.NonExistentQueue",
+	"QueueNameExists":        "QueueAlreadyExists",
+	"ReceiptHandleIsInvalid": "ReceiptHandleIsInvalid",
+}
+
+func newSQSService() *sqsService {
+	s := &sqsService{
+		queues: make(map[string]*sqsQueue),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"CreateQueue":        s.createQueue,
+		"DeleteMessage":      s.deleteMessage,
+		"DeleteQueue":        s.deleteQueue,
+		"GetQueueAttributes": s.getQueueAttributes,
+		"GetQueueUrl":        s.getQueueURL,
+		"ListQueueTags":      s.listQueueTags,
+		"ListQueues":         s.listQueues,
+		"PurgeQueue":         s.purgeQueue,
+		"ReceiveMessage":     s.receiveMessage,
+		"SendMessage":        s.sendMessage,
+		"SetQueueAttributes": s.setQueueAttributes,
+		"TagQueue":           s.tagQueue,
+		"UntagQueue":         s.untagQueue,
+	}
+
+	return s
+}
+
+func (s *sqsService) endpoint() string {
+	return "sqs"
+}
+
+func (s *sqsService) signingName() string {
+	return "sqs"
+}
+
+func (s *sqsService) setURL(url string) {
+	s.baseURL = url
+}
+
+func (s *sqsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.0", s.operations, func(err *apiError) http.Header {
+		if code, ok := sqsQueryErrorCodes[err.code]; ok {
+			return http.Header{"X-Amzn-Query-Error": {code + ";Sender"}}
+		}
+
+		return nil
+	})
+}
+
+func (s *sqsService) findQueue(url string) (*sqsQueue, error) {
+	name := url[strings.LastIndex(url, "/")+1:]
+
+	queue, ok := s.queues[name]
+	if !ok || queue.url != url {
+		return nil, errBadRequest("QueueDoesNotExist", "The specified queue does not exist.")
+	}
+
+	return queue, nil
+}
+
+// sqsDefaultAttributes returns the default values of a new queue's attributes.
+func sqsDefaultAttributes(attributes map[string]string) map[string]string {
+	defaults := map[string]string{
+		"DelaySeconds":                  "0",
+		"MaximumMessageSize":            "262144",
+		"MessageRetentionPeriod":        "345600",
+		"ReceiveMessageWaitTimeSeconds": "0",
+		"VisibilityTimeout":             "30",
+	}
+
+	if attributes["KmsMasterKeyId"] != "" {
+		defaults["KmsDataKeyReusePeriodSeconds"] = "300"
+		defaults["SqsManagedSseEnabled"] = "false"
+	} else {
+		defaults["SqsManagedSseEnabled"] = "true"
+	}
+
+	if attributes["FifoQueue"] == "true" {
+		defaults["ContentBasedDeduplication"] = "false"
+		defaults["DeduplicationScope"] = "queue"
+		defaults["FifoThroughputLimit"] = "perQueue"
+	}
+
+	return defaults
+}
+
+func (s *sqsService) createQueue(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("QueueName")
+	attributes := input.stringMap("Attributes")
+
+	if strings.HasSuffix(name, ".fifo") != (attributes["FifoQueue"] == "true") {
+		return nil, errBadRequest("InvalidParameterValue", "The name of a FIFO queue can only include alphanumeric characters, hyphens, or underscores, must end with .fifo suffix.")
+	}
+
+	if queue, ok := s.queues[name]; ok {
+		for k, v := range attributes {
+			if queue.attributes[k] != v {
+				return nil, errBadRequest("QueueNameExists", "A queue already exists with the same name and a different value for attribute %s", k)
+			}
+		}
+
+		return jsonObject{"QueueUrl": queue.url}, nil
+	}
+
+	now := time.Now()
+	queue := &sqsQueue{
+		arn:        arnString("sqs", regionFrom(ctx), name),
+		attributes: sqsDefaultAttributes(attributes),
+		created:    now,
+		modified:   now,
+		name:       name,
+		tags:       input.stringMap("tags"),
+		url:        s.baseURL + "/" + AccountID + "/" + name,
+	}
+	maps.Copy(queue.attributes, attributes)
+	s.queues[name] = queue
+
+	return jsonObject{"QueueUrl": queue.url}, nil
+}
+
+func (s *sqsService) getQueueURL(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, ok := s.queues[input.string("QueueName")]
+	if !ok {
+		return nil, errBadRequest("QueueDoesNotExist", "The specified queue does not exist.")
+	}
+
+	return jsonObject{"QueueUrl": queue.url}, nil
+}
+
+func (s *sqsService) getQueueAttributes(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	all := maps.Clone(queue.attributes)
+	all["ApproximateNumberOfMessages"] = strconv.Itoa(len(slices.DeleteFunc(slices.Clone(queue.messages), func(m *sqsMessage) bool { return m.visibleAt.After(now) })))
+	all["ApproximateNumberOfMessagesDelayed"] = "0"
+	all["ApproximateNumberOfMessagesNotVisible"] = strconv.Itoa(len(slices.DeleteFunc(slices.Clone(queue.messages), func(m *sqsMessage) bool { return !m.visibleAt.After(now) })))
+	all["CreatedTimestamp"] = strconv.FormatInt(queue.created.Unix(), 10)
+	all["LastModifiedTimestamp"] = strconv.FormatInt(queue.modified.Unix(), 10)
+	all["QueueArn"] = queue.arn
+
+	attributes := make(map[string]string)
+	for _, name := range input.strings("AttributeNames") {
+		if name == "All" {
+			attributes = all
+			break
+		}
+		if v, ok := all[name]; ok {
+			attributes[name] = v
+		}
+	}
+
+	return jsonObject{"Attributes": attributes}, nil
+}
+
+func (s *sqsService) setQueueAttributes(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range input.stringMap("Attributes") {
+		switch {
+		case k == "FifoQueue":
+			return nil, errBadRequest("InvalidAttributeName", "Unknown Attribute %s.", k)
+		case v == "" && (k == "Policy" || k == "RedrivePolicy" || k == "RedriveAllowPolicy" || k == "KmsMasterKeyId"):
+			delete(queue.attributes, k)
+		default:
+			queue.attributes[k] = v
+		}
+	}
+	queue.modified = time.Now()
+
+	return nil, nil
+}
+
+func (s *sqsService) deleteQueue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.queues, queue.name)
+
+	return nil, nil
+}
+
+func (s *sqsService) listQueues(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	var urls []string
+	for _, name := range sortedKeys(s.queues) {
+		if strings.HasPrefix(name, input.string("QueueNamePrefix")) {
+			urls = append(urls, s.queues[name].url)
+		}
+	}
+
+	limit := input.int("MaxResults", 0)
+	if limit == 0 {
+		// Without MaxResults, up to 1,000 results are returned without pagination.
+		if len(urls) > 1000 {
+			urls = urls[:1000]
+		}
+
+		return jsonObject{"QueueUrls": urls}, nil
+	}
+
+	page, next, err := paginate(urls, input.string("NextToken"), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := jsonObject{"QueueUrls": page}
+	if next != "" {
+		output["NextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *sqsService) tagQueue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(queue.tags, input.stringMap("Tags"))
+
+	return nil, nil
+}
+
+func (s *sqsService) untagQueue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(queue.tags, input.strings("TagKeys"))
+
+	return nil, nil
+}
+
+func (s *sqsService) listQueueTags(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"Tags": queue.tags}, nil
+}
+
+func sqsMD5(s string) string {
+	sum := md5.Sum([]byte(s)) //nolint:gosec // MD5 digests are part of the SQS API.
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *sqsService) sendMessage(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	delay, _ := strconv.Atoi(queue.attributes["DelaySeconds"])
+	message := &sqsMessage{
+		body:      input.string("MessageBody"),
+		id:        newID(),
+		visibleAt: time.Now().Add(time.Duration(input.int("DelaySeconds", delay)) * time.Second),
+	}
+	queue.messages = append(queue.messages, message)
+
+	return jsonObject{
+		"MD5OfMessageBody": sqsMD5(message.body),
+		"MessageId":        message.id,
+	}, nil
+}
+
+func (s *sqsService) receiveMessage(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	visibilityTimeout, _ := strconv.Atoi(queue.attributes["VisibilityTimeout"])
+	visibilityTimeout = input.int("VisibilityTimeout", visibilityTimeout)
+
+	now := time.Now()
+	messages := []any{}
+	for _, message := range queue.messages {
+		if len(messages) >= input.int("MaxNumberOfMessages", 1) {
+			break
+		}
+		if message.visibleAt.After(now) {
+			continue
+		}
+
+		message.receiptHandle = newID()
+		message.visibleAt = now.Add(time.Duration(visibilityTimeout) * time.Second)
+		messages = append(messages, jsonObject{
+			"Body":          message.body,
+			"MD5OfBody":     sqsMD5(message.body),
+			"MessageId":     message.id,
+			"ReceiptHandle": message.receiptHandle,
+		})
+	}
+
+	return jsonObject{"Messages": messages}, nil
+}
+
+func (s *sqsService) deleteMessage(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	handle := input.string("ReceiptHandle")
+	i := slices.IndexFunc(queue.messages, func(m *sqsMessage) bool { return m.receiptHandle == handle })
+	if i < 0 {
+		return nil, errBadRequest("ReceiptHandleIsInvalid", "The input receipt handle %q is not a valid receipt handle.", handle)
+	}
+
+	queue.messages = slices.Delete(queue.messages, i, i+1)
+
+	return nil, nil
+}
+
+func (s *sqsService) purgeQueue(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	queue, err := s.findQueue(input.string("QueueUrl"))
+	if err != nil {
+		return nil, err
+	}
+
+	queue.messages = nil
+
+	return nil, nil
+}
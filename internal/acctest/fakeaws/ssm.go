@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ssmService implements AWS Systems Manager Parameter Store.
+type ssmService struct {
+	store
+	operations map[string]jsonOperation
+	parameters map[string]*ssmParameter // Keyed by name.
+}
+
+type ssmParameter struct {
+	allowedPattern string
+	arn            string
+	dataType       string
+	description    string
+	keyID          string
+	modified       time.Time
+	name           string
+	tags           map[string]string
+	tier           string
+	type_          string
+	value          string
+	version        int
+}
+
+func newSSMService() *ssmService {
+	s := &ssmService{
+		parameters: make(map[string]*ssmParameter),
+	}
+
+	s.operations = map[string]jsonOperation{
+		"AddTagsToResource":      s.addTagsToResource,
+		"DeleteParameter":        s.deleteParameter,
+		"DeleteParameters":       s.deleteParameters,
+		"DescribeParameters":     s.describeParameters,
+		"GetParameter":           s.getParameter,
+		"GetParameters":          s.getParameters,
+		"GetParametersByPath":    s.getParametersByPath,
+		"ListTagsForResource":    s.listTagsForResource,
+		"PutParameter":           s.putParameter,
+		"RemoveTagsFromResource": s.removeTagsFromResource,
+	}
+
+	return s
+}
+
+func (s *ssmService) endpoint() string {
+	return "ssm"
+}
+
+func (s *ssmService) signingName() string {
+	return "ssm"
+}
+
+func (s *ssmService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveJSON(w, r, "application/x-amz-json-1.1", s.operations, nil)
+}
+
+func (s *ssmService) findParameter(name string) (*ssmParameter, error) {
+	parameter, ok := s.parameters[name]
+	if !ok {
+		return nil, errBadRequest("ParameterNotFound", "Parameter %s not found.", name)
+	}
+
+	return parameter, nil
+}
+
+func (p *ssmParameter) output(withDecryption bool) jsonObject {
+	value := p.value
+	if p.type_ == "SecureString" && !withDecryption {
+		// The value is encrypted.
+		value = "AQICAHjaHm5iZmFrZS1lbmNyeXB0ZWQtdmFsdWU="
+	}
+
+	return jsonObject{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.modified),
+		"Name":             p.name,
+		"Type":             p.type_,
+		"Value":            value,
+		"Version":          p.version,
+	}
+}
+
+func (p *ssmParameter) metadata() jsonObject {
+	v := jsonObject{
+		"ARN":              p.arn,
+		"DataType":         p.dataType,
+		"LastModifiedDate": epochSeconds(p.modified),
+		"LastModifiedUser": "arn:" + Partition + ":iam::" + AccountID + ":user/fake-aws",
+		"Name":             p.name,
+		"Policies":         []any{},
+		"Tier":             p.tier,
+		"Type":             p.type_,
+		"Version":          p.version,
+	}
+
+	if p.allowedPattern != "" {
+		v["AllowedPattern"] = p.allowedPattern
+	}
+	if p.description != "" {
+		v["Description"] = p.description
+	}
+	if p.keyID != "" {
+		v["KeyId"] = p.keyID
+	}
+
+	return v
+}
+
+func (s *ssmService) putParameter(ctx context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	name := input.string("Name")
+	parameter, exists := s.parameters[name]
+
+	switch {
+	case exists && !input.bool("Overwrite"):
+		return nil, errBadRequest("ParameterAlreadyExists", "The parameter already exists. To overwrite this value, set the overwrite option in the request to true.")
+	case exists && len(input.list("Tags")) > 0:
+		return nil, errBadRequest("ValidationException", "Invalid request: tags and overwrite can't be used together. To create a parameter with tags, please remove overwrite flag. To update tags for an existing parameter, please use AddTagsToResource or RemoveTagsFromResource.")
+	case !exists:
+		resource := "parameter/" + name
+		if strings.HasPrefix(name, "/") {
+			resource = "parameter" + name
+		}
+
+		parameter = &ssmParameter{
+			arn:      arnString("ssm", regionFrom(ctx), resource),
+			dataType: "text",
+			name:     name,
+			tags:     input.tagList("Tags", "Key", "Value"),
+			tier:     "Standard",
+			type_:    "String",
+		}
+	}
+
+	if v := input.string("Type"); v != "" {
+		parameter.type_ = v
+	}
+	if v := input.string("DataType"); v != "" {
+		parameter.dataType = v
+	}
+	if v := input.string("Tier"); v != "" && v != "Intelligent-Tiering" {
+		parameter.tier = v
+	}
+	if _, ok := input["Description"]; ok || !exists {
+		parameter.description = input.string("Description")
+	}
+	if _, ok := input["AllowedPattern"]; ok || !exists {
+		parameter.allowedPattern = input.string("AllowedPattern")
+	}
+	if parameter.type_ == "SecureString" {
+		parameter.keyID = input.string("KeyId")
+		if parameter.keyID == "" {
+			parameter.keyID = "alias/aws/ssm"
+		}
+	} else {
+		parameter.keyID = ""
+	}
+	parameter.modified = time.Now()
+	parameter.value = input.string("Value")
+	parameter.version++
+	s.parameters[name] = parameter
+
+	return jsonObject{
+		"Tier":    parameter.tier,
+		"Version": parameter.version,
+	}, nil
+}
+
+func (s *ssmService) getParameter(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameter, err := s.findParameter(input.string("Name"))
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"Parameter": parameter.output(input.bool("WithDecryption"))}, nil
+}
+
+func (s *ssmService) getParameters(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameters, invalid := []any{}, []string{}
+	for _, name := range input.strings("Names") {
+		if parameter, ok := s.parameters[name]; ok {
+			parameters = append(parameters, parameter.output(input.bool("WithDecryption")))
+		} else {
+			invalid = append(invalid, name)
+		}
+	}
+
+	return jsonObject{
+		"InvalidParameters": invalid,
+		"Parameters":        parameters,
+	}, nil
+}
+
+func (s *ssmService) getParametersByPath(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	path := input.string("Path")
+	if !strings.HasSuffix(path, "/") {
+		path += "/"
+	}
+
+	var names []string
+	for _, name := range sortedKeys(s.parameters) {
+		rest, ok := strings.CutPrefix(name, path)
+		if !ok || (!input.bool("Recursive") && strings.Contains(rest, "/")) {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	page, next, err := paginate(names, input.string("NextToken"), input.int("MaxResults", 10))
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := []any{}
+	for _, name := range page {
+		parameters = append(parameters, s.parameters[name].output(input.bool("WithDecryption")))
+	}
+
+	output := jsonObject{"Parameters": parameters}
+	if next != "" {
+		output["NextToken"] = next
+	}
+
+	return output, nil
+}
+
+// ssmParameterMatches returns whether a parameter matches the filters of a DescribeParameters request.
+// Only the Name, Path and Type filter keys are supported.
+func ssmParameterMatches(parameter *ssmParameter, filters []any) (bool, error) {
+	for _, v := range filters {
+		filter := jsonObject(v.(map[string]any))
+		values := filter.strings("Values")
+		option := filter.string("Option")
+
+		var match bool
+		switch key := filter.string("Key"); key {
+		case "Name":
+			match = slices.ContainsFunc(values, func(v string) bool {
+				if option == "BeginsWith" {
+					return strings.HasPrefix(parameter.name, v)
+				}
+				return parameter.name == v
+			})
+		case "Path":
+			match = slices.ContainsFunc(values, func(v string) bool {
+				rest, ok := strings.CutPrefix(parameter.name, strings.TrimSuffix(v, "/")+"/")
+				return ok && (option == "Recursive" || !strings.Contains(rest, "/"))
+			})
+		case "Type":
+			match = slices.Contains(values, parameter.type_)
+		default:
+			return false, errBadRequest("InvalidFilterKey", "fake SSM parameter filter key not supported: %s", key)
+		}
+
+		if !match {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (s *ssmService) describeParameters(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	var names []string
+	for _, name := range sortedKeys(s.parameters) {
+		match, err := ssmParameterMatches(s.parameters[name], input.list("ParameterFilters"))
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			names = append(names, name)
+		}
+	}
+
+	page, next, err := paginate(names, input.string("NextToken"), input.int("MaxResults", 50))
+	if err != nil {
+		return nil, err
+	}
+
+	parameters := []any{}
+	for _, name := range page {
+		parameters = append(parameters, s.parameters[name].metadata())
+	}
+
+	output := jsonObject{"Parameters": parameters}
+	if next != "" {
+		output["NextToken"] = next
+	}
+
+	return output, nil
+}
+
+func (s *ssmService) deleteParameter(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameter, err := s.findParameter(input.string("Name"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.parameters, parameter.name)
+
+	return nil, nil
+}
+
+func (s *ssmService) deleteParameters(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	deleted, invalid := []string{}, []string{}
+	for _, name := range input.strings("Names") {
+		if _, ok := s.parameters[name]; ok {
+			delete(s.parameters, name)
+			deleted = append(deleted, name)
+		} else {
+			invalid = append(invalid, name)
+		}
+	}
+
+	return jsonObject{
+		"DeletedParameters": deleted,
+		"InvalidParameters": invalid,
+	}, nil
+}
+
+func (s *ssmService) findTaggedParameter(input jsonObject) (*ssmParameter, error) {
+	if v := input.string("ResourceType"); v != "Parameter" {
+		return nil, errBadRequest("InvalidResourceType", "fake SSM resource type not supported: %s", v)
+	}
+
+	parameter, ok := s.parameters[input.string("ResourceId")]
+	if !ok {
+		return nil, errBadRequest("InvalidResourceId", "The resource ID is not valid. Verify that you entered the correct ID and try again.")
+	}
+
+	return parameter, nil
+}
+
+func (s *ssmService) addTagsToResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameter, err := s.findTaggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTags(parameter.tags, input.tagList("Tags", "Key", "Value"))
+
+	return nil, nil
+}
+
+func (s *ssmService) removeTagsFromResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameter, err := s.findTaggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	removeTags(parameter.tags, input.strings("TagKeys"))
+
+	return nil, nil
+}
+
+func (s *ssmService) listTagsForResource(_ context.Context, input jsonObject) (any, error) {
+	defer s.lock()()
+
+	parameter, err := s.findTaggedParameter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonObject{"TagList": jsonTags(parameter.tags, "Key", "Value")}, nil
+}
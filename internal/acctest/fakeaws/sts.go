@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeaws
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// stsService implements AWS Security Token Service caller identity.
+type stsService struct {
+	operations map[string]queryOperation
+}
+
+func newSTSService() *stsService {
+	s := &stsService{}
+
+	s.operations = map[string]queryOperation{
+		"GetCallerIdentity": s.getCallerIdentity,
+	}
+
+	return s
+}
+
+func (s *stsService) endpoint() string {
+	return "sts"
+}
+
+func (s *stsService) signingName() string {
+	return "sts"
+}
+
+func (s *stsService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveQuery(w, r, "https://sts.amazonaws.com/doc/2011-06-15/", s.operations)
+}
+
+func (s *stsService) getCallerIdentity(context.Context, url.Values) (any, error) {
+	return xmlObject{
+		"Account": AccountID,
+		"Arn":     "arn:" + Partition + ":iam::" + AccountID + ":user/fake-aws",
+		"UserId":  AccessKeyID,
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest/fakeaws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+func TestConfigureFakeAWS(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatalf("creating provider: %s", err)
+	}
+
+	if err := sdkdiag.DiagnosticsError(acctest.ConfigureFakeAWS(ctx, p)); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if got, want := meta.AccountID, fakeaws.AccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	if got, want := meta.Region, acctest.Region(); got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+
+	conn := meta.SQSClient(ctx)
+	queueName := t.Name()
+
+	if _, err := conn.CreateQueue(ctx, &sqs.CreateQueueInput{QueueName: aws.String(queueName)}); err != nil {
+		t.Fatalf("CreateQueue: %s", err)
+	}
+
+	output, err := conn.ListQueues(ctx, &sqs.ListQueuesInput{QueueNamePrefix: aws.String(queueName)})
+	if err != nil {
+		t.Fatalf("ListQueues: %s", err)
+	}
+
+	if got, want := len(output.QueueUrls), 1; got != want {
+		t.Errorf("len(QueueUrls) = %d, want %d", got, want)
+	}
+}
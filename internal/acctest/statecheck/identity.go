@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package statecheck
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ statecheck.StateCheck = expectIdentityCheck{}
+
+type expectIdentityCheck struct {
+	base           Base
+	servicePackage conns.ServicePackage
+}
+
+func (e expectIdentityCheck) CheckState(ctx context.Context, req statecheck.CheckStateRequest, resp *statecheck.CheckStateResponse) {
+	res, ok := e.base.ResourceFromState(req, resp)
+	if !ok {
+		return
+	}
+
+	var identitySpec *types.ServicePackageResourceIdentity
+	sp := e.servicePackage
+	for _, r := range sp.FrameworkResources(ctx) {
+		foo, _ := r.Factory(ctx)
+		var metadata resource.MetadataResponse
+		foo.Metadata(ctx, resource.MetadataRequest{}, &metadata)
+		if res.Type == metadata.TypeName {
+			identitySpec = r.Identity
+			break
+		}
+	}
+	if identitySpec == nil {
+		for _, r := range sp.SDKResources(ctx) {
+			if res.Type == r.TypeName {
+				identitySpec = r.Identity
+				break
+			}
+		}
+	}
+
+	if identitySpec == nil {
+		resp.Error = fmt.Errorf("no identity specification found for resource type %s", res.Type)
+		return
+	}
+
+	if res.IdentitySchemaVersion == nil || len(res.IdentityValues) == 0 {
+		resp.Error = fmt.Errorf("%s - Identity not found in state", e.base.ResourceAddress())
+		return
+	}
+
+	expected := map[string]any{
+		names.AttrAccountID: acctest.AccountID(),
+	}
+	if !names.IsGlobalService(sp.ServicePackageName()) {
+		region := acctest.Region()
+		if v, ok := res.AttributeValues[names.AttrRegion].(string); ok && v != "" {
+			region = v
+		}
+		expected[names.AttrRegion] = region
+	}
+	for _, attr := range identitySpec.IdentityAttributes() {
+		v, ok := res.AttributeValues[attr]
+		if !ok {
+			resp.Error = fmt.Errorf("attribute %q not found in resource %s", attr, e.base.ResourceAddress())
+			return
+		}
+		expected[attr] = v
+	}
+
+	if got, want := slices.Sorted(maps.Keys(res.IdentityValues)), slices.Sorted(maps.Keys(expected)); !slices.Equal(got, want) {
+		resp.Error = fmt.Errorf("%s - expected identity attributes %v, got %v", e.base.ResourceAddress(), want, got)
+		return
+	}
+
+	for k, want := range expected {
+		if got := res.IdentityValues[k]; got != want {
+			resp.Error = fmt.Errorf("%s - identity attribute %q: expected %v, got %v", e.base.ResourceAddress(), k, want, got)
+			return
+		}
+	}
+}
+
+// ExpectIdentity returns a state check that asserts that a resource's identity
+// contains the expected AWS account ID and Region and that its natural key attributes match the resource's state.
+func ExpectIdentity(servicePackage conns.ServicePackage, resourceAddress string) expectIdentityCheck {
+	return expectIdentityCheck{
+		base:           NewBase(resourceAddress),
+		servicePackage: servicePackage,
+	}
+}
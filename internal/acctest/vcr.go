@@ -7,27 +7,24 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
-	"io"
 	"math/rand"
 	"net/http"
 	"os"
 	"path/filepath"
-	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
@@ -107,6 +104,54 @@ func vcrMode() (recorder.Mode, error) {
 	}
 }
 
+// isVCRReplaying returns whether VCR is enabled in REPLAYING mode.
+func isVCRReplaying() bool {
+	if !isVCREnabled() {
+		return false
+	}
+
+	vcrMode, err := vcrMode()
+
+	return err == nil && vcrMode == recorder.ModeReplayOnly
+}
+
+// isVCROffline returns whether VCR cassettes are replayed without AWS credentials, e.g. in air-gapped CI.
+func isVCROffline() bool {
+	return isVCRReplaying() && os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" && os.Getenv(envvar.ContainerCredentialsFullURI) == ""
+}
+
+// vcrOfflineProviderConfig returns the provider configuration for replaying cassettes without AWS credentials.
+// Placeholder static credentials are used; all AWS API calls are replayed.
+func vcrOfflineProviderConfig() map[string]any {
+	return map[string]any{
+		"access_key":              vcrRedactedValue,
+		"secret_key":              vcrRedactedValue,
+		"skip_metadata_api_check": "true",
+	}
+}
+
+// configureVCROffline configures the test provider for replaying cassettes without AWS credentials.
+// The test provider makes no AWS API calls and reports the account ID to which cassettes are redacted.
+func configureVCROffline(ctx context.Context, t *testing.T) {
+	t.Helper()
+
+	t.Log("no AWS credentials configured, replaying VCR cassettes offline")
+
+	config := vcrOfflineProviderConfig()
+	config["region"] = Region()
+	config["skip_credentials_validation"] = true
+	config["skip_requesting_account_id"] = true
+
+	diags := Provider.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+	if err := sdkdiag.DiagnosticsError(diags); err != nil {
+		t.Fatalf("configuring provider: %s", err)
+	}
+
+	if v, ok := Provider.Meta().(*conns.AWSClient); ok {
+		v.AccountID = vcrRedactedAccountID
+	}
+}
+
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
@@ -164,97 +209,20 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
 
+		accountID := func() string {
+			if meta == nil {
+				return ""
+			}
+			return meta.AccountID
+		}
+
 		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		r, err := newVCRRecorder(ctx, path, testName, vcrMode, httpClient.Transport, accountID)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
-
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
-
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
 		// we must do this setup before calling the ConfigureContextFunc.
@@ -267,6 +235,20 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		meta.SetHTTPClient(ctx, httpClient)
 		provider.SetMeta(meta)
 
+		if isVCROffline() {
+			for k, v := range vcrOfflineProviderConfig() {
+				if err := d.Set(k, v); err != nil {
+					return nil, sdkdiag.AppendErrorf(diags, "setting %s for offline replay: %s", k, err)
+				}
+			}
+
+			if d.Get("region").(string) == "" {
+				if err := d.Set("region", Region()); err != nil {
+					return nil, sdkdiag.AppendErrorf(diags, "setting region for offline replay: %s", err)
+				}
+			}
+		}
+
 		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
 			return nil, append(diags, ds...)
 		} else {
@@ -290,6 +272,30 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// newVCRRecorder returns a VCR recorder that redacts interactions before they are saved and matches requests against redacted interactions.
+// testName is the name of the test whose redactors are applied and accountID returns the account ID used to record the cassette.
+func newVCRRecorder(ctx context.Context, path, testName string, mode recorder.Mode, realTransport http.RoundTripper, accountID func() string) (*recorder.Recorder, error) {
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          mode,
+		RealTransport: realTransport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove sensitive values before the cassette is saved.
+	r.AddHook(func(i *cassette.Interaction) error {
+		return redactVCRInteraction(i, testName, accountID())
+	}, recorder.BeforeSaveHook)
+
+	// Defines how VCR will match requests to responses.
+	r.SetMatcher(vcrMatcher(ctx, testName, accountID))
+
+	return r, nil
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -426,11 +432,13 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
+// ParallelTest wraps resource.ParallelTest, initializing VCR or the fake AWS services if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	if isFakeAWSEnabled() {
+		c.ProtoV5ProviderFactories = fakeAWSProtoV5ProviderFactories(ctx, c.ProtoV5ProviderFactories)
+	} else if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -438,11 +446,13 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled.
+// Test wraps resource.Test, initializing VCR or the fake AWS services if enabled.
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
-	if isVCREnabled() {
+	if isFakeAWSEnabled() {
+		c.ProtoV5ProviderFactories = fakeAWSProtoV5ProviderFactories(ctx, c.ProtoV5ProviderFactories)
+	} else if isVCREnabled() {
 		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
 		defer closeVCRRecorder(ctx, t)
 	}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+type vcrProtocol int
+
+const (
+	vcrProtocolUnknown vcrProtocol = iota
+	vcrProtocolJSON
+	vcrProtocolQuery
+	vcrProtocolXML
+)
+
+// vcrIdempotencyTokenFields are the names of request fields populated with a unique value for each request.
+// They are ignored when matching requests.
+var vcrIdempotencyTokenFields = []string{
+	"CallerReference",
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// vcrBodyProtocol returns the AWS protocol of a request body from its content type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodyProtocol(headers http.Header) vcrProtocol {
+	mediaType, _, err := mime.ParseMediaType(headers.Get("Content-Type"))
+	if err != nil {
+		return vcrProtocolUnknown
+	}
+
+	switch mediaType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		return vcrProtocolJSON
+	case "application/x-www-form-urlencoded":
+		return vcrProtocolQuery
+	case "application/xml", "text/xml":
+		return vcrProtocolXML
+	default:
+		return vcrProtocolUnknown
+	}
+}
+
+// vcrMatcher returns a function that defines how VCR matches requests to recorded interactions.
+// The request is first redacted as it would have been when recorded.
+// Methods must be equal and URLs must be equal ignoring the order of query parameters.
+// Bodies are compared ignoring JSON object key order, Query parameter order, XML attribute order and idempotency tokens.
+func vcrMatcher(ctx context.Context, testName string, accountID func() string) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		var body string
+		if r.Body != nil && r.Body != http.NoBody {
+			var b bytes.Buffer
+			if _, err := b.ReadFrom(r.Body); err != nil {
+				tflog.Debug(ctx, "Failed to read request body", map[string]any{
+					"error": err,
+				})
+				return false
+			}
+
+			r.Body = io.NopCloser(&b)
+			body = b.String()
+		}
+
+		request := cassette.Interaction{
+			Request: cassette.Request{
+				Body:    body,
+				Headers: r.Header.Clone(),
+				Method:  r.Method,
+				URL:     r.URL.String(),
+			},
+		}
+
+		if err := redactVCRInteraction(&request, testName, accountID()); err != nil {
+			tflog.Debug(ctx, "Failed to redact request", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		if !vcrURLsMatch(request.Request.URL, i.URL) {
+			return false
+		}
+
+		// If body matches identically, we are done.
+		if request.Request.Body == i.Body {
+			return true
+		}
+
+		ok, err := vcrBodiesMatch(vcrBodyProtocol(r.Header), request.Request.Body, i.Body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request bodies", map[string]any{
+				"error": err,
+			})
+			return false
+		}
+
+		return ok
+	}
+}
+
+// vcrURLsMatch returns whether two URLs are equal, ignoring the order of query parameters.
+func vcrURLsMatch(s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	u1, err := url.Parse(s1)
+	if err != nil {
+		return false
+	}
+	u2, err := url.Parse(s2)
+	if err != nil {
+		return false
+	}
+
+	q1, q2 := u1.Query(), u2.Query()
+	u1.RawQuery, u2.RawQuery = "", ""
+
+	return u1.String() == u2.String() && reflect.DeepEqual(q1, q2)
+}
+
+// vcrBodiesMatch returns whether two bodies in the specified protocol are equivalent.
+func vcrBodiesMatch(protocol vcrProtocol, body1, body2 string) (bool, error) {
+	switch protocol {
+	case vcrProtocolJSON:
+		v1, err := normalizeVCRJSON(body1)
+		if err != nil {
+			return false, fmt.Errorf("request: %w", err)
+		}
+		v2, err := normalizeVCRJSON(body2)
+		if err != nil {
+			return false, fmt.Errorf("cassette: %w", err)
+		}
+
+		return reflect.DeepEqual(v1, v2), nil
+
+	case vcrProtocolQuery:
+		v1, err := normalizeVCRQuery(body1)
+		if err != nil {
+			return false, fmt.Errorf("request: %w", err)
+		}
+		v2, err := normalizeVCRQuery(body2)
+		if err != nil {
+			return false, fmt.Errorf("cassette: %w", err)
+		}
+
+		return reflect.DeepEqual(v1, v2), nil
+
+	case vcrProtocolXML:
+		v1, err := normalizeVCRXML(body1)
+		if err != nil {
+			return false, fmt.Errorf("request: %w", err)
+		}
+		v2, err := normalizeVCRXML(body2)
+		if err != nil {
+			return false, fmt.Errorf("cassette: %w", err)
+		}
+
+		return v1 == v2, nil
+	}
+
+	return false, nil
+}
+
+// normalizeVCRJSON decodes a JSON body, removing any idempotency tokens.
+func normalizeVCRJSON(body string) (any, error) {
+	var v any
+
+	if body == "" {
+		return v, nil
+	}
+
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return nil, err
+	}
+
+	removeVCRIdempotencyTokensFromJSON(v)
+
+	return v, nil
+}
+
+func removeVCRIdempotencyTokensFromJSON(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isVCRIdempotencyTokenField(k) {
+				delete(v, k)
+				continue
+			}
+
+			removeVCRIdempotencyTokensFromJSON(e)
+		}
+	case []any:
+		for _, e := range v {
+			removeVCRIdempotencyTokensFromJSON(e)
+		}
+	}
+}
+
+// normalizeVCRQuery decodes a Query protocol body, removing any idempotency tokens.
+func normalizeVCRQuery(body string) (url.Values, error) {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return nil, err
+	}
+
+	for k := range values {
+		if isVCRIdempotencyTokenField(k) {
+			values.Del(k)
+		}
+	}
+
+	return values, nil
+}
+
+// vcrXMLElement is an XML element whose children are sorted, so that equivalent documents have equal canonical forms.
+type vcrXMLElement struct {
+	name     string
+	attrs    []string
+	text     string
+	children []*vcrXMLElement
+}
+
+func (e *vcrXMLElement) String() string {
+	var sb strings.Builder
+
+	sb.WriteString("<" + e.name)
+	for _, attr := range e.attrs {
+		sb.WriteString(" " + attr)
+	}
+	sb.WriteString(">" + e.text)
+	for _, child := range e.children {
+		sb.WriteString(child.String())
+	}
+	sb.WriteString("</" + e.name + ">")
+
+	return sb.String()
+}
+
+// normalizeVCRXML returns the canonical form of an XML body, removing any idempotency tokens.
+// Attribute order is ignored, as is whitespace around text. Element order is significant, e.g. for lists.
+func normalizeVCRXML(body string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	root := &vcrXMLElement{}
+	stack := []*vcrXMLElement{root}
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		parent := stack[len(stack)-1]
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			element := &vcrXMLElement{name: token.Name.Local}
+			for _, attr := range token.Attr {
+				element.attrs = append(element.attrs, attr.Name.Local+"="+attr.Value)
+			}
+			slices.Sort(element.attrs)
+			parent.children = append(parent.children, element)
+			stack = append(stack, element)
+
+		case xml.EndElement:
+			if len(stack) == 1 {
+				return "", fmt.Errorf("unexpected end element: %s", token.Name.Local)
+			}
+			stack = stack[:len(stack)-1]
+
+		case xml.CharData:
+			parent.text += strings.TrimSpace(string(token))
+		}
+	}
+
+	return canonicalizeVCRXML(root).String(), nil
+}
+
+func canonicalizeVCRXML(e *vcrXMLElement) *vcrXMLElement {
+	e.children = slices.DeleteFunc(e.children, func(child *vcrXMLElement) bool {
+		return isVCRIdempotencyTokenField(child.name)
+	})
+
+	for _, child := range e.children {
+		canonicalizeVCRXML(child)
+	}
+
+	return e
+}
+
+// isVCRIdempotencyTokenField returns whether the named field holds an idempotency token.
+func isVCRIdempotencyTokenField(name string) bool {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	for _, v := range vcrIdempotencyTokenFields {
+		if strings.EqualFold(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+const (
+	// vcrRedactedAccountID replaces AWS account IDs in VCR cassettes.
+	vcrRedactedAccountID = "123456789012"
+	// vcrRedactedValue replaces secret values in VCR cassettes.
+	vcrRedactedValue = "REDACTED"
+)
+
+// VCRRedactor removes sensitive values from a VCR interaction.
+// Redactors are applied to each interaction before its cassette is saved and to each request before it is matched against a cassette,
+// so a redactor must give the same result when applied to an already redacted interaction.
+type VCRRedactor func(i *cassette.Interaction) error
+
+var (
+	// vcrRedactors are applied to all VCR cassettes.
+	vcrRedactors = []VCRRedactor{
+		redactVCRHeaders,
+		redactVCRPresignedURL,
+		redactVCRARNAccountIDs,
+		redactVCRRequestSecretValues,
+	}
+
+	// vcrTestRedactors are the additional redactors applied to each test's VCR cassette, keyed by test name.
+	vcrTestRedactorsLock sync.Mutex
+	vcrTestRedactors     = make(map[string][]VCRRedactor)
+
+	// vcrSensitiveHeaders are removed from recorded requests.
+	vcrSensitiveHeaders = []string{
+		"Authorization",
+		"X-Amz-Security-Token",
+	}
+
+	// vcrSensitiveQueryParameters are the presigned URL query parameters whose values are redacted.
+	vcrSensitiveQueryParameters = []string{
+		"X-Amz-Credential",
+		"X-Amz-Security-Token",
+		"X-Amz-Signature",
+	}
+
+	// ARNs in bodies and URLs may be percent-encoded.
+	vcrARNAccountIDRegexp = regexp.MustCompile(`(arn(?::|%3[Aa])[0-9a-z-]+(?::|%3[Aa])[0-9a-z-]*(?::|%3[Aa])[0-9a-z-]*(?::|%3[Aa]))[0-9]{12}`)
+	vcrSecretXMLRegexp    = regexp.MustCompile(`(?i)<([0-9a-z_-]*(?:` + strings.Join(logging.SensitiveKeySuffixes(), "|") + `))>[^<]*</`)
+)
+
+// RegisterVCRRedactor adds a redactor to the pipeline applied to the test's VCR cassette.
+// Redactors run in registration order after the built-in redactors, which remove authorization headers,
+// presigned URL credentials, the account IDs in ARNs and well-known secret values in requests.
+// Register RedactVCRResponseSecrets to also remove well-known secret values from responses.
+func RegisterVCRRedactor(t *testing.T, r VCRRedactor) {
+	t.Helper()
+
+	testName := t.Name()
+
+	vcrTestRedactorsLock.Lock()
+	defer vcrTestRedactorsLock.Unlock()
+
+	vcrTestRedactors[testName] = append(vcrTestRedactors[testName], r)
+
+	t.Cleanup(func() {
+		vcrTestRedactorsLock.Lock()
+		defer vcrTestRedactorsLock.Unlock()
+
+		delete(vcrTestRedactors, testName)
+	})
+}
+
+// redactVCRInteraction applies the redaction pipeline for the named test to the specified interaction.
+// Any occurrence of accountID, the account ID used to record the cassette, is also redacted.
+func redactVCRInteraction(i *cassette.Interaction, testName, accountID string) error {
+	vcrTestRedactorsLock.Lock()
+	redactors := slices.Concat(vcrRedactors, vcrTestRedactors[testName])
+	vcrTestRedactorsLock.Unlock()
+
+	if accountID != "" && accountID != vcrRedactedAccountID {
+		redactors = append(redactors, vcrAccountIDRedactor(accountID))
+	}
+
+	for _, redactor := range redactors {
+		if err := redactor(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func redactVCRHeaders(i *cassette.Interaction) error {
+	for _, k := range vcrSensitiveHeaders {
+		delete(i.Request.Headers, k)
+	}
+
+	return nil
+}
+
+func redactVCRPresignedURL(i *cassette.Interaction) error {
+	u, err := url.Parse(i.Request.URL)
+	if err != nil {
+		return err
+	}
+
+	query := u.Query()
+	redacted := false
+	for _, k := range vcrSensitiveQueryParameters {
+		if query.Has(k) {
+			query.Set(k, vcrRedactedValue)
+			redacted = true
+		}
+	}
+
+	if redacted {
+		u.RawQuery = query.Encode()
+		i.Request.URL = u.String()
+	}
+
+	return nil
+}
+
+func redactVCRARNAccountIDs(i *cassette.Interaction) error {
+	return replaceInVCRInteraction(i, func(s string) string {
+		return vcrARNAccountIDRegexp.ReplaceAllString(s, "${1}"+vcrRedactedAccountID)
+	})
+}
+
+// vcrAccountIDRedactor returns a redactor that replaces all occurrences of the specified account ID.
+func vcrAccountIDRedactor(accountID string) VCRRedactor {
+	return func(i *cassette.Interaction) error {
+		return replaceInVCRInteraction(i, func(s string) string {
+			return strings.ReplaceAll(s, accountID, vcrRedactedAccountID)
+		})
+	}
+}
+
+// redactVCRRequestSecretValues redacts well-known secret values in requests.
+// Responses are not redacted so that replayed tests read back the values that they configured.
+func redactVCRRequestSecretValues(i *cassette.Interaction) error {
+	body, err := redactVCRSecretsInBody(i.Request.Body, i.Request.Headers)
+	if err != nil {
+		return err
+	}
+	setVCRRequestBody(&i.Request, body)
+
+	for k := range i.Request.Form {
+		if isVCRSecretField(k) {
+			i.Request.Form.Set(k, vcrRedactedValue)
+		}
+	}
+
+	return nil
+}
+
+// RedactVCRResponseSecrets is a VCRRedactor that redacts well-known secret values in responses.
+// Register it with RegisterVCRRedactor for tests whose responses contain real secrets, e.g. generated passwords or credentials,
+// and which do not read those values back during replay.
+func RedactVCRResponseSecrets(i *cassette.Interaction) error {
+	body, err := redactVCRSecretsInBody(i.Response.Body, i.Response.Headers)
+	if err != nil {
+		return err
+	}
+	setVCRResponseBody(&i.Response, body)
+
+	return nil
+}
+
+func redactVCRSecretsInBody(body string, headers http.Header) (string, error) {
+	if body == "" {
+		return body, nil
+	}
+
+	switch vcrBodyProtocol(headers) {
+	case vcrProtocolJSON:
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			// Not all bodies sent with a JSON content type are JSON, e.g. empty responses.
+			return body, nil //nolint:nilerr // OK
+		}
+
+		if !redactVCRSecretsInJSON(v) {
+			return body, nil
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+
+	case vcrProtocolQuery:
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return body, nil //nolint:nilerr // OK
+		}
+
+		redacted := false
+		for k := range values {
+			if isVCRSecretField(k) {
+				values.Set(k, vcrRedactedValue)
+				redacted = true
+			}
+		}
+
+		if !redacted {
+			return body, nil
+		}
+
+		return values.Encode(), nil
+
+	case vcrProtocolXML:
+		return vcrSecretXMLRegexp.ReplaceAllString(body, "<${1}>"+vcrRedactedValue+"</"), nil
+	}
+
+	return body, nil
+}
+
+// redactVCRSecretsInJSON redacts secret values in a decoded JSON value, returning whether any value was redacted.
+func redactVCRSecretsInJSON(v any) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if _, ok := e.(string); ok && isVCRSecretField(k) {
+				if e != vcrRedactedValue {
+					v[k] = vcrRedactedValue
+					redacted = true
+				}
+				continue
+			}
+
+			if redactVCRSecretsInJSON(e) {
+				redacted = true
+			}
+		}
+	case []any:
+		for _, e := range v {
+			if redactVCRSecretsInJSON(e) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
+
+// isVCRSecretField returns whether the named field holds a secret value.
+// Query protocol names such as "Credentials.SecretAccessKey" are matched on their final component.
+func isVCRSecretField(name string) bool {
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return logging.IsSensitiveKey(name)
+}
+
+// replaceInVCRInteraction applies f to an interaction's URL, bodies and form values.
+func replaceInVCRInteraction(i *cassette.Interaction, f func(string) string) error {
+	i.Request.URL = f(i.Request.URL)
+	setVCRRequestBody(&i.Request, f(i.Request.Body))
+	setVCRResponseBody(&i.Response, f(i.Response.Body))
+
+	for k, v := range i.Request.Form {
+		for j := range v {
+			v[j] = f(v[j])
+		}
+		i.Request.Form[k] = v
+	}
+
+	return nil
+}
+
+func setVCRRequestBody(r *cassette.Request, body string) {
+	if body == r.Body {
+		return
+	}
+
+	r.Body = body
+	if r.ContentLength > 0 {
+		r.ContentLength = int64(len(body))
+	}
+	if r.Headers.Get("Content-Length") != "" {
+		r.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+}
+
+func setVCRResponseBody(r *cassette.Response, body string) {
+	if body == r.Body {
+		return
+	}
+
+	r.Body = body
+	if r.ContentLength > 0 {
+		r.ContentLength = int64(len(body))
+	}
+	if r.Headers.Get("Content-Length") != "" {
+		r.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+}
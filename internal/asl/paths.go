@@ -0,0 +1,340 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package asl
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+var (
+	accountIDRegexp           = regexache.MustCompile(`^\d{12}$`)
+	partitionRegexp           = regexache.MustCompile(`^aws(-[a-z]+)*$`)
+	pathSubscriptRegexp       = regexache.MustCompile(`^\s*(-?\d+|'[^']*'|"[^"]*")\s*$`)
+	pathSubscriptListRegexp   = regexache.MustCompile(`^\s*(-?\d+|'[^']*'|"[^"]*"|-?\d*:-?\d*(:-?\d*)?)\s*$`)
+	serviceIntegrationRegexp  = regexache.MustCompile(`^[0-9a-z-]+(:[0-9a-z-]+)?:[0-9A-Za-z]+(\.(sync(:2)?|waitForTaskToken))?$`)
+	variableNameRegexp        = regexache.MustCompile(`^[A-Za-z_][0-9A-Za-z_]*`)
+	intrinsicFunctionNameList = []string{
+		"States.Array",
+		"States.ArrayContains",
+		"States.ArrayGetItem",
+		"States.ArrayLength",
+		"States.ArrayPartition",
+		"States.ArrayRange",
+		"States.ArrayUnique",
+		"States.Base64Decode",
+		"States.Base64Encode",
+		"States.Format",
+		"States.Hash",
+		"States.JsonMerge",
+		"States.JsonToString",
+		"States.MathAdd",
+		"States.MathRandom",
+		"States.StringSplit",
+		"States.StringToJson",
+		"States.UUID",
+	}
+)
+
+func (v *validator) validatePathField(location string, raw any, reference bool) {
+	path, ok := raw.(string)
+	if !ok {
+		v.errorf(CodeInvalidJSONPath, location, "value must be a JSONPath string, got %s", compact(raw))
+		return
+	}
+
+	if err := validateJSONPath(path, reference); err != nil {
+		v.errorf(CodeInvalidJSONPath, location, "%q is not a valid %s: %s", path, pathKind(reference), err)
+	}
+}
+
+// validatePayloadTemplate validates the values of fields whose names end in ".$",
+// which must be paths or intrinsic functions.
+func (v *validator) validatePayloadTemplate(location string, raw any) {
+	switch raw := raw.(type) {
+	case map[string]any:
+		for _, k := range sortedKeys(raw) {
+			fieldLocation := pointer(location, k)
+
+			if !strings.HasSuffix(k, ".$") {
+				v.validatePayloadTemplate(fieldLocation, raw[k])
+				continue
+			}
+
+			s, ok := raw[k].(string)
+			if !ok {
+				v.errorf(CodeInvalidJSONPath, fieldLocation, "value of %s must be a path or intrinsic function string, got %s", k, compact(raw[k]))
+				continue
+			}
+
+			switch {
+			case strings.HasPrefix(s, "States."):
+				if err := validateIntrinsicFunction(s); err != nil {
+					v.errorf(CodeInvalidJSONPath, fieldLocation, "%q is not a valid intrinsic function: %s", s, err)
+				}
+			default:
+				if err := validateJSONPath(s, false); err != nil {
+					v.errorf(CodeInvalidJSONPath, fieldLocation, "%q is not a valid path: %s", s, err)
+				}
+			}
+		}
+	case []any:
+		for i, item := range raw {
+			v.validatePayloadTemplate(pointer(location, i), item)
+		}
+	}
+}
+
+// validateJSONataExpressions validates the JSONata expressions, strings enclosed in "{%" and "%}", in a value.
+func (v *validator) validateJSONataExpressions(location string, raw any) {
+	switch raw := raw.(type) {
+	case string:
+		if !strings.HasPrefix(raw, "{%") {
+			return
+		}
+		if !strings.HasSuffix(raw, "%}") || len(raw) < len("{%%}") {
+			v.errorf(CodeInvalidJSONata, location, "JSONata expression %q must end with \"%%}\"", raw)
+			return
+		}
+		expression := strings.TrimSpace(raw[len("{%") : len(raw)-len("%}")])
+		if expression == "" {
+			v.errorf(CodeInvalidJSONata, location, "JSONata expression is empty")
+			return
+		}
+		if err := checkBalanced(expression, "'\"`"); err != nil {
+			v.errorf(CodeInvalidJSONata, location, "JSONata expression %q: %s", raw, err)
+		}
+	case map[string]any:
+		for _, k := range sortedKeys(raw) {
+			v.validateJSONataExpressions(pointer(location, k), raw[k])
+		}
+	case []any:
+		for i, item := range raw {
+			v.validateJSONataExpressions(pointer(location, i), item)
+		}
+	}
+}
+
+func pathKind(reference bool) string {
+	if reference {
+		return "reference path"
+	}
+	return "path"
+}
+
+// validateJSONPath validates a path, which is a JSONPath expression beginning with "$" (the effective input),
+// "$$" (the context object) or "$name" (a variable).
+// A reference path may only identify a single node, so it cannot contain wildcards, filters, slices or descendant operators.
+func validateJSONPath(path string, reference bool) error {
+	var i int
+	switch {
+	case strings.HasPrefix(path, "$$"):
+		i = 2
+	case strings.HasPrefix(path, "$"):
+		i = 1 + len(variableNameRegexp.FindString(path[1:]))
+	default:
+		return errors.New(`must begin with "$"`)
+	}
+
+	for i < len(path) {
+		switch path[i] {
+		case '.':
+			i++
+			if i < len(path) && path[i] == '.' {
+				if reference {
+					return errors.New("descendant operator (..) is not supported")
+				}
+				i++
+			}
+			if i < len(path) && path[i] == '*' {
+				if reference {
+					return errors.New("wildcard (*) is not supported")
+				}
+				i++
+				continue
+			}
+			start := i
+			for i < len(path) && path[i] != '.' && path[i] != '[' {
+				i++
+			}
+			if i == start {
+				return fmt.Errorf("missing field name at offset %d", start)
+			}
+		case '[':
+			end, err := matchingBracket(path, i)
+			if err != nil {
+				return err
+			}
+			if err := validatePathSubscript(path[i+1:end], reference); err != nil {
+				return err
+			}
+			i = end + 1
+		default:
+			return fmt.Errorf("unexpected character %q at offset %d", path[i], i)
+		}
+	}
+
+	return nil
+}
+
+func validatePathSubscript(subscript string, reference bool) error {
+	s := strings.TrimSpace(subscript)
+
+	switch {
+	case s == "":
+		return errors.New("empty subscript ([])")
+	case pathSubscriptRegexp.MatchString(s):
+		return nil
+	case reference:
+		return fmt.Errorf("subscript [%s] is not supported", subscript)
+	case s == "*", strings.HasPrefix(s, "?"), strings.HasPrefix(s, "("):
+		return nil
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		if !pathSubscriptListRegexp.MatchString(item) {
+			return fmt.Errorf("invalid subscript [%s]", subscript)
+		}
+	}
+
+	return nil
+}
+
+// matchingBracket returns the index of the "]" that closes the "[" at index start.
+func matchingBracket(s string, start int) (int, error) {
+	var depth int
+	var quote byte
+
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[' || c == '(':
+			depth++
+		case c == ']' || c == ')':
+			depth--
+			if depth == 0 {
+				if c != ']' {
+					return 0, fmt.Errorf("mismatched %q at offset %d", c, i)
+				}
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated subscript at offset %d", start)
+}
+
+// validateIntrinsicFunction validates an intrinsic function call, like "States.Format('{}', $.name)".
+func validateIntrinsicFunction(s string) error {
+	name, _, ok := strings.Cut(s, "(")
+	if !ok {
+		return errors.New("missing argument list")
+	}
+
+	if !slices.Contains(intrinsicFunctionNameList, name) {
+		return fmt.Errorf("unsupported function %q", name)
+	}
+
+	if !strings.HasSuffix(s, ")") {
+		return errors.New(`must end with ")"`)
+	}
+
+	return checkBalanced(s, "'")
+}
+
+// checkBalanced checks that the brackets, braces and parentheses in s that are not within quotes are balanced.
+func checkBalanced(s string, quotes string) error {
+	var stack []byte
+	var quote byte
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case strings.IndexByte(quotes, c) >= 0:
+			quote = c
+		case c == '(' || c == '[' || c == '{':
+			stack = append(stack, c)
+		case c == ')' || c == ']' || c == '}':
+			open := map[byte]byte{')': '(', ']': '[', '}': '{'}[c]
+			if len(stack) == 0 || stack[len(stack)-1] != open {
+				return fmt.Errorf("unexpected %q at offset %d", c, i)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal")
+	}
+	if len(stack) > 0 {
+		return fmt.Errorf("unclosed %q", stack[len(stack)-1])
+	}
+
+	return nil
+}
+
+// validateResourceARN validates the Resource of a Task state, which must be the ARN of an activity,
+// a Lambda function or a service integration.
+func validateResourceARN(s string) error {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid ARN", s)
+	}
+
+	if !partitionRegexp.MatchString(v.Partition) {
+		return fmt.Errorf("%q has an invalid partition %q", s, v.Partition)
+	}
+
+	switch v.Service {
+	case "states":
+		if v.Region == "" && v.AccountID == "" {
+			if !serviceIntegrationRegexp.MatchString(v.Resource) {
+				return fmt.Errorf("%q is not a valid service integration ARN, expected arn:%s:states:::service:api[.sync|.waitForTaskToken]", s, v.Partition)
+			}
+			return nil
+		}
+
+		if name, ok := strings.CutPrefix(v.Resource, "activity:"); !ok || name == "" {
+			return fmt.Errorf("%q is not a valid activity ARN", s)
+		}
+	case "lambda":
+		if name, ok := strings.CutPrefix(v.Resource, "function:"); !ok || name == "" {
+			return fmt.Errorf("%q is not a valid Lambda function ARN", s)
+		}
+	default:
+		return fmt.Errorf("%q is not the ARN of an activity, a Lambda function or a service integration", s)
+	}
+
+	if v.Region == "" {
+		return fmt.Errorf("%q is missing a Region", s)
+	}
+	if !accountIDRegexp.MatchString(v.AccountID) {
+		return fmt.Errorf("%q has an invalid account ID %q", s, v.AccountID)
+	}
+
+	return nil
+}
@@ -0,0 +1,665 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package asl validates Amazon States Language (ASL) state machine definitions locally,
+// without calling the AWS Step Functions API.
+// See https://states-language.net/spec.html and
+// https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html.
+package asl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "ERROR"
+	SeverityWarning Severity = "WARNING"
+)
+
+// Finding codes. Where the Step Functions ValidateStateMachineDefinition API reports the
+// same problem, the same code is used.
+const (
+	CodeDeprecatedField         = "DEPRECATED_FIELD"
+	CodeDuplicateStateName      = "DUPLICATE_STATE_NAME"
+	CodeInvalidErrorName        = "INVALID_ERROR_NAME"
+	CodeInvalidJSONDescription  = "INVALID_JSON_DESCRIPTION"
+	CodeInvalidJSONata          = "INVALID_JSONATA"
+	CodeInvalidJSONPath         = "INVALID_JSON_PATH"
+	CodeInvalidResource         = "INVALID_RESOURCE"
+	CodeMissingNextOrEnd        = "MISSING_NEXT_OR_END"
+	CodeMissingTransitionTarget = "MISSING_TRANSITION_TARGET"
+	CodeSchemaValidationFailed  = "SCHEMA_VALIDATION_FAILED"
+	CodeUnreachableState        = "UNREACHABLE_STATE"
+)
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+)
+
+// Finding is a problem found in a state machine definition.
+type Finding struct {
+	Severity Severity
+	Code     string
+	// Location is a JSON Pointer (RFC 6901) to the element of the definition that the finding refers to.
+	Location string
+	Message  string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s at %s (%s): %s", f.Severity, f.Location, f.Code, f.Message)
+}
+
+// Errors returns the findings with severity ERROR.
+func Errors(findings []Finding) []Finding {
+	return slices.DeleteFunc(slices.Clone(findings), func(f Finding) bool {
+		return f.Severity != SeverityError
+	})
+}
+
+// Validate validates a state machine definition and returns the problems found, ordered by location.
+// A definition without findings of severity ERROR may still be rejected by AWS, for example
+// because a referenced resource does not exist or the IAM role lacks permissions.
+func Validate(definition string) []Finding {
+	v := &validator{
+		stateNames: make(map[string]bool),
+	}
+
+	dec := json.NewDecoder(strings.NewReader(definition))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		v.errorf(CodeInvalidJSONDescription, "", "invalid JSON: %s", err)
+		return v.findings
+	}
+	if dec.More() {
+		v.errorf(CodeInvalidJSONDescription, "", "invalid JSON: unexpected data after top-level value")
+		return v.findings
+	}
+
+	m, ok := doc.(map[string]any)
+	if !ok {
+		v.errorf(CodeSchemaValidationFailed, "", "definition must be a JSON object")
+		return v.findings
+	}
+
+	queryLanguage := queryLanguageJSONPath
+	if raw, ok := m["QueryLanguage"]; ok {
+		switch s, _ := raw.(string); s {
+		case queryLanguageJSONata, queryLanguageJSONPath:
+			queryLanguage = s
+		default:
+			v.errorf(CodeSchemaValidationFailed, "/QueryLanguage", "QueryLanguage must be %q or %q", queryLanguageJSONPath, queryLanguageJSONata)
+		}
+	}
+	if raw, ok := m["TimeoutSeconds"]; ok {
+		if n, ok := integer(raw); !ok || n < 0 {
+			v.errorf(CodeSchemaValidationFailed, "/TimeoutSeconds", "TimeoutSeconds must be a non-negative integer")
+		}
+	}
+
+	v.validateStateMachine("", m, queryLanguage)
+
+	slices.SortStableFunc(v.findings, func(a, b Finding) int {
+		return strings.Compare(a.Location, b.Location)
+	})
+
+	return v.findings
+}
+
+type validator struct {
+	findings []Finding
+	// State names must be unique within the whole state machine, including branches and item processors.
+	stateNames map[string]bool
+}
+
+func (v *validator) errorf(code, location, format string, a ...any) {
+	v.findings = append(v.findings, Finding{
+		Severity: SeverityError,
+		Code:     code,
+		Location: location,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+func (v *validator) warnf(code, location, format string, a ...any) {
+	v.findings = append(v.findings, Finding{
+		Severity: SeverityWarning,
+		Code:     code,
+		Location: location,
+		Message:  fmt.Sprintf(format, a...),
+	})
+}
+
+// validateStateMachine validates the top-level state machine, a Parallel state branch or a Map state item processor.
+func (v *validator) validateStateMachine(location string, m map[string]any, queryLanguage string) {
+	startAt, ok := m["StartAt"].(string)
+	if !ok {
+		v.errorf(CodeSchemaValidationFailed, location, "StartAt is required and must be a string")
+	}
+
+	states, ok := m["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.errorf(CodeSchemaValidationFailed, location, "States is required and must be a non-empty object")
+		return
+	}
+
+	names := sortedKeys(states)
+	for _, name := range names {
+		stateLocation := pointer(location, "States", name)
+
+		if len(name) > 80 {
+			v.errorf(CodeSchemaValidationFailed, stateLocation, "state name %q exceeds 80 characters", name)
+		}
+		if v.stateNames[name] {
+			v.errorf(CodeDuplicateStateName, stateLocation, "state name %q is not unique within the state machine", name)
+		}
+		v.stateNames[name] = true
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			v.errorf(CodeSchemaValidationFailed, stateLocation, "state must be an object")
+			continue
+		}
+
+		v.validateState(stateLocation, state, states, queryLanguage)
+	}
+
+	if startAt == "" {
+		return
+	}
+	if _, ok := states[startAt]; !ok {
+		v.errorf(CodeMissingTransitionTarget, pointer(location, "StartAt"), "StartAt refers to state %q, which does not exist", startAt)
+		return
+	}
+
+	reachable := map[string]bool{startAt: true}
+	for queue := []string{startAt}; len(queue) > 0; queue = queue[1:] {
+		state, _ := states[queue[0]].(map[string]any)
+		for _, next := range transitions(state) {
+			if _, ok := states[next]; ok && !reachable[next] {
+				reachable[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	for _, name := range names {
+		if !reachable[name] {
+			v.errorf(CodeUnreachableState, pointer(location, "States", name), "state %q is not reachable from %q", name, startAt)
+		}
+	}
+}
+
+// transitions returns the names of the states that a state can transition to.
+func transitions(state map[string]any) []string {
+	var names []string
+
+	for _, k := range []string{"Next", "Default"} {
+		if s, ok := state[k].(string); ok {
+			names = append(names, s)
+		}
+	}
+	for _, k := range []string{"Choices", "Catch"} {
+		items, _ := state[k].([]any)
+		for _, item := range items {
+			if m, ok := item.(map[string]any); ok {
+				if s, ok := m["Next"].(string); ok {
+					names = append(names, s)
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+var (
+	// jsonPathFields are the fields of a state that are only supported when the query language is JSONPath.
+	jsonPathFields = []string{"CausePath", "ErrorPath", "InputPath", "ItemsPath", "OutputPath", "Parameters", "ResultPath", "ResultSelector", "SecondsPath", "TimestampPath"}
+	// jsonataFields are the fields of a state that are only supported when the query language is JSONata.
+	jsonataFields = []string{"Arguments", "Items", "Output"}
+)
+
+func (v *validator) validateState(location string, state, states map[string]any, parentQueryLanguage string) {
+	queryLanguage := parentQueryLanguage
+	if raw, ok := state["QueryLanguage"]; ok {
+		switch s, _ := raw.(string); s {
+		case queryLanguageJSONata:
+			queryLanguage = s
+		case queryLanguageJSONPath:
+			if parentQueryLanguage == queryLanguageJSONata {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, "QueryLanguage"), "QueryLanguage cannot be %q when the state machine uses %q", queryLanguageJSONPath, queryLanguageJSONata)
+			}
+		default:
+			v.errorf(CodeSchemaValidationFailed, pointer(location, "QueryLanguage"), "QueryLanguage must be %q or %q", queryLanguageJSONPath, queryLanguageJSONata)
+		}
+	}
+
+	stateType, _ := state["Type"].(string)
+	switch stateType {
+	case "Choice", "Fail", "Map", "Parallel", "Pass", "Succeed", "Task", "Wait":
+	case "":
+		v.errorf(CodeSchemaValidationFailed, location, "Type is required and must be a string")
+		return
+	default:
+		v.errorf(CodeSchemaValidationFailed, pointer(location, "Type"), "unsupported state type %q", stateType)
+		return
+	}
+
+	v.validateTransition(location, stateType, state, states)
+
+	switch queryLanguage {
+	case queryLanguageJSONata:
+		for _, k := range jsonPathFields {
+			if _, ok := state[k]; ok {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "%s is not supported when QueryLanguage is %q", k, queryLanguageJSONata)
+			}
+		}
+		for _, k := range sortedKeys(state) {
+			switch k {
+			case "Branches", "ItemProcessor", "Iterator":
+				// Validated as nested state machines.
+			default:
+				v.validateJSONataExpressions(pointer(location, k), state[k])
+			}
+		}
+	default:
+		for _, k := range jsonataFields {
+			if _, ok := state[k]; ok {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "%s is not supported when QueryLanguage is %q", k, queryLanguageJSONPath)
+			}
+		}
+		for _, k := range []string{"InputPath", "OutputPath"} {
+			if raw, ok := state[k]; ok && raw != nil {
+				v.validatePathField(pointer(location, k), raw, false)
+			}
+		}
+		for _, k := range []string{"ItemsPath", "ResultPath", "SecondsPath", "TimestampPath"} {
+			if raw, ok := state[k]; ok && !(k == "ResultPath" && raw == nil) {
+				v.validatePathField(pointer(location, k), raw, true)
+			}
+		}
+		for _, k := range []string{"Assign", "ItemSelector", "Parameters", "ResultSelector"} {
+			if raw, ok := state[k]; ok {
+				v.validatePayloadTemplate(pointer(location, k), raw)
+			}
+		}
+	}
+
+	switch stateType {
+	case "Choice":
+		v.validateChoice(location, state, states, queryLanguage)
+	case "Map":
+		processor, hasProcessor := state["ItemProcessor"]
+		iterator, hasIterator := state["Iterator"]
+		switch {
+		case hasProcessor && hasIterator:
+			v.errorf(CodeSchemaValidationFailed, location, "only one of ItemProcessor or Iterator can be specified")
+		case hasIterator:
+			v.warnf(CodeDeprecatedField, pointer(location, "Iterator"), "Iterator is deprecated, use ItemProcessor")
+			processor, hasProcessor = iterator, true
+		}
+		if _, ok := state["Parameters"]; ok && queryLanguage == queryLanguageJSONPath {
+			v.warnf(CodeDeprecatedField, pointer(location, "Parameters"), "Parameters is deprecated in Map states, use ItemSelector")
+		}
+		if !hasProcessor {
+			v.errorf(CodeSchemaValidationFailed, location, "ItemProcessor is required for Map states")
+		} else if m, ok := processor.(map[string]any); !ok {
+			v.errorf(CodeSchemaValidationFailed, pointer(location, "ItemProcessor"), "ItemProcessor must be an object")
+		} else {
+			processorLocation := pointer(location, "ItemProcessor")
+			if hasIterator {
+				processorLocation = pointer(location, "Iterator")
+			}
+			v.validateStateMachine(processorLocation, m, queryLanguage)
+		}
+		v.validateRetryAndCatch(location, state, states)
+	case "Parallel":
+		branches, ok := state["Branches"].([]any)
+		if !ok || len(branches) == 0 {
+			v.errorf(CodeSchemaValidationFailed, location, "Branches is required and must be a non-empty array")
+		}
+		for i, branch := range branches {
+			if m, ok := branch.(map[string]any); ok {
+				v.validateStateMachine(pointer(location, "Branches", i), m, queryLanguage)
+			} else {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, "Branches", i), "branch must be an object")
+			}
+		}
+		v.validateRetryAndCatch(location, state, states)
+	case "Task":
+		if raw, ok := state["Resource"]; !ok {
+			v.errorf(CodeSchemaValidationFailed, location, "Resource is required for Task states")
+		} else if s, ok := raw.(string); !ok {
+			v.errorf(CodeSchemaValidationFailed, pointer(location, "Resource"), "Resource must be a string")
+		} else if err := validateResourceARN(s); err != nil {
+			v.errorf(CodeInvalidResource, pointer(location, "Resource"), "%s", err)
+		}
+		v.validateRetryAndCatch(location, state, states)
+	case "Wait":
+		var n int
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			v.errorf(CodeSchemaValidationFailed, location, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath is required for Wait states")
+		}
+	}
+}
+
+func (v *validator) validateTransition(location, stateType string, state, states map[string]any) {
+	next, hasNext := state["Next"]
+	end, hasEnd := state["End"]
+
+	switch stateType {
+	case "Choice", "Fail", "Succeed":
+		for _, k := range []string{"End", "Next"} {
+			if _, ok := state[k]; ok {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "%s is not supported in %s states", k, stateType)
+			}
+		}
+		return
+	}
+
+	if hasEnd {
+		if b, ok := end.(bool); !ok {
+			v.errorf(CodeSchemaValidationFailed, pointer(location, "End"), "End must be a boolean")
+		} else if !b {
+			hasEnd = false
+		}
+	}
+
+	switch {
+	case hasNext && hasEnd:
+		v.errorf(CodeSchemaValidationFailed, location, "only one of Next or End can be specified")
+	case !hasNext && !hasEnd:
+		v.errorf(CodeMissingNextOrEnd, location, "%s states must have either Next or \"End\": true", stateType)
+	case hasNext:
+		v.validateTransitionTarget(pointer(location, "Next"), next, states)
+	}
+}
+
+func (v *validator) validateTransitionTarget(location string, raw any, states map[string]any) {
+	name, ok := raw.(string)
+	if !ok {
+		v.errorf(CodeSchemaValidationFailed, location, "transition target must be a string")
+		return
+	}
+
+	if _, ok := states[name]; !ok {
+		v.errorf(CodeMissingTransitionTarget, location, "state %q does not exist", name)
+	}
+}
+
+// predefinedErrorNames are the error names defined by the States language and Step Functions.
+var predefinedErrorNames = []string{
+	"States.ALL",
+	"States.BranchFailed",
+	"States.DataLimitExceeded",
+	"States.ExceedToleratedFailureThreshold",
+	"States.HeartbeatTimeout",
+	"States.Http.Socket",
+	"States.IntrinsicFailure",
+	"States.ItemReaderFailed",
+	"States.NoChoiceMatched",
+	"States.ParameterPathFailure",
+	"States.Permissions",
+	"States.QueryEvaluationError",
+	"States.ResultPathMatchFailure",
+	"States.ResultWriterFailed",
+	"States.Runtime",
+	"States.TaskFailed",
+	"States.Timeout",
+}
+
+func (v *validator) validateRetryAndCatch(location string, state, states map[string]any) {
+	for _, field := range []string{"Retry", "Catch"} {
+		raw, ok := state[field]
+		if !ok {
+			continue
+		}
+
+		items, ok := raw.([]any)
+		if !ok {
+			v.errorf(CodeSchemaValidationFailed, pointer(location, field), "%s must be an array", field)
+			continue
+		}
+
+		for i, item := range items {
+			itemLocation := pointer(location, field, i)
+
+			m, ok := item.(map[string]any)
+			if !ok {
+				v.errorf(CodeSchemaValidationFailed, itemLocation, "%s entry must be an object", field)
+				continue
+			}
+
+			v.validateErrorEquals(pointer(itemLocation, "ErrorEquals"), m["ErrorEquals"], i == len(items)-1)
+
+			switch field {
+			case "Catch":
+				if next, ok := m["Next"]; !ok {
+					v.errorf(CodeSchemaValidationFailed, itemLocation, "Next is required for Catch entries")
+				} else {
+					v.validateTransitionTarget(pointer(itemLocation, "Next"), next, states)
+				}
+			case "Retry":
+				if raw, ok := m["MaxAttempts"]; ok {
+					if n, ok := integer(raw); !ok || n < 0 {
+						v.errorf(CodeSchemaValidationFailed, pointer(itemLocation, "MaxAttempts"), "MaxAttempts must be a non-negative integer")
+					}
+				}
+				if raw, ok := m["IntervalSeconds"]; ok {
+					if n, ok := integer(raw); !ok || n < 1 {
+						v.errorf(CodeSchemaValidationFailed, pointer(itemLocation, "IntervalSeconds"), "IntervalSeconds must be a positive integer")
+					}
+				}
+				if raw, ok := m["BackoffRate"]; ok {
+					if n, ok := number(raw); !ok || n < 1 {
+						v.errorf(CodeSchemaValidationFailed, pointer(itemLocation, "BackoffRate"), "BackoffRate must be a number greater than or equal to 1.0")
+					}
+				}
+			}
+		}
+	}
+}
+
+func (v *validator) validateErrorEquals(location string, raw any, last bool) {
+	names, ok := raw.([]any)
+	if !ok || len(names) == 0 {
+		v.errorf(CodeSchemaValidationFailed, location, "ErrorEquals is required and must be a non-empty array")
+		return
+	}
+
+	for i, raw := range names {
+		name, ok := raw.(string)
+		if !ok || name == "" {
+			v.errorf(CodeInvalidErrorName, pointer(location, i), "error name must be a non-empty string")
+			continue
+		}
+
+		if strings.HasPrefix(name, "States.") && !slices.Contains(predefinedErrorNames, name) {
+			v.errorf(CodeInvalidErrorName, pointer(location, i), "%q is not a predefined error name", name)
+		}
+
+		if name == "States.ALL" {
+			if len(names) != 1 {
+				v.errorf(CodeInvalidErrorName, pointer(location, i), "States.ALL must appear alone in ErrorEquals")
+			}
+			if !last {
+				v.errorf(CodeInvalidErrorName, pointer(location, i), "States.ALL must appear in the last retrier or catcher")
+			}
+		}
+	}
+}
+
+func (v *validator) validateChoice(location string, state, states map[string]any, queryLanguage string) {
+	choices, ok := state["Choices"].([]any)
+	if !ok || len(choices) == 0 {
+		v.errorf(CodeSchemaValidationFailed, location, "Choices is required and must be a non-empty array")
+	}
+
+	for i, choice := range choices {
+		v.validateChoiceRule(pointer(location, "Choices", i), choice, states, queryLanguage, true)
+	}
+
+	if raw, ok := state["Default"]; ok {
+		v.validateTransitionTarget(pointer(location, "Default"), raw, states)
+	}
+}
+
+// comparisonOperators are the data-test expression operators of JSONPath Choice rules.
+var comparisonOperators = func() map[string]bool {
+	operators := map[string]bool{
+		"BooleanEquals":     true,
+		"BooleanEqualsPath": true,
+		"IsBoolean":         true,
+		"IsNull":            true,
+		"IsNumeric":         true,
+		"IsPresent":         true,
+		"IsString":          true,
+		"IsTimestamp":       true,
+		"StringMatches":     true,
+	}
+	for _, typ := range []string{"Numeric", "String", "Timestamp"} {
+		for _, comparison := range []string{"Equals", "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals"} {
+			operators[typ+comparison] = true
+			operators[typ+comparison+"Path"] = true
+		}
+	}
+	return operators
+}()
+
+func (v *validator) validateChoiceRule(location string, raw any, states map[string]any, queryLanguage string, top bool) {
+	rule, ok := raw.(map[string]any)
+	if !ok {
+		v.errorf(CodeSchemaValidationFailed, location, "Choice rule must be an object")
+		return
+	}
+
+	if next, ok := rule["Next"]; top && !ok {
+		v.errorf(CodeSchemaValidationFailed, location, "Next is required for top-level Choice rules")
+	} else if top {
+		v.validateTransitionTarget(pointer(location, "Next"), next, states)
+	} else if ok {
+		v.errorf(CodeSchemaValidationFailed, pointer(location, "Next"), "Next is only supported in top-level Choice rules")
+	}
+
+	if queryLanguage == queryLanguageJSONata {
+		if _, ok := rule["Condition"].(string); !ok {
+			v.errorf(CodeSchemaValidationFailed, location, "Condition is required and must be a string when QueryLanguage is %q", queryLanguageJSONata)
+		}
+		for _, k := range sortedKeys(rule) {
+			switch k {
+			case "Assign", "Comment", "Condition", "Next", "Output":
+			default:
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "%s is not supported in Choice rules when QueryLanguage is %q", k, queryLanguageJSONata)
+			}
+		}
+		return
+	}
+
+	var operators []string
+	for _, k := range sortedKeys(rule) {
+		switch k {
+		case "Assign":
+			v.validatePayloadTemplate(pointer(location, k), rule[k])
+		case "Comment", "Next":
+		case "Variable":
+			v.validatePathField(pointer(location, k), rule[k], false)
+		case "And", "Or":
+			operators = append(operators, k)
+			items, ok := rule[k].([]any)
+			if !ok || len(items) == 0 {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "%s must be a non-empty array", k)
+			}
+			for i, item := range items {
+				v.validateChoiceRule(pointer(location, k, i), item, states, queryLanguage, false)
+			}
+		case "Not":
+			operators = append(operators, k)
+			v.validateChoiceRule(pointer(location, k), rule[k], states, queryLanguage, false)
+		default:
+			if !comparisonOperators[k] {
+				v.errorf(CodeSchemaValidationFailed, pointer(location, k), "unsupported Choice rule field %q", k)
+				continue
+			}
+			operators = append(operators, k)
+			if strings.HasSuffix(k, "Path") {
+				v.validatePathField(pointer(location, k), rule[k], false)
+			}
+		}
+	}
+
+	switch len(operators) {
+	case 0:
+		v.errorf(CodeSchemaValidationFailed, location, "Choice rule must have a comparison operator, And, Or or Not")
+	case 1:
+		switch operators[0] {
+		case "And", "Not", "Or":
+		default:
+			if _, ok := rule["Variable"]; !ok {
+				v.errorf(CodeSchemaValidationFailed, location, "Variable is required for comparison operator %s", operators[0])
+			}
+		}
+	default:
+		v.errorf(CodeSchemaValidationFailed, location, "Choice rule must have exactly one of a comparison operator, And, Or or Not, got %s", strings.Join(operators, ", "))
+	}
+}
+
+func pointer(location string, tokens ...any) string {
+	var sb strings.Builder
+	sb.WriteString(location)
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(fmt.Sprint(token)))
+	}
+	return sb.String()
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func integer(raw any) (int64, bool) {
+	n, ok := raw.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	i, err := n.Int64()
+	return i, err == nil
+}
+
+func number(raw any) (float64, bool) {
+	n, ok := raw.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+// compact returns the compact JSON encoding of a value, for use in messages.
+func compact(raw any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(raw); err != nil {
+		return fmt.Sprint(raw)
+	}
+	return strings.TrimSpace(buf.String())
+}
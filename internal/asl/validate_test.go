@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package asl_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/asl"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		want       []string
+	}{
+		"valid": {
+			definition: `{
+  "Comment": "Order processing",
+  "StartAt": "Validate",
+  "TimeoutSeconds": 300,
+  "States": {
+    "Validate": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Parameters": {
+        "FunctionName": "arn:aws:lambda:us-west-2:123456789012:function:validate:$LATEST",
+        "Payload.$": "$",
+        "ExecutionId.$": "$$.Execution.Id",
+        "Message.$": "States.Format('Order {} received', $.order['id'])"
+      },
+      "ResultSelector": {"valid.$": "$.Payload.valid"},
+      "ResultPath": "$.validation",
+      "Retry": [
+        {"ErrorEquals": ["Lambda.TooManyRequestsException"], "IntervalSeconds": 2, "MaxAttempts": 3, "BackoffRate": 2.0},
+        {"ErrorEquals": ["States.ALL"], "MaxAttempts": 0}
+      ],
+      "Catch": [{"ErrorEquals": ["States.ALL"], "ResultPath": "$.error", "Next": "Failed"}],
+      "Next": "IsValid"
+    },
+    "IsValid": {
+      "Type": "Choice",
+      "Choices": [
+        {
+          "And": [
+            {"Variable": "$.validation.valid", "BooleanEquals": true},
+            {"Not": {"Variable": "$.items[0]", "IsNull": true}}
+          ],
+          "Next": "ProcessItems"
+        }
+      ],
+      "Default": "Failed"
+    },
+    "ProcessItems": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemSelector": {"item.$": "$$.Map.Item.Value"},
+      "ItemProcessor": {
+        "StartAt": "Ship",
+        "States": {
+          "Ship": {"Type": "Task", "Resource": "arn:aws:states:us-west-2:123456789012:activity:ship", "End": true}
+        }
+      },
+      "ResultPath": null,
+      "Next": "Notify"
+    },
+    "Notify": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "Email", "States": {"Email": {"Type": "Task", "Resource": "arn:aws:states:::sns:publish.waitForTaskToken", "End": true}}},
+        {"StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Seconds": 10, "Next": "Done"}, "Done": {"Type": "Succeed"}}}
+      ],
+      "OutputPath": "$[0]",
+      "End": true
+    },
+    "Failed": {"Type": "Fail", "Error": "OrderFailed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Get",
+  "States": {
+    "Get": {
+      "Type": "Task",
+      "Resource": "arn:aws-us-gov:states:::aws-sdk:s3:getObject",
+      "Arguments": {"Bucket": "{% $states.input.bucket %}", "Key": "{% $states.input.key %}"},
+      "Output": {"body": "{% $states.result.Body %}"},
+      "Next": "Check"
+    },
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.body = 'ok' %}", "Next": "Done"}],
+      "Default": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"invalid JSON": {
+			definition: `{"StartAt": "A",`,
+			want:       []string{"ERROR INVALID_JSON_DESCRIPTION "},
+		},
+		"not an object": {
+			definition: `["StartAt"]`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED "},
+		},
+		"missing States": {
+			definition: `{"StartAt": "A"}`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED "},
+		},
+		"StartAt does not exist": {
+			definition: `{"StartAt": "B", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			want:       []string{"ERROR MISSING_TRANSITION_TARGET /StartAt"},
+		},
+		"unreachable state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}, "B": {"Type": "Succeed"}}}`,
+			want:       []string{"ERROR UNREACHABLE_STATE /States/B"},
+		},
+		"missing Next or End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}, "B": {"Type": "Pass"}}}`,
+			want:       []string{"ERROR MISSING_NEXT_OR_END /States/B"},
+		},
+		"Next does not exist": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "C"}}}`,
+			want:       []string{"ERROR MISSING_TRANSITION_TARGET /States/A/Next"},
+		},
+		"End in Succeed state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "End": true}}}`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED /States/A/End"},
+		},
+		"unsupported state type": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Lambda", "End": true}}}`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED /States/A/Type"},
+		},
+		"Choice rule typo": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "StringEqual": "y", "Next": "B"}], "Default": "C"},
+  "B": {"Type": "Succeed"},
+  "C": {"Type": "Fail"}
+}}`,
+			want: []string{
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/Choices/0",
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/Choices/0/StringEqual",
+			},
+		},
+		"Choice rule Next does not exist": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "NumericGreaterThanPath": "$.y", "Next": "D"}], "Default": "B"},
+  "B": {"Type": "Succeed"}
+}}`,
+			want: []string{"ERROR MISSING_TRANSITION_TARGET /States/A/Choices/0/Next"},
+		},
+		"nested Choice rule with Next": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Choice", "Choices": [{"Not": {"Variable": "$.x", "IsPresent": true, "Next": "B"}, "Next": "B"}]},
+  "B": {"Type": "Succeed"}
+}}`,
+			want: []string{"ERROR SCHEMA_VALIDATION_FAILED /States/A/Choices/0/Not/Next"},
+		},
+		"invalid paths": {
+			definition: `{"StartAt": "A", "States": {"A": {
+  "Type": "Pass",
+  "InputPath": "input",
+  "ResultPath": "$.items[*]",
+  "OutputPath": "$.a[0",
+  "Parameters": {"x.$": "$..name", "y.$": "States.Concat($.a)", "z.$": 1, "nested": [{"w.$": "$.a..b"}]},
+  "End": true
+}}}`,
+			want: []string{
+				"ERROR INVALID_JSON_PATH /States/A/InputPath",
+				"ERROR INVALID_JSON_PATH /States/A/OutputPath",
+				"ERROR INVALID_JSON_PATH /States/A/Parameters/y.$",
+				"ERROR INVALID_JSON_PATH /States/A/Parameters/z.$",
+				"ERROR INVALID_JSON_PATH /States/A/ResultPath",
+			},
+		},
+		"JSONata fields in JSONPath state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Output": {"x": 1}, "End": true}}}`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED /States/A/Output"},
+		},
+		"invalid JSONata": {
+			definition: `{"StartAt": "A", "States": {"A": {
+  "Type": "Pass",
+  "QueryLanguage": "JSONata",
+  "Output": {"a": "{% $states.input.a ", "b": "{% %}", "c": "{% $count($states.input.items %}"},
+  "ResultPath": "$.x",
+  "End": true
+}}}`,
+			want: []string{
+				"ERROR INVALID_JSONATA /States/A/Output/a",
+				"ERROR INVALID_JSONATA /States/A/Output/b",
+				"ERROR INVALID_JSONATA /States/A/Output/c",
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/ResultPath",
+			},
+		},
+		"JSONPath state in JSONata state machine": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "QueryLanguage": "JSONPath", "End": true}}}`,
+			want:       []string{"ERROR SCHEMA_VALIDATION_FAILED /States/A/QueryLanguage"},
+		},
+		"error names": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {
+    "Type": "Task",
+    "Resource": "arn:aws:states:::ecs:runTask.sync",
+    "Retry": [
+      {"ErrorEquals": ["States.ALL", "States.Timeout"]},
+      {"ErrorEquals": ["States.TaskFailure"], "BackoffRate": 0.5}
+    ],
+    "Catch": [{"ErrorEquals": [], "Next": "B"}, {"ErrorEquals": ["States.ALL"]}],
+    "End": true
+  },
+  "B": {"Type": "Fail"}
+}}`,
+			want: []string{
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/Catch/0/ErrorEquals",
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/Catch/1",
+				"ERROR INVALID_ERROR_NAME /States/A/Retry/0/ErrorEquals/0",
+				"ERROR INVALID_ERROR_NAME /States/A/Retry/0/ErrorEquals/0",
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A/Retry/1/BackoffRate",
+				"ERROR INVALID_ERROR_NAME /States/A/Retry/1/ErrorEquals/0",
+			},
+		},
+		"resource ARNs": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Task", "Resource": "not-an-arn", "Next": "B"},
+  "B": {"Type": "Task", "Resource": "arn:aws:lambda:us-east-1:123456789012:layer:example", "Next": "C"},
+  "C": {"Type": "Task", "Resource": "arn:aws:states:::lambda", "Next": "D"},
+  "D": {"Type": "Task", "Resource": "arn:aws:s3:::example", "Next": "E"},
+  "E": {"Type": "Task", "Resource": "arn:aws:states:us-east-1:12345:activity:example", "Next": "F"},
+  "F": {"Type": "Task", "Next": "G"},
+  "G": {"Type": "Task", "Resource": "arn:aws-cn:lambda:cn-north-1:123456789012:function:example", "End": true}
+}}`,
+			want: []string{
+				"ERROR INVALID_RESOURCE /States/A/Resource",
+				"ERROR INVALID_RESOURCE /States/B/Resource",
+				"ERROR INVALID_RESOURCE /States/C/Resource",
+				"ERROR INVALID_RESOURCE /States/D/Resource",
+				"ERROR INVALID_RESOURCE /States/E/Resource",
+				"ERROR SCHEMA_VALIDATION_FAILED /States/F",
+			},
+		},
+		"nested state machines": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {
+    "Type": "Parallel",
+    "Branches": [{"StartAt": "B", "States": {"B": {"Type": "Pass", "Next": "A"}}}],
+    "Next": "M"
+  },
+  "M": {
+    "Type": "Map",
+    "Iterator": {"StartAt": "B", "States": {"B": {"Type": "Pass", "End": true}, "C/D": {"Type": "Succeed"}}},
+    "Parameters": {"x.$": "$$.Map.Item.Value"},
+    "End": true
+  }
+}}`,
+			want: []string{
+				"ERROR MISSING_TRANSITION_TARGET /States/A/Branches/0/States/B/Next",
+				"WARNING DEPRECATED_FIELD /States/M/Iterator",
+				"ERROR DUPLICATE_STATE_NAME /States/M/Iterator/States/B",
+				"ERROR UNREACHABLE_STATE /States/M/Iterator/States/C~1D",
+				"WARNING DEPRECATED_FIELD /States/M/Parameters",
+			},
+		},
+		"Wait state": {
+			definition: `{"StartAt": "A", "States": {
+  "A": {"Type": "Wait", "Seconds": 5, "TimestampPath": "$.at", "Next": "B"},
+  "B": {"Type": "Wait", "SecondsPath": "$.seconds[*]", "End": true}
+}}`,
+			want: []string{
+				"ERROR SCHEMA_VALIDATION_FAILED /States/A",
+				"ERROR INVALID_JSON_PATH /States/B/SecondsPath",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, finding := range asl.Validate(testCase.definition) {
+				got = append(got, fmt.Sprintf("%s %s %s", finding.Severity, finding.Code, finding.Location))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+
+	findings := asl.Validate(`{"StartAt": "A", "States": {"A": {"Type": "Map", "Iterator": {"StartAt": "B", "States": {"B": {"Type": "Pass"}}}, "End": true}}}`)
+
+	if got, want := len(findings), 2; got != want {
+		t.Fatalf("len(findings) = %d, want %d", got, want)
+	}
+
+	errs := asl.Errors(findings)
+
+	if got, want := len(errs), 1; got != want {
+		t.Fatalf("len(Errors(findings)) = %d, want %d", got, want)
+	}
+	if got, want := errs[0].String(), `ERROR at /States/A/Iterator/States/B (MISSING_NEXT_OR_END): Pass states must have either Next or "End": true`; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package cloudfrontfunction runs CloudFront Functions locally in an embedded JavaScript interpreter,
+// so that function code can be checked and tested without calling the TestFunction API.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/functions-javascript-runtime-features.html.
+package cloudfrontfunction
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+const (
+	Runtime1_0 = "cloudfront-js-1.0"
+	Runtime2_0 = "cloudfront-js-2.0"
+)
+
+const (
+	EventTypeViewerRequest  = "viewer-request"
+	EventTypeViewerResponse = "viewer-response"
+)
+
+const (
+	// The maximum size of function code.
+	MaxCodeSize = 10_240
+
+	// Execution is stopped after this time, for example in an infinite loop.
+	executionTimeout = time.Second
+	maxCallStackSize = 1_000
+	programName      = "function.js"
+)
+
+var (
+	errExecutionTimeout = errors.New("function execution timed out")
+
+	// importRegexp matches cloudfront-js-2.0 import declarations of the built-in modules,
+	// e.g. `import cf from 'cloudfront';`, `import * as cf from 'cloudfront';` or `import { kvs } from 'cloudfront';`.
+	importRegexp = regexp.MustCompile(`(?m)^([ \t]*)import[ \t]+(?:\*[ \t]*as[ \t]+)?([A-Za-z_$][\w$]*|\{[^}]*\})[ \t]*from[ \t]+(['"])([\w-]+)['"][ \t]*;?`)
+	// importSpecifierRegexp matches a renamed import, e.g. `kvs as keyValueStore`.
+	importSpecifierRegexp = regexp.MustCompile(`([A-Za-z_$][\w$]*)\s+as\s+([A-Za-z_$][\w$]*)`)
+)
+
+// Function is compiled CloudFront Function code.
+type Function struct {
+	runtime string
+	program *goja.Program
+}
+
+// Compile compiles CloudFront Function code for the specified runtime.
+// An error is returned if the code is too large or has a syntax error.
+func Compile(runtime, code string) (*Function, error) {
+	if n := len(code); n > MaxCodeSize {
+		return nil, fmt.Errorf("function code size (%d bytes) exceeds the maximum (%d bytes)", n, MaxCodeSize)
+	}
+
+	switch runtime {
+	case Runtime1_0:
+	case Runtime2_0:
+		code = rewriteImports(code)
+	default:
+		return nil, fmt.Errorf("unsupported runtime: %s", runtime)
+	}
+
+	parsed, err := goja.Parse(programName, code)
+	if err != nil {
+		return nil, err
+	}
+
+	instrument(parsed)
+
+	program, err := goja.CompileAST(parsed, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Function{
+		runtime: runtime,
+		program: program,
+	}, nil
+}
+
+// rewriteImports rewrites import declarations as calls to require().
+// Named imports are rewritten as destructuring assignments, e.g. `const { kvs: keyValueStore } = require('cloudfront');`.
+// Line breaks are kept to keep line numbers in errors unchanged.
+func rewriteImports(code string) string {
+	return importRegexp.ReplaceAllStringFunc(code, func(s string) string {
+		m := importRegexp.FindStringSubmatch(s)
+		indent, binding, quote, module := m[1], m[2], m[3], m[4]
+
+		if strings.HasPrefix(binding, "{") {
+			binding = importSpecifierRegexp.ReplaceAllString(binding, "${1}: ${2}")
+		}
+
+		return fmt.Sprintf("%sconst %s = require(%s%s%s);", indent, binding, quote, module, quote)
+	})
+}
+
+// Input is the input to a function evaluation.
+type Input struct {
+	// Event is the event object in JSON format.
+	// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/functions-event-structure.html.
+	Event string
+	// KeyValueStore is the contents of the key value store associated with a cloudfront-js-2.0 function.
+	KeyValueStore map[string]string
+}
+
+// Result is the result of a function evaluation.
+// Its fields correspond to those of the TestFunction API's TestResult.
+type Result struct {
+	// ComputeUtilization is an estimate, from 0 to 100, of the function's compute utilization.
+	// CloudFront measures execution time as a percentage of the maximum allowed, which can't be reproduced locally,
+	// so the estimate is instead based on the number of statements executed and is the same for each evaluation.
+	ComputeUtilization int
+	// ErrorMessage is set if the function threw an error or returned an invalid value.
+	ErrorMessage string
+	// ExecutionLogs are the lines logged using console.log().
+	ExecutionLogs []string
+	// Output is the request or response returned by the function in JSON format,
+	// e.g. `{"request":{...}}`.
+	Output string
+}
+
+// Evaluate runs the function's handler with the input's event object.
+// An error is returned if the input is invalid; errors thrown by the function are reported in the result.
+func (f *Function) Evaluate(ctx context.Context, input *Input) (*Result, error) {
+	var event struct {
+		Context struct {
+			EventType string `json:"eventType"`
+		} `json:"context"`
+		Request  map[string]any `json:"request"`
+		Response map[string]any `json:"response"`
+	}
+
+	if err := json.Unmarshal([]byte(input.Event), &event); err != nil {
+		return nil, fmt.Errorf("parsing event object: %w", err)
+	}
+
+	eventType := event.Context.EventType
+	switch eventType {
+	case EventTypeViewerRequest:
+	case EventTypeViewerResponse:
+		if event.Response == nil {
+			return nil, fmt.Errorf("event object: response is required for event type %s", eventType)
+		}
+	default:
+		return nil, fmt.Errorf("event object: unsupported context.eventType: %q", eventType)
+	}
+
+	if event.Request == nil {
+		return nil, errors.New("event object: request is required")
+	}
+
+	result := &Result{}
+	e := &evaluation{
+		function: f,
+		input:    input,
+		result:   result,
+		vm:       goja.New(),
+	}
+
+	timer := time.AfterFunc(executionTimeout, func() {
+		e.vm.Interrupt(errExecutionTimeout)
+	})
+	defer timer.Stop()
+
+	stop := context.AfterFunc(ctx, func() {
+		e.vm.Interrupt(ctx.Err())
+	})
+	defer stop()
+
+	if err := e.run(eventType); err != nil {
+		var errInterrupted *goja.InterruptedError
+		if errors.As(err, &errInterrupted) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		var errStackOverflow *goja.StackOverflowError
+		if errors.As(err, &errStackOverflow) {
+			err = errors.New("RangeError: Maximum call stack size exceeded")
+		}
+
+		result.ErrorMessage = err.Error()
+	}
+
+	result.ComputeUtilization = computeUtilization(e.statements)
+
+	return result, nil
+}
+
+// evaluation is the state of a single function evaluation.
+// Each evaluation has its own interpreter, so evaluations don't share global state.
+type evaluation struct {
+	function *Function
+	input    *Input
+	result   *Result
+	vm       *goja.Runtime
+
+	// statements is the number of statements of the function code executed.
+	statements int
+}
+
+func (e *evaluation) run(eventType string) error {
+	vm := e.vm
+	vm.SetMaxCallStackSize(maxCallStackSize)
+
+	if err := e.setGlobals(); err != nil {
+		return err
+	}
+
+	if _, err := vm.RunProgram(e.function.program); err != nil {
+		return err
+	}
+
+	handler, ok := goja.AssertFunction(vm.Get("handler"))
+	if !ok {
+		return errors.New("the function code must define a function named handler")
+	}
+
+	event, err := e.jsonParse(e.input.Event)
+	if err != nil {
+		return err
+	}
+
+	v, err := handler(goja.Undefined(), event)
+	if err != nil {
+		return err
+	}
+
+	if promise, ok := v.Export().(*goja.Promise); ok {
+		if e.function.runtime == Runtime1_0 {
+			return fmt.Errorf("the handler returned a Promise, which requires runtime %s", Runtime2_0)
+		}
+
+		switch promise.State() {
+		case goja.PromiseStateFulfilled:
+			v = promise.Result()
+		case goja.PromiseStateRejected:
+			return fmt.Errorf("%s", promise.Result())
+		default:
+			return errors.New("the Promise returned by the handler was not settled")
+		}
+	}
+
+	kind, err := e.outputKind(eventType, v)
+	if err != nil {
+		return err
+	}
+
+	output, err := e.jsonStringify(v)
+	if err != nil {
+		return err
+	}
+
+	e.result.Output = fmt.Sprintf(`{%q:%s}`, kind, output)
+
+	return nil
+}
+
+// outputKind returns whether the handler's return value is a request or a response.
+// A viewer request function can return either; a viewer response function must return a response.
+func (e *evaluation) outputKind(eventType string, v goja.Value) (string, error) {
+	obj, ok := v.(*goja.Object)
+	if !ok || goja.IsNull(v) {
+		return "", errors.New("the handler must return a request or response object")
+	}
+
+	if statusCode := obj.Get("statusCode"); statusCode != nil && !goja.IsUndefined(statusCode) {
+		if _, ok := statusCode.Export().(int64); !ok {
+			return "", errors.New("the response statusCode must be an integer")
+		}
+
+		return "response", nil
+	}
+
+	if eventType == EventTypeViewerResponse {
+		return "", errors.New("the handler must return a response object with a statusCode")
+	}
+
+	if uri := obj.Get("uri"); uri == nil || goja.IsUndefined(uri) {
+		return "", errors.New("the request uri is required")
+	}
+
+	return "request", nil
+}
+
+func (e *evaluation) jsonParse(s string) (goja.Value, error) {
+	parse, _ := goja.AssertFunction(e.vm.Get("JSON").ToObject(e.vm).Get("parse"))
+
+	return parse(goja.Undefined(), e.vm.ToValue(s))
+}
+
+func (e *evaluation) jsonStringify(v goja.Value) (string, error) {
+	stringify, _ := goja.AssertFunction(e.vm.Get("JSON").ToObject(e.vm).Get("stringify"))
+
+	s, err := stringify(goja.Undefined(), v)
+	if err != nil {
+		return "", err
+	}
+
+	return s.String(), nil
+}
@@ -0,0 +1,393 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontfunction_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/cloudfrontfunction"
+)
+
+const (
+	viewerRequestEvent = `{
+  "version": "1.0",
+  "context": {"eventType": "viewer-request"},
+  "viewer": {"ip": "198.51.100.11"},
+  "request": {
+    "method": "GET",
+    "uri": "/index.html",
+    "querystring": {"a": {"value": "1"}},
+    "headers": {"host": {"value": "example.com"}},
+    "cookies": {}
+  }
+}`
+	viewerResponseEvent = `{
+  "version": "1.0",
+  "context": {"eventType": "viewer-response"},
+  "viewer": {"ip": "198.51.100.11"},
+  "request": {"method": "GET", "uri": "/index.html", "querystring": {}, "headers": {}, "cookies": {}},
+  "response": {"statusCode": 200, "statusDescription": "OK", "headers": {}, "cookies": {}}
+}`
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	const importCode = "import cf from 'cloudfront';\nasync function handler(event) { return event.request; } // "
+
+	testCases := map[string]struct {
+		runtime     string
+		code        string
+		expectedErr string
+	}{
+		"valid": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code:    `function handler(event) { return event.request; }`,
+		},
+		"syntax error": {
+			runtime:     cloudfrontfunction.Runtime1_0,
+			code:        `function handler(event) { return event.request; `,
+			expectedErr: "Unexpected end of input",
+		},
+		"unsupported runtime": {
+			runtime:     "cloudfront-js-3.0",
+			code:        `function handler(event) { return event.request; }`,
+			expectedErr: "unsupported runtime",
+		},
+		"too large": {
+			runtime:     cloudfrontfunction.Runtime2_0,
+			code:        `function handler(event) { return event.request; } // ` + strings.Repeat("x", cloudfrontfunction.MaxCodeSize),
+			expectedErr: "exceeds the maximum",
+		},
+		"import 2.0": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code:    "import cf from 'cloudfront';\nasync function handler(event) { return event.request; }",
+		},
+		"import maximum size": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code:    importCode + strings.Repeat("x", cloudfrontfunction.MaxCodeSize-len(importCode)),
+		},
+		"named import": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code:    "import { kvs } from 'cloudfront';\nasync function handler(event) { return event.request; }",
+		},
+		"namespace import": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code:    "import * as cf from \"cloudfront\"\nasync function handler(event) { return event.request; }",
+		},
+		"import 1.0": {
+			runtime:     cloudfrontfunction.Runtime1_0,
+			code:        "import cf from 'cloudfront';\nfunction handler(event) { return event.request; }",
+			expectedErr: "SyntaxError",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cloudfrontfunction.Compile(testCase.runtime, testCase.code)
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		runtime               string
+		code                  string
+		event                 string
+		keyValueStore         map[string]string
+		expectedOutput        string
+		expectedErrorMessage  string
+		expectedExecutionLogs []string
+	}{
+		"request": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+  var request = event.request;
+  request.headers['x-viewer-ip'] = { value: event.viewer.ip };
+  console.log('uri', request.uri, request.querystring.a);
+  return request;
+}`,
+			event:                 viewerRequestEvent,
+			expectedOutput:        `{"request":{"method":"GET","uri":"/index.html","querystring":{"a":{"value":"1"}},"headers":{"host":{"value":"example.com"},"x-viewer-ip":{"value":"198.51.100.11"}},"cookies":{}}}`,
+			expectedExecutionLogs: []string{`uri /index.html {"value":"1"}`},
+		},
+		"redirect": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+  return {
+    statusCode: 302,
+    statusDescription: 'Found',
+    headers: { location: { value: 'https://example.com' + event.request.uri } }
+  };
+}`,
+			event:          viewerRequestEvent,
+			expectedOutput: `{"response":{"statusCode":302,"statusDescription":"Found","headers":{"location":{"value":"https://example.com/index.html"}}}}`,
+		},
+		"response": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code: `
+function handler(event) {
+  const response = event.response;
+  response.headers['strict-transport-security'] = { value: 'max-age=63072000' };
+  return response;
+}`,
+			event:          viewerResponseEvent,
+			expectedOutput: `{"response":{"statusCode":200,"statusDescription":"OK","headers":{"strict-transport-security":{"value":"max-age=63072000"}},"cookies":{}}}`,
+		},
+		"response returns request": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code: `
+function handler(event) {
+  return event.request;
+}`,
+			event:                viewerResponseEvent,
+			expectedErrorMessage: "the handler must return a response object with a statusCode",
+		},
+		"no return value": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "the handler must return a request or response object",
+		},
+		"no handler": {
+			runtime:              cloudfrontfunction.Runtime1_0,
+			code:                 `function main(event) { return event.request; }`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "the function code must define a function named handler",
+		},
+		"throw": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+  console.log('before');
+  throw new Error('boom');
+}`,
+			event:                 viewerRequestEvent,
+			expectedErrorMessage:  "Error: boom at handler (function.js:4:",
+			expectedExecutionLogs: []string{"before"},
+		},
+		"crypto": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+var crypto = require('crypto');
+
+function handler(event) {
+  var request = event.request;
+  request.headers['x-md5'] = { value: crypto.createHash('md5').update('abc').digest('hex') };
+  request.headers['x-hmac'] = { value: crypto.createHmac('sha256', 'key').update('abc').digest('base64') };
+  return request;
+}`,
+			event:          viewerRequestEvent,
+			expectedOutput: `{"request":{"method":"GET","uri":"/index.html","querystring":{"a":{"value":"1"}},"headers":{"host":{"value":"example.com"},"x-md5":{"value":"900150983cd24fb0d6963f7d28e17f72"},"x-hmac":{"value":"nBluMtwBdfhvSxy4konWYZ3mvuaZ5MN45oMJ7Zehpqs="}},"cookies":{}}}`,
+		},
+		"querystring": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+var querystring = require('querystring');
+
+function handler(event) {
+  var request = event.request;
+  var qs = querystring.parse('a=1&b=x%20y&a=2');
+  request.uri = '/search?' + querystring.stringify({ q: qs.b, a: qs.a });
+  return request;
+}`,
+			event:          viewerRequestEvent,
+			expectedOutput: `{"request":{"method":"GET","uri":"/search?q=x%20y&a=1&a=2","querystring":{"a":{"value":"1"}},"headers":{"host":{"value":"example.com"}},"cookies":{}}}`,
+		},
+		"unknown module": {
+			runtime:              cloudfrontfunction.Runtime1_0,
+			code:                 `var fs = require('fs'); function handler(event) { return event.request; }`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "Cannot find module 'fs'",
+		},
+		"key value store": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code: `
+import cf from 'cloudfront';
+
+const kvsHandle = cf.kvs('k-0123456789');
+
+async function handler(event) {
+  const request = event.request;
+  const redirects = await kvsHandle.get('redirects', { format: 'json' });
+  if (await kvsHandle.exists('missing')) {
+    throw new Error('unexpected key');
+  }
+  request.uri = redirects[request.uri] || request.uri;
+  return request;
+}`,
+			event: viewerRequestEvent,
+			keyValueStore: map[string]string{
+				"redirects": `{"/index.html": "/home.html"}`,
+			},
+			expectedOutput: `{"request":{"method":"GET","uri":"/home.html","querystring":{"a":{"value":"1"}},"headers":{"host":{"value":"example.com"}},"cookies":{}}}`,
+		},
+		"named import": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code: `
+import {
+  kvs as keyValueStore
+} from 'cloudfront';
+
+function handler(event) {
+  keyValueStore();
+  throw new Error('boom');
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "Error: boom at handler (function.js:8:",
+		},
+		"key value store key not found": {
+			runtime: cloudfrontfunction.Runtime2_0,
+			code: `
+import cf from 'cloudfront';
+
+async function handler(event) {
+  await cf.kvs().get('missing');
+  return event.request;
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "Error: key not found: missing",
+		},
+		"cloudfront module 1.0": {
+			runtime:              cloudfrontfunction.Runtime1_0,
+			code:                 `var cf = require('cloudfront'); function handler(event) { return event.request; }`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "Cannot find module 'cloudfront'",
+		},
+		"async 1.0": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+async function handler(event) {
+  return event.request;
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "the handler returned a Promise, which requires runtime cloudfront-js-2.0",
+		},
+		"infinite loop": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+  for (;;) {}
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "function execution timed out",
+		},
+		"infinite recursion": {
+			runtime: cloudfrontfunction.Runtime1_0,
+			code: `
+function handler(event) {
+  return handler(event);
+}`,
+			event:                viewerRequestEvent,
+			expectedErrorMessage: "RangeError: Maximum call stack size exceeded",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := cloudfrontfunction.Compile(testCase.runtime, testCase.code)
+			if err != nil {
+				t.Fatalf("compiling: %s", err)
+			}
+
+			result, err := f.Evaluate(context.Background(), &cloudfrontfunction.Input{
+				Event:         testCase.event,
+				KeyValueStore: testCase.keyValueStore,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := result.Output, testCase.expectedOutput; got != want {
+				t.Errorf("output = %s, want %s", got, want)
+			}
+
+			if got, want := result.ErrorMessage, testCase.expectedErrorMessage; want == "" && got != "" || !strings.Contains(got, want) {
+				t.Errorf("error message = %q, want %q", got, want)
+			}
+
+			if diff := cmp.Diff(result.ExecutionLogs, testCase.expectedExecutionLogs); diff != "" {
+				t.Errorf("unexpected execution logs diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestEvaluate_invalidEvent(t *testing.T) {
+	t.Parallel()
+
+	f, err := cloudfrontfunction.Compile(cloudfrontfunction.Runtime1_0, `function handler(event) { return event.request; }`)
+	if err != nil {
+		t.Fatalf("compiling: %s", err)
+	}
+
+	for _, event := range []string{
+		`{`,
+		`{"context": {"eventType": "origin-request"}, "request": {}}`,
+		`{"context": {"eventType": "viewer-request"}}`,
+		`{"context": {"eventType": "viewer-response"}, "request": {}}`,
+	} {
+		if _, err := f.Evaluate(context.Background(), &cloudfrontfunction.Input{Event: event}); err == nil {
+			t.Errorf("expected error for event %s", event)
+		}
+	}
+}
+
+func TestEvaluate_computeUtilization(t *testing.T) {
+	t.Parallel()
+
+	f, err := cloudfrontfunction.Compile(cloudfrontfunction.Runtime1_0, `
+function handler(event) {
+  'use strict';
+  var n = 0;
+  for (var i = 0; i < 1000; i++) {
+    n += i;
+  }
+  [1, 2, 3].forEach((v) => v * 2);
+  return event.request;
+}`)
+	if err != nil {
+		t.Fatalf("compiling: %s", err)
+	}
+
+	// 1 top-level statement, 4 handler statements, 1,000 loop iterations and 3 arrow function calls
+	// out of 10,000 statements, rounded up.
+	const expected = 11
+
+	for range 2 {
+		result, err := f.Evaluate(context.Background(), &cloudfrontfunction.Input{Event: viewerRequestEvent})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if result.ErrorMessage != "" {
+			t.Fatalf("unexpected error message: %s", result.ErrorMessage)
+		}
+
+		if got, want := result.ComputeUtilization, expected; got != want {
+			t.Errorf("compute utilization = %d, want %d", got, want)
+		}
+	}
+}
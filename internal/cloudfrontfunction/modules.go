@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontfunction
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/dop251/goja"
+)
+
+// querystringModule is the implementation of the querystring module.
+var querystringModule = goja.MustCompile("querystring.js", `(function () {
+	function unescape(s) {
+		try {
+			return decodeURIComponent(s.replace(/\+/g, ' '));
+		} catch (e) {
+			return s;
+		}
+	}
+
+	function parse(str, sep, eq) {
+		sep = sep || '&';
+		eq = eq || '=';
+		var obj = {};
+		if (typeof str !== 'string' || str.length === 0) {
+			return obj;
+		}
+		str.split(sep).forEach(function (pair) {
+			if (pair.length === 0) {
+				return;
+			}
+			var i = pair.indexOf(eq);
+			var k = unescape(i >= 0 ? pair.slice(0, i) : pair);
+			var v = i >= 0 ? unescape(pair.slice(i + eq.length)) : '';
+			if (!Object.prototype.hasOwnProperty.call(obj, k)) {
+				obj[k] = v;
+			} else if (Array.isArray(obj[k])) {
+				obj[k].push(v);
+			} else {
+				obj[k] = [obj[k], v];
+			}
+		});
+		return obj;
+	}
+
+	function stringify(obj, sep, eq) {
+		sep = sep || '&';
+		eq = eq || '=';
+		if (obj === null || typeof obj !== 'object') {
+			return '';
+		}
+		var pairs = [];
+		Object.keys(obj).forEach(function (k) {
+			var values = Array.isArray(obj[k]) ? obj[k] : [obj[k]];
+			values.forEach(function (v) {
+				if (v === undefined || v === null || typeof v === 'object') {
+					v = '';
+				}
+				pairs.push(encodeURIComponent(k) + eq + encodeURIComponent(String(v)));
+			});
+		});
+		return pairs.join(sep);
+	}
+
+	return {
+		decode: parse,
+		encode: stringify,
+		escape: encodeURIComponent,
+		parse: parse,
+		stringify: stringify,
+		unescape: unescape
+	};
+})()`, true)
+
+// setGlobals adds the console object and the require function, which returns the runtime's built-in modules.
+func (e *evaluation) setGlobals() error {
+	vm := e.vm
+
+	if e.function.runtime == Runtime1_0 {
+		// Promises aren't supported by cloudfront-js-1.0.
+		if err := vm.GlobalObject().Delete("Promise"); err != nil {
+			return err
+		}
+	}
+
+	if err := vm.Set(statementCounterName, func(goja.FunctionCall) goja.Value {
+		e.statements++
+		return goja.Undefined()
+	}); err != nil {
+		return err
+	}
+
+	if err := vm.Set("console", e.newObject(map[string]any{
+		"log": e.consoleLog,
+	})); err != nil {
+		return err
+	}
+
+	modules := make(map[string]goja.Value)
+	return vm.Set("require", func(call goja.FunctionCall) goja.Value {
+		name := call.Argument(0).String()
+
+		if v, ok := modules[name]; ok {
+			return v
+		}
+
+		var module goja.Value
+		switch name {
+		case "cloudfront":
+			if e.function.runtime == Runtime2_0 {
+				module = e.newCloudFrontModule()
+			}
+		case "crypto":
+			module = e.newCryptoModule()
+		case "querystring":
+			v, err := vm.RunProgram(querystringModule)
+			if err != nil {
+				panic(err)
+			}
+			module = v
+		}
+
+		if module == nil {
+			panic(e.newError(fmt.Sprintf("Cannot find module '%s'", name)))
+		}
+
+		modules[name] = module
+
+		return module
+	})
+}
+
+// newObject returns a new object with the specified properties.
+func (e *evaluation) newObject(properties map[string]any) *goja.Object {
+	obj := e.vm.NewObject()
+
+	for k, v := range properties {
+		// Defining a property of a new ordinary object can't fail.
+		_ = obj.Set(k, v)
+	}
+
+	return obj
+}
+
+// newError returns a new Error object with the specified message.
+func (e *evaluation) newError(message string) *goja.Object {
+	ctor, _ := goja.AssertConstructor(e.vm.Get("Error"))
+	obj, err := ctor(nil, e.vm.ToValue(message))
+	if err != nil {
+		panic(err)
+	}
+
+	return obj
+}
+
+// consoleLog logs its arguments, separated by spaces. Objects are logged in JSON format.
+func (e *evaluation) consoleLog(call goja.FunctionCall) goja.Value {
+	var args []string
+
+	for _, v := range call.Arguments {
+		if obj, ok := v.(*goja.Object); ok && obj.ClassName() != "Function" && obj.ClassName() != "Error" {
+			if s, err := e.jsonStringify(v); err == nil {
+				args = append(args, s)
+				continue
+			}
+		}
+
+		args = append(args, v.String())
+	}
+
+	e.result.ExecutionLogs = append(e.result.ExecutionLogs, strings.Join(args, " "))
+
+	return goja.Undefined()
+}
+
+// newCryptoModule returns the crypto module, which supports the createHash and createHmac functions.
+func (e *evaluation) newCryptoModule() goja.Value {
+	vm := e.vm
+
+	newHash := func(algorithm string) func() hash.Hash {
+		switch algorithm {
+		case "md5":
+			return md5.New
+		case "sha1":
+			return sha1.New
+		case "sha256":
+			return sha256.New
+		}
+
+		panic(vm.NewTypeError("unsupported hash algorithm: %s", algorithm))
+	}
+
+	return e.newObject(map[string]any{
+		"createHash": func(call goja.FunctionCall) goja.Value {
+			return e.newHashObject(newHash(call.Argument(0).String())())
+		},
+		"createHmac": func(call goja.FunctionCall) goja.Value {
+			return e.newHashObject(hmac.New(newHash(call.Argument(0).String()), []byte(call.Argument(1).String())))
+		},
+	})
+}
+
+func (e *evaluation) newHashObject(h hash.Hash) goja.Value {
+	vm := e.vm
+
+	return e.newObject(map[string]any{
+		"digest": func(call goja.FunctionCall) goja.Value {
+			sum := h.Sum(nil)
+
+			switch encoding := call.Argument(0); {
+			case goja.IsUndefined(encoding):
+				// A byte string.
+				var sb strings.Builder
+				for _, b := range sum {
+					sb.WriteRune(rune(b))
+				}
+				return vm.ToValue(sb.String())
+			case encoding.String() == "base64":
+				return vm.ToValue(base64.StdEncoding.EncodeToString(sum))
+			case encoding.String() == "base64url":
+				return vm.ToValue(base64.RawURLEncoding.EncodeToString(sum))
+			case encoding.String() == "hex":
+				return vm.ToValue(hex.EncodeToString(sum))
+			default:
+				panic(vm.NewTypeError("unsupported digest encoding: %s", encoding))
+			}
+		},
+		"update": func(call goja.FunctionCall) goja.Value {
+			h.Write([]byte(call.Argument(0).String()))
+
+			return call.This
+		},
+	})
+}
+
+// newCloudFrontModule returns the cloudfront-js-2.0 cloudfront module, which supports reading the key value store.
+// Every key value store handle reads the evaluation's single key value store.
+func (e *evaluation) newCloudFrontModule() goja.Value {
+	vm := e.vm
+	kvs := e.input.KeyValueStore
+
+	settle := func(f func() (any, error)) goja.Value {
+		promise, resolve, reject := vm.NewPromise()
+
+		if v, err := f(); err != nil {
+			reject(e.newError(err.Error()))
+		} else {
+			resolve(v)
+		}
+
+		return vm.ToValue(promise)
+	}
+
+	handle := e.newObject(map[string]any{
+		"exists": func(call goja.FunctionCall) goja.Value {
+			return settle(func() (any, error) {
+				_, ok := kvs[call.Argument(0).String()]
+				return ok, nil
+			})
+		},
+		"get": func(call goja.FunctionCall) goja.Value {
+			return settle(func() (any, error) {
+				key := call.Argument(0).String()
+				v, ok := kvs[key]
+				if !ok {
+					return nil, fmt.Errorf("key not found: %s", key)
+				}
+
+				format := "string"
+				if options, ok := call.Argument(1).(*goja.Object); ok {
+					if v := options.Get("format"); v != nil && !goja.IsUndefined(v) {
+						format = v.String()
+					}
+				}
+
+				switch format {
+				case "bytes":
+					uint8Array, _ := goja.AssertConstructor(vm.Get("Uint8Array"))
+					return uint8Array(nil, vm.ToValue(vm.NewArrayBuffer([]byte(v))))
+				case "json":
+					return e.jsonParse(v)
+				case "string":
+					return v, nil
+				default:
+					return nil, fmt.Errorf("unsupported format: %s", format)
+				}
+			})
+		},
+		"meta": func(call goja.FunctionCall) goja.Value {
+			return settle(func() (any, error) {
+				return e.newObject(map[string]any{
+					"keyCount": len(kvs),
+				}), nil
+			})
+		},
+	})
+
+	return e.newObject(map[string]any{
+		"kvs": func(call goja.FunctionCall) goja.Value {
+			return handle
+		},
+	})
+}
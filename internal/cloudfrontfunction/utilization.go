@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfrontfunction
+
+import (
+	"reflect"
+
+	"github.com/dop251/goja/ast"
+	"github.com/dop251/goja/file"
+	"github.com/dop251/goja/unistring"
+)
+
+const (
+	// The number of statements executed by a function whose compute utilization is estimated as 100.
+	maxComputeStatements = 10_000
+
+	// statementCounterName is the name of the global function called before each statement of instrumented code.
+	statementCounterName = "__cloudfrontFunctionStatement"
+)
+
+// computeUtilization returns the estimated compute utilization, from 0 to 100, of a function that executed the specified number of statements.
+// Unlike CloudFront's, which measures execution time, the estimate is deterministic.
+func computeUtilization(statements int) int {
+	return min(100, (statements*100+maxComputeStatements-1)/maxComputeStatements)
+}
+
+// instrument adds a call to the statement counter before each statement in the program,
+// so that the number of statements executed can be counted.
+// The bodies of loops and if statements are wrapped in blocks, so that statements other than blocks are counted in each iteration,
+// and the expression bodies of arrow functions are preceded by a call to the counter.
+// The inserted nodes take the positions of the nodes that follow them, so positions in errors are unchanged.
+func instrument(program *ast.Program) {
+	i := &instrumenter{
+		visited: make(map[any]struct{}),
+	}
+	i.instrumentNode(reflect.ValueOf(program))
+}
+
+var (
+	astPackagePath    = reflect.TypeFor[ast.Program]().PkgPath()
+	statementListType = reflect.TypeFor[[]ast.Statement]()
+)
+
+// instrumenter walks an AST, instrumenting each node once.
+// The parser can reference a node from more than one place, e.g. from a function's declaration list.
+type instrumenter struct {
+	visited map[any]struct{}
+}
+
+func (i *instrumenter) instrumentNode(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if !v.IsNil() {
+			i.instrumentNode(v.Elem())
+		}
+	case reflect.Pointer:
+		if v.IsNil() || v.Type().Elem().PkgPath() != astPackagePath {
+			return
+		}
+
+		if _, ok := i.visited[v.Interface()]; ok {
+			return
+		}
+		i.visited[v.Interface()] = struct{}{}
+
+		switch n := v.Interface().(type) {
+		case *ast.DoWhileStatement:
+			n.Body = blockStatement(n.Body)
+		case *ast.ForInStatement:
+			n.Body = blockStatement(n.Body)
+		case *ast.ForOfStatement:
+			n.Body = blockStatement(n.Body)
+		case *ast.ForStatement:
+			n.Body = blockStatement(n.Body)
+		case *ast.IfStatement:
+			n.Consequent = blockStatement(n.Consequent)
+			if n.Alternate != nil {
+				n.Alternate = blockStatement(n.Alternate)
+			}
+		case *ast.WhileStatement:
+			n.Body = blockStatement(n.Body)
+		case *ast.ExpressionBody:
+			n.Expression = &ast.SequenceExpression{
+				Sequence: []ast.Expression{statementCounterCall(n.Expression.Idx0()), n.Expression},
+			}
+		}
+
+		i.instrumentNode(v.Elem())
+	case reflect.Slice:
+		if v.Type() == statementListType {
+			v.Set(reflect.ValueOf(instrumentStatements(v.Interface().([]ast.Statement))))
+		}
+
+		for j := range v.Len() {
+			i.instrumentNode(v.Index(j))
+		}
+	case reflect.Struct:
+		for j := range v.NumField() {
+			if v.Type().Field(j).IsExported() {
+				i.instrumentNode(v.Field(j))
+			}
+		}
+	}
+}
+
+// instrumentStatements returns the statements, each preceded by a call to the statement counter.
+// A directive prologue, e.g. "use strict", must be at the start of a function body, so it isn't counted.
+func instrumentStatements(list []ast.Statement) []ast.Statement {
+	instrumented := make([]ast.Statement, 0, 2*len(list))
+	prologue := true
+
+	for _, stmt := range list {
+		if v, ok := stmt.(*ast.ExpressionStatement); ok {
+			if _, ok := v.Expression.(*ast.StringLiteral); ok && prologue {
+				instrumented = append(instrumented, stmt)
+				continue
+			}
+		}
+
+		prologue = false
+		instrumented = append(instrumented, &ast.ExpressionStatement{Expression: statementCounterCall(stmt.Idx0())}, stmt)
+	}
+
+	return instrumented
+}
+
+// blockStatement returns the statement in a block, unless it's already a block.
+func blockStatement(stmt ast.Statement) ast.Statement {
+	if _, ok := stmt.(*ast.BlockStatement); ok {
+		return stmt
+	}
+
+	return &ast.BlockStatement{
+		LeftBrace:  stmt.Idx0(),
+		List:       []ast.Statement{stmt},
+		RightBrace: stmt.Idx1() - 1,
+	}
+}
+
+func statementCounterCall(idx file.Idx) *ast.CallExpression {
+	return &ast.CallExpression{
+		Callee:           &ast.Identifier{Name: unistring.String(statementCounterName), Idx: idx},
+		LeftParenthesis:  idx,
+		RightParenthesis: idx,
+	}
+}
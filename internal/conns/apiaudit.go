@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+// APIAuditRecord is a single line of the API audit log written when `api_audit_log_file` is configured.
+type APIAuditRecord struct {
+	Time       time.Time      `json:"time"`
+	Service    string         `json:"service"`
+	Operation  string         `json:"operation"`
+	Region     string         `json:"region"`
+	Resources  map[string]any `json:"resources,omitempty"`
+	DurationMS int64          `json:"duration_ms"`
+	RetryCount int            `json:"retry_count"`
+	ErrorCode  string         `json:"error_code,omitempty"`
+}
+
+// apiAuditor is AWS SDK for Go v2 middleware that records every API operation as a line of JSON.
+type apiAuditor struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func newAPIAuditor(w io.Writer) *apiAuditor {
+	return &apiAuditor{
+		w: w,
+	}
+}
+
+// apiAuditLogs are the open API audit log files, keyed by absolute path.
+// The provider may be configured many times in one process, e.g. once per provider alias,
+// and configurations that share an API audit log file share its auditor.
+var apiAuditLogs = struct {
+	lock     sync.Mutex
+	auditors map[string]*apiAuditor
+	files    map[string]*os.File
+}{
+	auditors: make(map[string]*apiAuditor),
+	files:    make(map[string]*os.File),
+}
+
+// openAPIAuditLog returns the auditor that appends to the specified file, opening the file if it is not already open.
+func openAPIAuditLog(path string) (*apiAuditor, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	apiAuditLogs.lock.Lock()
+	defer apiAuditLogs.lock.Unlock()
+
+	if a, ok := apiAuditLogs.auditors[path]; ok {
+		return a, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	a := newAPIAuditor(f)
+	apiAuditLogs.auditors[path] = a
+	apiAuditLogs.files[path] = f
+
+	return a, nil
+}
+
+// CloseAPIAuditLogs closes any open API audit log files.
+// It is called when the provider server stops.
+func CloseAPIAuditLogs() error {
+	apiAuditLogs.lock.Lock()
+	defer apiAuditLogs.lock.Unlock()
+
+	var closeErrs []error
+	for path, f := range apiAuditLogs.files {
+		// Wait for any in-progress write.
+		a := apiAuditLogs.auditors[path]
+		a.lock.Lock()
+		closeErrs = append(closeErrs, f.Close())
+		a.lock.Unlock()
+
+		delete(apiAuditLogs.auditors, path)
+		delete(apiAuditLogs.files, path)
+	}
+
+	return errors.Join(closeErrs...)
+}
+
+// addToStack adds the auditor to an API operation's middleware stack.
+// Its signature matches aws.Config.APIOptions.
+func (a *apiAuditor) addToStack(stack *middleware.Stack) error {
+	// After service metadata is registered and before any retries.
+	return stack.Initialize.Add(a, middleware.After)
+}
+
+func (a *apiAuditor) ID() string {
+	return "TF_AWS_APIAudit"
+}
+
+func (a *apiAuditor) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	record := APIAuditRecord{
+		Time:       start.UTC(),
+		Service:    awsmiddleware.GetServiceID(ctx),
+		Operation:  awsmiddleware.GetOperationName(ctx),
+		Region:     awsmiddleware.GetRegion(ctx),
+		Resources:  apiAuditResources(in.Parameters),
+		DurationMS: time.Since(start).Milliseconds(),
+	}
+	if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+		record.RetryCount = len(v.Results) - 1
+	}
+	if err != nil {
+		record.ErrorCode = apiAuditErrorCode(err)
+	}
+
+	if err := a.write(record); err != nil {
+		tflog.Warn(ctx, "writing API audit log", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return out, metadata, err
+}
+
+func (a *apiAuditor) write(record APIAuditRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	_, err = a.w.Write(append(b, '\n'))
+
+	return err
+}
+
+// apiAuditErrorCode returns the error code recorded for a failed API operation.
+// Error messages are never recorded as they may echo sensitive input.
+func apiAuditErrorCode(err error) string {
+	if v, ok := errs.As[smithy.APIError](err); ok {
+		return v.ErrorCode()
+	}
+
+	return "ClientError"
+}
+
+// apiAuditResourceSuffixes are the suffixes of API input member names that identify AWS resources.
+var apiAuditResourceSuffixes = []string{
+	"Arn",
+	"ARN",
+	"Arns",
+	"ARNs",
+	"Bucket",
+	"Id",
+	"Identifier",
+	"Ids",
+	"Key",
+	"Name",
+	"Names",
+	"Role",
+}
+
+// apiAuditResources returns the resource identifiers in an API operation's input.
+// These are the top-level string and string slice members whose names end in one of the resource suffixes.
+// The values of sensitive members are redacted.
+func apiAuditResources(input any) map[string]any {
+	v := reflect.Indirect(reflect.ValueOf(input))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	resources := make(map[string]any)
+	for i, t := 0, v.Type(); i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || !IsAPIAuditResourceName(field.Name) {
+			continue
+		}
+
+		var value any
+		switch fv := reflect.Indirect(v.Field(i)); {
+		case fv.Kind() == reflect.String:
+			value = fv.String()
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String:
+			if fv.Len() == 0 {
+				continue
+			}
+			s := make([]string, fv.Len())
+			for j := range fv.Len() {
+				s[j] = fv.Index(j).String()
+			}
+			value = s
+		default:
+			continue
+		}
+
+		if logging.IsSensitiveKey(field.Name) {
+			value = logging.Redacted
+		}
+
+		resources[field.Name] = value
+	}
+
+	if len(resources) == 0 {
+		return nil
+	}
+
+	return resources
+}
+
+// IsAPIAuditResourceName returns whether an API input member name identifies an AWS resource.
+func IsAPIAuditResourceName(name string) bool {
+	for _, suffix := range apiAuditResourceSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
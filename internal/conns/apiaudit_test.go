@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+type apiAuditTestInput struct {
+	BucketName  *string
+	Description *string
+	GroupIds    []string
+	PolicyArn   *string
+	RoleName    *string
+	SecretKey   *string
+	Tags        map[string]string
+	VersionId   *string
+}
+
+func TestAPIAuditResources(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    any
+		expected map[string]any
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name:  "empty",
+			input: &apiAuditTestInput{},
+		},
+		{
+			name: "identifiers",
+			input: &apiAuditTestInput{
+				BucketName:  aws.String("my-bucket"),
+				Description: aws.String("not an identifier"),
+				GroupIds:    []string{"sg-12345678", "sg-87654321"},
+				PolicyArn:   aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess"), //lintignore:AWSAT005
+				Tags:        map[string]string{"Name": "test"},
+			},
+			expected: map[string]any{
+				"BucketName": "my-bucket",
+				"GroupIds":   []string{"sg-12345678", "sg-87654321"},
+				"PolicyArn":  "arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+			},
+		},
+		{
+			name: "redacted",
+			input: &apiAuditTestInput{
+				RoleName:  aws.String("my-role"),
+				SecretKey: aws.String("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"),
+			},
+			expected: map[string]any{
+				"RoleName":  "my-role",
+				"SecretKey": "[REDACTED]",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(apiAuditResources(testCase.input), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIAuditor(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var b bytes.Buffer
+	auditor := newAPIAuditor(&b)
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "IAM",
+		Region:        "us-east-1", //lintignore:AWSAT003
+		OperationName: "GetRole",
+	}, middleware.Before); err != nil {
+		t.Fatal(err)
+	}
+	if err := auditor.addToStack(stack); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(context.Context, any) (any, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, errs.APIError("NoSuchEntity", "The role with name my-role cannot be found.")
+	}), stack)
+
+	for range 2 {
+		_, _, err := handler.Handle(ctx, &apiAuditTestInput{RoleName: aws.String("my-role")})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+	}
+
+	lines := bytes.Split(bytes.TrimSuffix(b.Bytes(), []byte("\n")), []byte("\n"))
+	if got, want := len(lines), 2; got != want {
+		t.Fatalf("lines = %d, want %d", got, want)
+	}
+
+	var got APIAuditRecord
+	if err := json.Unmarshal(lines[0], &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := APIAuditRecord{
+		Service:   "IAM",
+		Operation: "GetRole",
+		Region:    "us-east-1", //lintignore:AWSAT003
+		Resources: map[string]any{
+			"RoleName": "my-role",
+		},
+		ErrorCode: "NoSuchEntity",
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(APIAuditRecord{}, "Time", "DurationMS")); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if bytes.Contains(b.Bytes(), []byte("cannot be found")) {
+		t.Error("audit log contains error message")
+	}
+}
+
+func TestOpenAPIAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	a1, err := openAPIAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, err := openAPIAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a1 != a2 {
+		t.Error("expected the same auditor for the same file")
+	}
+
+	next := middleware.InitializeHandlerFunc(func(context.Context, middleware.InitializeInput) (middleware.InitializeOutput, middleware.Metadata, error) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, nil
+	})
+	for _, a := range []*apiAuditor{a1, a2} {
+		if _, _, err := a.HandleInitialize(ctx, middleware.InitializeInput{}, next); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := CloseAPIAuditLogs(); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := bytes.Count(b, []byte("\n")), 2; got != want {
+		t.Errorf("lines = %d, want %d", got, want)
+	}
+}
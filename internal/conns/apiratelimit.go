@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+)
+
+const (
+	// rateLimitBackoffFactor is the factor by which the request rate is reduced on throttling.
+	rateLimitBackoffFactor = 0.7
+	// rateLimitRecoverySteps is the number of successful requests after which a throttled request rate recovers to its maximum.
+	rateLimitRecoverySteps = 50
+	// rateLimitDefaultMinimumFactor is the default minimum request rate as a fraction of the maximum.
+	rateLimitDefaultMinimumFactor = 0.1
+)
+
+// APIRateLimit is the adaptive client-side rate limit configuration for an AWS service.
+type APIRateLimit struct {
+	MaxRequestsPerSecond float64
+	MinRequestsPerSecond float64 // Defaults to a tenth of MaxRequestsPerSecond.
+}
+
+// apiRateLimiters holds an adaptive rate limiter per service, Region and operation.
+type apiRateLimiters struct {
+	lock     sync.Mutex
+	configs  map[string]APIRateLimit // Keyed by service package name.
+	limiters map[string]*adaptiveRateLimiter
+}
+
+func newAPIRateLimiters(configs map[string]APIRateLimit) *apiRateLimiters {
+	return &apiRateLimiters{
+		configs:  configs,
+		limiters: make(map[string]*adaptiveRateLimiter),
+	}
+}
+
+// enabled returns whether rate limiting is configured for the specified service.
+func (l *apiRateLimiters) enabled(servicePackageName string) bool {
+	if l == nil {
+		return false
+	}
+
+	_, ok := l.configs[servicePackageName]
+
+	return ok
+}
+
+// get returns the rate limiter for the specified service, Region and operation, creating it if necessary.
+func (l *apiRateLimiters) get(servicePackageName, region, operation string) *adaptiveRateLimiter {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key := servicePackageName + "/" + region + "/" + operation
+	limiter, ok := l.limiters[key]
+	if !ok {
+		limiter = newAdaptiveRateLimiter(l.configs[servicePackageName])
+		l.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// adaptiveRateLimiter spaces requests evenly at a rate which is reduced multiplicatively when requests are throttled
+// and recovers additively as requests succeed.
+type adaptiveRateLimiter struct {
+	lock     sync.Mutex
+	max, min float64   // Requests per second.
+	rate     float64   // Current requests per second.
+	next     time.Time // Earliest time at which the next request may be sent.
+	now      func() time.Time
+}
+
+func newAdaptiveRateLimiter(config APIRateLimit) *adaptiveRateLimiter {
+	maxRate, minRate := config.MaxRequestsPerSecond, config.MinRequestsPerSecond
+	if minRate <= 0 {
+		minRate = maxRate * rateLimitDefaultMinimumFactor
+	}
+	if minRate > maxRate {
+		minRate = maxRate
+	}
+
+	return &adaptiveRateLimiter{
+		max:  maxRate,
+		min:  minRate,
+		rate: maxRate,
+		now:  time.Now,
+	}
+}
+
+// reserve reserves the next request slot and returns how long the caller must wait before sending the request
+// and the interval that the slot occupies.
+func (l *adaptiveRateLimiter) reserve() (time.Duration, time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	interval := time.Duration(float64(time.Second) / l.rate)
+	l.next = l.next.Add(interval)
+
+	return delay, interval
+}
+
+// cancel returns an unused request slot of the specified interval, so that it can be used by a later request.
+func (l *adaptiveRateLimiter) cancel(interval time.Duration) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.next = l.next.Add(-interval)
+	if now := l.now(); l.next.Before(now) {
+		l.next = now
+	}
+}
+
+// wait blocks until the next request may be sent, returning how long it waited.
+// If the context is done first then the request slot is returned.
+func (l *adaptiveRateLimiter) wait(ctx context.Context) (time.Duration, error) {
+	delay, interval := l.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel(interval)
+		return delay, ctx.Err()
+	case <-timer.C:
+		return delay, nil
+	}
+}
+
+// update adjusts the request rate based on the result of a request.
+func (l *adaptiveRateLimiter) update(err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	switch {
+	case err == nil:
+		l.rate = min(l.max, l.rate+(l.max-l.min)/rateLimitRecoverySteps)
+	case retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool():
+		l.rate = max(l.min, l.rate*rateLimitBackoffFactor)
+	}
+}
+
+// currentRate returns the current request rate in requests per second.
+func (l *adaptiveRateLimiter) currentRate() float64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.rate
+}
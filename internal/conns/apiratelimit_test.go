@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestAdaptiveRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := newAdaptiveRateLimiter(APIRateLimit{MaxRequestsPerSecond: 10})
+	limiter.now = func() time.Time { return now }
+
+	for i, want := range []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond} {
+		if got, _ := limiter.reserve(); got != want {
+			t.Errorf("reserve %d = %s, want %s", i, got, want)
+		}
+	}
+
+	// Unused slots are not accumulated.
+	now = now.Add(time.Second)
+	if got, _ := limiter.reserve(); got != 0 {
+		t.Errorf("reserve after idle = %s, want 0s", got)
+	}
+}
+
+func TestAdaptiveRateLimiterUpdate(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAdaptiveRateLimiter(APIRateLimit{MaxRequestsPerSecond: 10, MinRequestsPerSecond: 2})
+	throttle := errs.APIError("ThrottlingException", "Rate exceeded")
+	requestLimitExceeded := errs.APIError("RequestLimitExceeded", "Request limit exceeded.")
+
+	limiter.update(throttle)
+	if got, want := limiter.currentRate(), 7.0; got != want {
+		t.Errorf("rate after ThrottlingException = %v, want %v", got, want)
+	}
+
+	limiter.update(requestLimitExceeded)
+	if got, want := limiter.currentRate(), 4.9; got < want-0.001 || got > want+0.001 {
+		t.Errorf("rate after RequestLimitExceeded = %v, want %v", got, want)
+	}
+
+	// Other errors do not change the rate.
+	limiter.update(errors.New("test"))
+	if got, want := limiter.currentRate(), 4.9; got < want-0.001 || got > want+0.001 {
+		t.Errorf("rate after other error = %v, want %v", got, want)
+	}
+
+	for range 10 {
+		limiter.update(throttle)
+	}
+	if got, want := limiter.currentRate(), 2.0; got != want {
+		t.Errorf("rate after repeated throttling = %v, want %v", got, want)
+	}
+
+	limiter.update(nil)
+	if got, want := limiter.currentRate(), 2.16; got < want-0.001 || got > want+0.001 {
+		t.Errorf("rate after success = %v, want %v", got, want)
+	}
+
+	for range rateLimitRecoverySteps {
+		limiter.update(nil)
+	}
+	if got, want := limiter.currentRate(), 10.0; got != want {
+		t.Errorf("rate after recovery = %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveRateLimiterDefaultMinimum(t *testing.T) {
+	t.Parallel()
+
+	limiter := newAdaptiveRateLimiter(APIRateLimit{MaxRequestsPerSecond: 20})
+
+	if got, want := limiter.min, 2.0; got != want {
+		t.Errorf("min = %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveRateLimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := newAdaptiveRateLimiter(APIRateLimit{MaxRequestsPerSecond: 0.001})
+	limiter.now = func() time.Time { return now }
+	limiter.reserve()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := limiter.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("wait error = %v, want %v", err, context.Canceled)
+	}
+
+	// The canceled request's slot is returned.
+	if got, want := limiter.next, now.Add(1000*time.Second); !got.Equal(want) {
+		t.Errorf("next = %s, want %s", got, want)
+	}
+}
+
+func TestAdaptiveRateLimiterCancel(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	limiter := newAdaptiveRateLimiter(APIRateLimit{MaxRequestsPerSecond: 10})
+	limiter.now = func() time.Time { return now }
+
+	limiter.reserve()
+	_, interval := limiter.reserve()
+	limiter.cancel(interval)
+
+	if got, _ := limiter.reserve(); got != 100*time.Millisecond {
+		t.Errorf("reserve after cancel = %s, want %s", got, 100*time.Millisecond)
+	}
+
+	// Returned slots are not accumulated.
+	now = now.Add(time.Second)
+	_, interval = limiter.reserve()
+	limiter.cancel(interval)
+	limiter.cancel(interval)
+
+	if got, _ := limiter.reserve(); got != 0 {
+		t.Errorf("reserve after idle = %s, want 0s", got)
+	}
+}
+
+func TestAddRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limiters := newAPIRateLimiters(map[string]APIRateLimit{
+		"ec2": {MaxRequestsPerSecond: 100},
+	})
+
+	if !limiters.enabled("ec2") {
+		t.Error("expected rate limiting enabled for ec2")
+	}
+	if limiters.enabled("iam") {
+		t.Error("expected rate limiting disabled for iam")
+	}
+
+	retryer := AddIsErrorRetryables(addRateLimiter(retry.NewStandard(), limiters, "ec2"))
+
+	release, err := retryer.GetAttemptToken(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := release(errs.APIError("RequestLimitExceeded", "Request limit exceeded.")); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := limiters.get("ec2", "", "").currentRate(), 70.0; got != want {
+		t.Errorf("rate = %v, want %v", got, want)
+	}
+}
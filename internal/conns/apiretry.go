@@ -4,8 +4,12 @@
 package conns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // AddIsErrorRetryables returns a Retryer which runs the specified retryables on any error.
@@ -27,3 +31,46 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// addRateLimiter returns a Retryer which waits on the specified service's adaptive rate limiter before each attempt.
+// Each attempt's result adjusts the rate limit of its operation.
+func addRateLimiter(r aws.RetryerV2, limiters *apiRateLimiters, servicePackageName string) aws.RetryerV2 {
+	return &withRateLimiter{
+		RetryerV2:          r,
+		limiters:           limiters,
+		servicePackageName: servicePackageName,
+	}
+}
+
+type withRateLimiter struct {
+	aws.RetryerV2
+	limiters           *apiRateLimiters
+	servicePackageName string
+}
+
+func (r *withRateLimiter) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	operation := awsmiddleware.GetOperationName(ctx)
+	limiter := r.limiters.get(r.servicePackageName, awsmiddleware.GetRegion(ctx), operation)
+
+	delay, err := limiter.wait(ctx)
+	if delay > 0 {
+		tflog.Debug(ctx, "Waited for adaptive rate limiter", map[string]any{
+			"tf_aws.rate_limit.operation":           operation,
+			"tf_aws.rate_limit.requests_per_second": limiter.currentRate(),
+			"tf_aws.rate_limit.wait_duration_ms":    delay.Milliseconds(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		limiter.update(err)
+		return release(err)
+	}, nil
+}
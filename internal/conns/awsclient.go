@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -16,6 +17,7 @@ import (
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -25,13 +27,16 @@ import (
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID           string
+	DefaultTagsConfig   *tftags.DefaultConfig
+	IAMPolicyLintConfig *IAMPolicyLintConfig
+	IgnoreTagsConfig    *tftags.IgnoreConfig
+	Partition           string
+	Region              string
+	ServicePackages     map[string]ServicePackage
+	TagPolicyConfig     *tftags.PolicyConfig
+
+	apiAuditor                *apiAuditor // From provider configuration.
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -40,6 +45,7 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	rateLimiters              *apiRateLimiters // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -70,7 +76,17 @@ func (c *AWSClient) PartitionHostname(ctx context.Context, prefix string) string
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
 func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, c.Region, c.DNSSuffix(ctx))
+	return fmt.Sprintf("%s.%s.%s", prefix, c.EffectiveRegion(ctx), c.DNSSuffix(ctx))
+}
+
+// EffectiveRegion returns the AWS Region that the current operation targets.
+// This is any per-resource `region` override held in Context, otherwise the provider-configured Region.
+func (c *AWSClient) EffectiveRegion(ctx context.Context) string {
+	if inContext, ok := FromContext(ctx); ok && inContext.OverrideRegion != "" {
+		return inContext.OverrideRegion
+	}
+
+	return c.Region
 }
 
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
@@ -79,17 +95,18 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	s3Client := c.S3Client(ctx)
 
+	if s3Client.Options().Region != names.GlobalRegionID {
+		return s3Client
+	}
+
 	c.lock.Lock() // OK since a non-default client is created.
 	defer c.lock.Unlock()
 
+	// Only the global endpoint (us-east-1) needs replacing, so a single cached client suffices.
 	if c.s3ExpressClient == nil {
-		if s3Client.Options().Region == names.GlobalRegionID {
-			c.s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
-				"s3_us_east_1_regional_endpoint": "regional",
-			}))
-		} else {
-			c.s3ExpressClient = s3Client
-		}
+		c.s3ExpressClient = errs.Must(client[*s3_sdkv2.Client](ctx, c, names.S3, map[string]any{
+			"s3_us_east_1_regional_endpoint": "regional",
+		}))
 	}
 
 	return c.s3ExpressClient
@@ -186,8 +203,8 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 }
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -196,8 +213,8 @@ func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
-func (c *AWSClient) EC2RegionalPublicDNSSuffix(context.Context) string {
-	region := c.Region
+func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
+	region := c.EffectiveRegion(ctx)
 	if region == names.USEast1RegionID {
 		return "compute-1"
 	}
@@ -221,11 +238,42 @@ func convertIPToDashIP(ip string) string {
 
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
+	awsConfig, session := c.awsConfig, c.session
+	// Clients for a per-resource Region override are configured identically bar the Region.
+	if region := c.EffectiveRegion(ctx); region != c.Region {
+		if awsConfig != nil {
+			cfg := awsConfig.Copy()
+			cfg.Region = region
+			awsConfig = &cfg
+		}
+		if session != nil {
+			session = session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+		}
+	}
+
+	// Clients for services with a configured rate limit wait on the adaptive rate limiter before each attempt.
+	if c.rateLimiters.enabled(servicePackageName) && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		if retryer := cfg.Retryer; retryer != nil {
+			cfg.Retryer = func() aws_sdkv2.Retryer {
+				return addRateLimiter(retryer().(aws_sdkv2.RetryerV2), c.rateLimiters, servicePackageName)
+			}
+		}
+		awsConfig = &cfg
+	}
+
+	// Clients record every API operation if an API audit log is configured.
+	if c.apiAuditor != nil && awsConfig != nil {
+		cfg := awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), c.apiAuditor.addToStack)
+		awsConfig = &cfg
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         c.resolveEndpoint(ctx, servicePackageName),
 		"partition":        c.Partition,
-		"session":          c.session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -243,6 +291,16 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiClientCacheKey returns the key under which the default API client for the specified service is cached.
+// Clients for any per-resource Region override are cached separately from those for the provider-configured Region.
+func (c *AWSClient) apiClientCacheKey(ctx context.Context, servicePackageName string) string {
+	if region := c.EffectiveRegion(ctx); region != c.Region {
+		return servicePackageName + "/" + region
+	}
+
+	return servicePackageName
+}
+
 // serviceBaseEndpointProvider is needed to search for all providers
 // that provide a configured service endpoint
 type serviceBaseEndpointProvider interface {
@@ -274,12 +332,13 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw, ok := c.conns[key]; ok {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -323,7 +382,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		c.conns[key] = conn
 	}
 
 	return conn, nil
@@ -336,12 +395,13 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	key := c.apiClientCacheKey(ctx, servicePackageName)
 	// Default service client is cached.
 	if isDefault {
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw, ok := c.clients[key]; ok {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -376,7 +436,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		c.clients[key] = client
 	}
 
 	return client, nil
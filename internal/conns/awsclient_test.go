@@ -92,6 +92,54 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 	}
 }
 
+func TestAWSClientEffectiveRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		AWSClient      *AWSClient
+		OverrideRegion string
+		Expected       string
+	}{
+		{
+			Name: "no override",
+			AWSClient: &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			},
+			Expected: "us-west-2", //lintignore:AWSAT003
+		},
+		{
+			Name: "override",
+			AWSClient: &AWSClient{
+				Region: "us-west-2", //lintignore:AWSAT003
+			},
+			OverrideRegion: "eu-west-1", //lintignore:AWSAT003
+			Expected:       "eu-west-1", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.TODO(), "test", "Test")
+			if inContext, ok := FromContext(ctx); ok {
+				inContext.OverrideRegion = testCase.OverrideRegion
+			}
+
+			got := testCase.AWSClient.EffectiveRegion(ctx)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got, expected := testCase.AWSClient.apiClientCacheKey(ctx, "test") == "test", testCase.OverrideRegion == ""; got != expected {
+				t.Errorf("default client cache key: got %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
 func TestAWSClientEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
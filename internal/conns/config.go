@@ -6,11 +6,14 @@ package conns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
@@ -29,6 +32,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIAuditLogFile                string
+	APIHandlers                    []func(*request_sdkv1.Handlers) // Applied to the AWS SDK for Go v1 session's request handlers.
+	APIOptions                     []func(*middleware.Stack) error // Appended to the AWS SDK for Go v2 API options.
+	APIRateLimits                  map[string]APIRateLimit         // Keyed by service package name.
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -40,6 +47,7 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPolicyLintConfig            *IAMPolicyLintConfig
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
@@ -57,6 +65,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -163,6 +172,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if len(c.APIOptions) > 0 {
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), c.APIOptions...)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
@@ -180,6 +193,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	for _, f := range c.APIHandlers {
+		f(&session.Handlers)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -209,9 +226,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
 	client.dnsSuffix = dnsSuffix
+	client.IAMPolicyLintConfig = c.IAMPolicyLintConfig
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -221,6 +240,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	if c.APIAuditLogFile != "" {
+		auditor, err := openAPIAuditLog(c.APIAuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API audit log (%s): %s", c.APIAuditLogFile, err)
+		}
+
+		tflog.Info(ctx, "Recording AWS API operations", map[string]any{
+			"tf_aws.api_audit_log_file": c.APIAuditLogFile,
+		})
+		client.apiAuditor = auditor
+	}
+	if len(c.APIRateLimits) > 0 {
+		client.rateLimiters = newAPIRateLimiters(c.APIRateLimits)
+	}
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -19,6 +19,20 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is implemented by service packages that contain ephemeral resources.
+// Ephemeral resources are never persisted in Terraform plan or state.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
+// ServicePackageWithListResources is implemented by service packages that can enumerate existing remote objects.
+// Each list resource's type must also declare a resource identity.
+type ServicePackageWithListResources interface {
+	ServicePackage
+	ListResources(context.Context) []*types.ServicePackageListResource
+}
+
 type (
 	contextKeyType int
 )
@@ -29,9 +43,11 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	IsDataSource        bool   // Data source?
+	IsEphemeralResource bool   // Ephemeral resource?
+	OverrideRegion      string // Any per-resource `region` value overriding the provider-configured Region
+	ResourceName        string // Friendly resource name, e.g. "Subnet"
+	ServicePackageName  string // Canonical name defined as a constant in names package
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -44,6 +60,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeralResource: true,
+		ResourceName:        resourceName,
+		ServicePackageName:  servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+)
+
+// IAMPolicyLintConfig is the plan-time IAM policy linting configuration (see the provider's `iam_policy_lint` argument).
+type IAMPolicyLintConfig struct {
+	// ErrorFindingTypes are the finding types that are reported as errors. Findings of other types are reported as warnings.
+	ErrorFindingTypes []string
+	// IgnoreIssueCodes are the issue codes of findings that are not reported.
+	IgnoreIssueCodes []string
+}
+
+// IsError returns whether findings of the specified type are reported as errors.
+func (c *IAMPolicyLintConfig) IsError(findingType string) bool {
+	if c == nil {
+		return false
+	}
+
+	return slices.Contains(c.ErrorFindingTypes, findingType)
+}
+
+// IsIgnored returns whether findings with the specified issue code are not reported.
+func (c *IAMPolicyLintConfig) IsIgnored(issueCode string) bool {
+	if c == nil {
+		return true
+	}
+
+	return slices.Contains(c.IgnoreIssueCodes, issueCode)
+}
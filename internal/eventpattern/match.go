@@ -0,0 +1,286 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+// Matches reports whether an event matches the pattern.
+func (p *Pattern) Matches(event string) (bool, error) {
+	var v any
+	if err := json.Unmarshal([]byte(event), &v); err != nil {
+		return false, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false, errors.New("event must be a JSON object")
+	}
+
+	return p.root.matches(m), nil
+}
+
+// Matches reports whether an event matches an event pattern.
+func Matches(pattern, event string) (bool, error) {
+	p, err := Parse(pattern)
+	if err != nil {
+		return false, fmt.Errorf("parsing event pattern: %w", err)
+	}
+
+	ok, err := p.Matches(event)
+	if err != nil {
+		return false, fmt.Errorf("parsing event: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (o *objectPattern) matches(m map[string]any) bool {
+	for k, field := range o.fields {
+		v, ok := m[k]
+		if !field.matches(v, ok) {
+			return false
+		}
+	}
+
+	if len(o.alternatives) > 0 {
+		return slices.ContainsFunc(o.alternatives, func(alternative *objectPattern) bool {
+			return alternative.matches(m)
+		})
+	}
+
+	return true
+}
+
+func (f *fieldPattern) matches(v any, present bool) bool {
+	if f.object != nil {
+		if !present {
+			// Fields nested under a missing field are also missing.
+			return f.object.matches(map[string]any{})
+		}
+
+		// An array of objects matches if any of its elements matches.
+		return slices.ContainsFunc(flatten(v), func(v any) bool {
+			m, ok := v.(map[string]any)
+			return ok && f.object.matches(m)
+		})
+	}
+
+	if !present {
+		return slices.ContainsFunc(f.matchers, func(m matcher) bool {
+			return m == existsMatcher{exists: false}
+		})
+	}
+
+	// An array matches if any of its elements matches.
+	for _, v := range flatten(v) {
+		if _, ok := v.(map[string]any); ok {
+			// Match expressions only apply to leaf values.
+			continue
+		}
+
+		for _, m := range f.matchers {
+			if m.matches(v) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func flatten(v any) []any {
+	items, ok := v.([]any)
+	if !ok {
+		return []any{v}
+	}
+
+	var values []any
+	for _, item := range items {
+		values = append(values, flatten(item)...)
+	}
+
+	return values
+}
+
+// matcher matches a leaf value of an event: a string, float64, bool or nil.
+type matcher interface {
+	matches(v any) bool
+}
+
+type equalsMatcher struct {
+	value any
+}
+
+func (m equalsMatcher) matches(v any) bool {
+	return v == m.value
+}
+
+type equalsIgnoreCaseMatcher struct {
+	value string
+}
+
+func (m equalsIgnoreCaseMatcher) matches(v any) bool {
+	s, ok := v.(string)
+	return ok && strings.EqualFold(s, m.value)
+}
+
+type affixMatcher struct {
+	suffix     bool
+	value      string
+	ignoreCase bool
+}
+
+func (m affixMatcher) matches(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	value := m.value
+	if m.ignoreCase {
+		s, value = strings.ToLower(s), strings.ToLower(value)
+	}
+
+	if m.suffix {
+		return strings.HasSuffix(s, value)
+	}
+	return strings.HasPrefix(s, value)
+}
+
+type anythingButMatcher struct {
+	matchers []matcher
+}
+
+func (m anythingButMatcher) matches(v any) bool {
+	switch v.(type) {
+	case string, float64:
+	default:
+		return false
+	}
+
+	return !slices.ContainsFunc(m.matchers, func(m matcher) bool {
+		return m.matches(v)
+	})
+}
+
+type cidrMatcher struct {
+	prefix netip.Prefix
+}
+
+func (m cidrMatcher) matches(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false
+	}
+
+	return m.prefix.Contains(addr.Unmap())
+}
+
+type existsMatcher struct {
+	exists bool
+}
+
+func (m existsMatcher) matches(v any) bool {
+	// Only called for fields that are present.
+	return m.exists
+}
+
+type numericCondition struct {
+	op    string
+	value float64
+}
+
+type numericMatcher struct {
+	conditions []numericCondition
+}
+
+func (m numericMatcher) matches(v any) bool {
+	n, ok := v.(float64)
+	if !ok {
+		return false
+	}
+
+	for _, c := range m.conditions {
+		var ok bool
+		switch c.op {
+		case "=":
+			ok = n == c.value
+		case "<":
+			ok = n < c.value
+		case "<=":
+			ok = n <= c.value
+		case ">":
+			ok = n > c.value
+		case ">=":
+			ok = n >= c.value
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type wildcardMatcher struct {
+	pattern string
+}
+
+func (m wildcardMatcher) matches(v any) bool {
+	s, ok := v.(string)
+	return ok && wildcardMatch(m.pattern, s)
+}
+
+// wildcardMatch reports whether s matches a wildcard pattern, in which "*" matches any sequence of characters
+// and "\*" and "\\" match a literal "*" and "\".
+func wildcardMatch(pattern, s string) bool {
+	// Split the pattern into the literal segments between wildcards.
+	var segments []string
+	var sb strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			sb.WriteByte(pattern[i])
+		case c == '*':
+			segments = append(segments, sb.String())
+			sb.Reset()
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	segments = append(segments, sb.String())
+
+	if len(segments) == 1 {
+		return s == segments[0]
+	}
+
+	first, last := segments[0], segments[len(segments)-1]
+	if len(s) < len(first)+len(last) || !strings.HasPrefix(s, first) || !strings.HasSuffix(s, last) {
+		return false
+	}
+
+	s = s[len(first) : len(s)-len(last)]
+	for _, segment := range segments[1 : len(segments)-1] {
+		i := strings.Index(s, segment)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(segment):]
+	}
+
+	return true
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package eventpattern implements Amazon EventBridge event pattern parsing and matching,
+// so that event patterns can be validated and tested without calling the TestEventPattern API.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+package eventpattern
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+const (
+	// Numeric matching is supported for values between -5.0e9 and +5.0e9 inclusive.
+	minNumericValue = -5.0e9
+	maxNumericValue = 5.0e9
+)
+
+// Pattern is a parsed event pattern.
+type Pattern struct {
+	root *objectPattern
+}
+
+// objectPattern matches a JSON object. All fields must match, and if there are
+// alternatives ($or), at least one of them must also match.
+type objectPattern struct {
+	fields       map[string]*fieldPattern
+	alternatives []*objectPattern
+}
+
+// fieldPattern matches the value of a field, either as a nested object or with leaf matchers.
+type fieldPattern struct {
+	object   *objectPattern
+	matchers []matcher
+}
+
+// Parse parses an event pattern.
+func Parse(pattern string) (*Pattern, error) {
+	v, err := decode(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	root, err := parseObject("", m)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pattern{root: root}, nil
+}
+
+func decode(s string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+
+	return v, nil
+}
+
+func parseObject(path string, m map[string]any) (*objectPattern, error) {
+	if len(m) == 0 {
+		return nil, fmt.Errorf("%s: empty objects are not allowed", displayPath(path))
+	}
+
+	object := &objectPattern{
+		fields: make(map[string]*fieldPattern, len(m)),
+	}
+
+	for _, k := range sortedKeys(m) {
+		fieldPath := joinPath(path, k)
+
+		switch v := m[k].(type) {
+		case map[string]any:
+			nested, err := parseObject(fieldPath, v)
+			if err != nil {
+				return nil, err
+			}
+			object.fields[k] = &fieldPattern{object: nested}
+		case []any:
+			if k == "$or" {
+				if len(v) == 0 {
+					return nil, fmt.Errorf("%s: $or must be a non-empty array", displayPath(fieldPath))
+				}
+				for i, item := range v {
+					m, ok := item.(map[string]any)
+					if !ok {
+						return nil, fmt.Errorf("%s[%d]: $or alternatives must be objects", displayPath(fieldPath), i)
+					}
+					alternative, err := parseObject(path, m)
+					if err != nil {
+						return nil, err
+					}
+					object.alternatives = append(object.alternatives, alternative)
+				}
+				continue
+			}
+
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: empty arrays are not allowed", displayPath(fieldPath))
+			}
+			field := &fieldPattern{}
+			for i, item := range v {
+				matcher, err := parseMatcher(item)
+				if err != nil {
+					return nil, fmt.Errorf("%s[%d]: %w", displayPath(fieldPath), i, err)
+				}
+				field.matchers = append(field.matchers, matcher)
+			}
+			object.fields[k] = field
+		default:
+			return nil, fmt.Errorf("%s: value must be an object or an array, got %s", displayPath(fieldPath), compact(v))
+		}
+	}
+
+	return object, nil
+}
+
+func parseMatcher(v any) (matcher, error) {
+	switch v := v.(type) {
+	case nil, bool, string:
+		return equalsMatcher{value: v}, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", v, err)
+		}
+		return equalsMatcher{value: f}, nil
+	case map[string]any:
+		return parseExpression(v)
+	}
+
+	return nil, fmt.Errorf("unsupported match value %s", compact(v))
+}
+
+// parseExpression parses a match expression, like {"prefix": "a"}.
+func parseExpression(m map[string]any) (matcher, error) {
+	if len(m) != 1 {
+		return nil, fmt.Errorf("match expression must have exactly one key, got %s", compact(m))
+	}
+
+	for typ, arg := range m {
+		switch typ {
+		case "anything-but":
+			return parseAnythingBut(arg)
+		case "cidr":
+			s, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("cidr value must be a string, got %s", compact(arg))
+			}
+			prefix, err := netip.ParsePrefix(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cidr value %q: %w", s, err)
+			}
+			return cidrMatcher{prefix: prefix.Masked()}, nil
+		case "equals-ignore-case":
+			s, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("equals-ignore-case value must be a string, got %s", compact(arg))
+			}
+			return equalsIgnoreCaseMatcher{value: s}, nil
+		case "exists":
+			b, ok := arg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("exists value must be a boolean, got %s", compact(arg))
+			}
+			return existsMatcher{exists: b}, nil
+		case "numeric":
+			return parseNumeric(arg)
+		case "prefix", "suffix":
+			return parseAffix(typ, arg)
+		case "wildcard":
+			s, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("wildcard value must be a string, got %s", compact(arg))
+			}
+			if err := validateWildcard(s); err != nil {
+				return nil, err
+			}
+			return wildcardMatcher{pattern: s}, nil
+		default:
+			return nil, fmt.Errorf("unrecognized match type %q", typ)
+		}
+	}
+
+	return nil, nil // Unreachable.
+}
+
+// parseAffix parses a prefix or suffix match, which is either a string or an equals-ignore-case expression.
+func parseAffix(typ string, arg any) (matcher, error) {
+	var s string
+	var ignoreCase bool
+
+	switch arg := arg.(type) {
+	case string:
+		s = arg
+	case map[string]any:
+		v, ok := arg["equals-ignore-case"].(string)
+		if !ok || len(arg) != 1 {
+			return nil, fmt.Errorf(`%s value must be a string or {"equals-ignore-case": string}, got %s`, typ, compact(arg))
+		}
+		s, ignoreCase = v, true
+	default:
+		return nil, fmt.Errorf("%s value must be a string, got %s", typ, compact(arg))
+	}
+
+	return affixMatcher{suffix: typ == "suffix", value: s, ignoreCase: ignoreCase}, nil
+}
+
+func parseAnythingBut(arg any) (matcher, error) {
+	switch arg := arg.(type) {
+	case string, json.Number:
+		m, err := parseMatcher(arg)
+		if err != nil {
+			return nil, err
+		}
+		return anythingButMatcher{matchers: []matcher{m}}, nil
+	case []any:
+		if len(arg) == 0 {
+			return nil, errors.New("anything-but list must not be empty")
+		}
+		var matchers []matcher
+		for _, v := range arg {
+			switch v.(type) {
+			case string, json.Number:
+			default:
+				return nil, fmt.Errorf("anything-but list values must be strings or numbers, got %s", compact(v))
+			}
+			m, err := parseMatcher(v)
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, m)
+		}
+		return anythingButMatcher{matchers: matchers}, nil
+	case map[string]any:
+		if len(arg) != 1 {
+			return nil, fmt.Errorf("anything-but expression must have exactly one key, got %s", compact(arg))
+		}
+		for typ, v := range arg {
+			switch typ {
+			case "prefix", "suffix":
+				s, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("anything-but %s value must be a string, got %s", typ, compact(v))
+				}
+				return anythingButMatcher{matchers: []matcher{affixMatcher{suffix: typ == "suffix", value: s}}}, nil
+			case "equals-ignore-case", "wildcard":
+				values, err := stringOrStrings(v)
+				if err != nil {
+					return nil, fmt.Errorf("anything-but %s %w", typ, err)
+				}
+				var matchers []matcher
+				for _, s := range values {
+					if typ == "wildcard" {
+						if err := validateWildcard(s); err != nil {
+							return nil, err
+						}
+						matchers = append(matchers, wildcardMatcher{pattern: s})
+					} else {
+						matchers = append(matchers, equalsIgnoreCaseMatcher{value: s})
+					}
+				}
+				return anythingButMatcher{matchers: matchers}, nil
+			default:
+				return nil, fmt.Errorf("unsupported anything-but match type %q", typ)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("anything-but value must be a string, a number, a list or an object, got %s", compact(arg))
+}
+
+func parseNumeric(arg any) (matcher, error) {
+	items, ok := arg.([]any)
+	if !ok || (len(items) != 2 && len(items) != 4) {
+		return nil, fmt.Errorf("numeric value must be an array of one or two operator and number pairs, got %s", compact(arg))
+	}
+
+	var conditions []numericCondition
+	for i := 0; i < len(items); i += 2 {
+		op, ok := items[i].(string)
+		if !ok || !slices.Contains([]string{"=", "<", "<=", ">", ">="}, op) {
+			return nil, fmt.Errorf("unsupported numeric operator %s", compact(items[i]))
+		}
+		raw, ok := items[i+1].(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("numeric operand must be a number, got %s", compact(items[i+1]))
+		}
+		n, err := raw.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s: %w", raw, err)
+		}
+		if n < minNumericValue || n > maxNumericValue {
+			return nil, fmt.Errorf("numeric operand %s is outside the supported range [%v, %v]", raw, minNumericValue, maxNumericValue)
+		}
+		conditions = append(conditions, numericCondition{op: op, value: n})
+	}
+
+	if len(conditions) == 2 {
+		lower, upper := conditions[0], conditions[1]
+		if strings.HasPrefix(lower.op, "<") {
+			lower, upper = upper, lower
+		}
+		if !strings.HasPrefix(lower.op, ">") || !strings.HasPrefix(upper.op, "<") {
+			return nil, errors.New(`numeric range must have a lower bound (">" or ">=") and an upper bound ("<" or "<=")`)
+		}
+		if lower.value >= upper.value {
+			return nil, fmt.Errorf("numeric range lower bound %v must be less than upper bound %v", lower.value, upper.value)
+		}
+	}
+
+	return numericMatcher{conditions: conditions}, nil
+}
+
+// validateWildcard checks that a wildcard pattern has no consecutive wildcard characters.
+func validateWildcard(s string) error {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '*':
+			if i+1 < len(s) && s[i+1] == '*' {
+				return fmt.Errorf("wildcard value %q must not contain consecutive wildcard characters", s)
+			}
+		}
+	}
+	return nil
+}
+
+func stringOrStrings(v any) ([]string, error) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		if len(v) == 0 {
+			return nil, errors.New("list must not be empty")
+		}
+		s := make([]string, 0, len(v))
+		for _, item := range v {
+			item, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("list values must be strings, got %s", compact(v))
+			}
+			s = append(s, item)
+		}
+		return s, nil
+	}
+
+	return nil, fmt.Errorf("value must be a string or a list of strings, got %s", compact(v))
+}
+
+func joinPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+	return path + "." + k
+}
+
+func displayPath(path string) string {
+	if path == "" {
+		return "pattern"
+	}
+	return path
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func compact(v any) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSpace(buf.String())
+}
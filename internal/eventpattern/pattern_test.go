@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		wantErr bool
+	}{
+		"literals":                        {pattern: `{"source": ["aws.ec2"], "detail": {"count": [5, null, true]}}`},
+		"all match types":                 {pattern: `{"a": [{"prefix": "x"}, {"suffix": {"equals-ignore-case": ".PNG"}}, {"anything-but": {"wildcard": ["*.tmp", "*.bak"]}}, {"numeric": [">", 0, "<=", 5]}, {"exists": true}, {"cidr": "10.0.0.0/24"}, {"equals-ignore-case": "x"}, {"wildcard": "a*b"}]}`},
+		"$or":                             {pattern: `{"$or": [{"a": ["x"]}, {"b": [{"numeric": ["=", 1]}]}]}`},
+		"invalid JSON":                    {pattern: `{"a": [`, wantErr: true},
+		"not an object":                   {pattern: `["a"]`, wantErr: true},
+		"empty object":                    {pattern: `{}`, wantErr: true},
+		"empty nested object":             {pattern: `{"detail": {}}`, wantErr: true},
+		"empty array":                     {pattern: `{"a": []}`, wantErr: true},
+		"leaf value":                      {pattern: `{"a": "x"}`, wantErr: true},
+		"unrecognized match type":         {pattern: `{"a": [{"starts-with": "x"}]}`, wantErr: true},
+		"multiple keys":                   {pattern: `{"a": [{"prefix": "x", "suffix": "y"}]}`, wantErr: true},
+		"numeric operator":                {pattern: `{"a": [{"numeric": ["!=", 1]}]}`, wantErr: true},
+		"numeric range order":             {pattern: `{"a": [{"numeric": ["<", 5, ">", 0]}]}`},
+		"numeric range two lower bounds":  {pattern: `{"a": [{"numeric": [">", 0, ">=", 5]}]}`, wantErr: true},
+		"numeric empty range":             {pattern: `{"a": [{"numeric": [">", 5, "<", 5]}]}`, wantErr: true},
+		"numeric out of range":            {pattern: `{"a": [{"numeric": [">", 6e9]}]}`, wantErr: true},
+		"numeric string operand":          {pattern: `{"a": [{"numeric": [">", "5"]}]}`, wantErr: true},
+		"exists not boolean":              {pattern: `{"a": [{"exists": "true"}]}`, wantErr: true},
+		"invalid cidr":                    {pattern: `{"a": [{"cidr": "10.0.0.0/33"}]}`, wantErr: true},
+		"consecutive wildcards":           {pattern: `{"a": [{"wildcard": "a**b"}]}`, wantErr: true},
+		"anything-but empty list":         {pattern: `{"a": [{"anything-but": []}]}`, wantErr: true},
+		"anything-but unsupported":        {pattern: `{"a": [{"anything-but": {"numeric": [">", 1]}}]}`, wantErr: true},
+		"prefix not string":               {pattern: `{"a": [{"prefix": 1}]}`, wantErr: true},
+		"$or not objects":                 {pattern: `{"$or": ["a"]}`, wantErr: true},
+		"$or empty":                       {pattern: `{"$or": []}`, wantErr: true},
+		"$or alternative empty":           {pattern: `{"$or": [{"a": ["x"]}, {}]}`, wantErr: true},
+		"anything-but equals-ignore-case": {pattern: `{"a": [{"anything-but": {"equals-ignore-case": ["X", "Y"]}}]}`},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := eventpattern.Parse(testCase.pattern)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("Parse(%s) err = %v, wantErr %t", testCase.pattern, err, want)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "version": "0",
+  "id": "6a7e8feb-b491-4cf7-a9f1-bf3703467718",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "111122223333",
+  "time": "2017-12-22T18:43:48Z",
+  "region": "us-west-1",
+  "resources": ["arn:aws:ec2:us-west-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "terminated",
+    "count": 3,
+    "cpu": 0.5,
+    "source-ip": "10.0.0.123",
+    "flags": [true],
+    "note": null,
+    "file": "Photos/Cat.PNG",
+    "tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "web"}]
+  }
+}`
+
+	testCases := map[string]struct {
+		pattern string
+		want    bool
+	}{
+		"string":                           {pattern: `{"source": ["aws.ec2"]}`, want: true},
+		"string no match":                  {pattern: `{"source": ["aws.s3"]}`},
+		"string any of":                    {pattern: `{"source": ["aws.s3", "aws.ec2"]}`, want: true},
+		"nested":                           {pattern: `{"detail": {"state": ["terminated"]}}`, want: true},
+		"all fields must match":            {pattern: `{"source": ["aws.ec2"], "detail": {"state": ["running"]}}`},
+		"number":                           {pattern: `{"detail": {"count": [3]}}`, want: true},
+		"number is not string":             {pattern: `{"detail": {"count": ["3"]}}`},
+		"boolean in array":                 {pattern: `{"detail": {"flags": [true]}}`, want: true},
+		"null":                             {pattern: `{"detail": {"note": [null]}}`, want: true},
+		"array element":                    {pattern: `{"resources": [{"prefix": "arn:aws:ec2:"}]}`, want: true},
+		"array of objects":                 {pattern: `{"detail": {"tags": {"key": ["team"], "value": ["web"]}}}`, want: true},
+		"array of objects across elements": {pattern: `{"detail": {"tags": {"key": ["team"], "value": ["prod"]}}}`},
+		"prefix":                           {pattern: `{"time": [{"prefix": "2017-12-22"}]}`, want: true},
+		"prefix equals-ignore-case":        {pattern: `{"detail": {"file": [{"prefix": {"equals-ignore-case": "photos/"}}]}}`, want: true},
+		"suffix":                           {pattern: `{"detail": {"file": [{"suffix": ".PNG"}]}}`, want: true},
+		"suffix case sensitive":            {pattern: `{"detail": {"file": [{"suffix": ".png"}]}}`},
+		"suffix equals-ignore-case":        {pattern: `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".png"}}]}}`, want: true},
+		"equals-ignore-case":               {pattern: `{"detail": {"state": [{"equals-ignore-case": "TERMINATED"}]}}`, want: true},
+		"anything-but":                     {pattern: `{"detail": {"state": [{"anything-but": "running"}]}}`, want: true},
+		"anything-but list":                {pattern: `{"detail": {"state": [{"anything-but": ["running", "terminated"]}]}}`},
+		"anything-but number":              {pattern: `{"detail": {"count": [{"anything-but": [1, 2]}]}}`, want: true},
+		"anything-but prefix":              {pattern: `{"region": [{"anything-but": {"prefix": "us-"}}]}`},
+		"anything-but suffix":              {pattern: `{"region": [{"anything-but": {"suffix": "-2"}}]}`, want: true},
+		"anything-but equals-ignore-case":  {pattern: `{"region": [{"anything-but": {"equals-ignore-case": ["US-WEST-1"]}}]}`},
+		"anything-but wildcard":            {pattern: `{"detail": {"file": [{"anything-but": {"wildcard": "*.tmp"}}]}}`, want: true},
+		"anything-but missing field":       {pattern: `{"detail": {"missing": [{"anything-but": "x"}]}}`},
+		"numeric range":                    {pattern: `{"detail": {"count": [{"numeric": [">", 0, "<=", 3]}]}}`, want: true},
+		"numeric range upper bound first":  {pattern: `{"detail": {"count": [{"numeric": ["<=", 3, ">", 0]}]}}`, want: true},
+		"numeric range no match":           {pattern: `{"detail": {"count": [{"numeric": [">", 3]}]}}`},
+		"numeric equals":                   {pattern: `{"detail": {"cpu": [{"numeric": ["=", 0.5]}]}}`, want: true},
+		"numeric on string":                {pattern: `{"detail": {"state": [{"numeric": [">", 0]}]}}`},
+		"exists":                           {pattern: `{"detail": {"state": [{"exists": true}]}}`, want: true},
+		"exists missing":                   {pattern: `{"detail": {"missing": [{"exists": true}]}}`},
+		"not exists":                       {pattern: `{"detail": {"missing": [{"exists": false}]}}`, want: true},
+		"not exists present":               {pattern: `{"detail": {"state": [{"exists": false}]}}`},
+		"not exists under missing parent":  {pattern: `{"missing": {"field": [{"exists": false}]}}`, want: true},
+		"exists on object":                 {pattern: `{"detail": [{"exists": true}]}`},
+		"cidr":                             {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, want: true},
+		"cidr no match":                    {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`},
+		"cidr IPv6":                        {pattern: `{"detail": {"source-ip": [{"cidr": "2001:db8::/32"}]}}`},
+		"wildcard":                         {pattern: `{"detail": {"file": [{"wildcard": "Photos/*.PNG"}]}}`, want: true},
+		"wildcard multiple":                {pattern: `{"detail-type": [{"wildcard": "EC2*State*Notification"}]}`, want: true},
+		"wildcard no match":                {pattern: `{"detail": {"file": [{"wildcard": "Videos/*"}]}}`},
+		"wildcard escaped":                 {pattern: `{"detail": {"file": [{"wildcard": "Photos/\\*.PNG"}]}}`},
+		"$or":                              {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"count": [{"numeric": [">", 2]}]}}]}`, want: true},
+		"$or no match":                     {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"count": [{"numeric": [">", 5]}]}}]}`},
+		"$or and fields":                   {pattern: `{"source": ["aws.s3"], "$or": [{"region": ["us-west-1"]}, {"account": ["111122223333"]}]}`},
+		"$or nested":                       {pattern: `{"detail": {"$or": [{"state": ["running"]}, {"state": ["terminated"]}]}}`, want: true},
+		"missing field":                    {pattern: `{"detail": {"missing": ["x"]}}`},
+		"nested pattern on leaf":           {pattern: `{"source": {"name": ["aws.ec2"]}}`},
+		"leaf pattern on object":           {pattern: `{"detail": ["terminated"]}`},
+		"wildcard suffix":                  {pattern: `{"detail": {"instance-id": [{"wildcard": "i-*"}]}}`, want: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := eventpattern.Matches(testCase.pattern, event)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("Matches(%s) = %t, want %t", testCase.pattern, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMatchesInvalidEvent(t *testing.T) {
+	t.Parallel()
+
+	p, err := eventpattern.Parse(`{"source": ["aws.ec2"]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, event := range []string{`{"source": `, `["aws.ec2"]`} {
+		if _, err := p.Matches(event); err == nil {
+			t.Errorf("Matches(%s) err = nil, want error", event)
+		}
+	}
+}
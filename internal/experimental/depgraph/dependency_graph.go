@@ -186,6 +186,39 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// OverallOrderWaves returns the overall processing order for the dependency graph grouped into waves.
+// Every node's dependencies are in earlier waves, so the nodes within a wave can be processed concurrently.
+// Nodes within a wave are in the same relative order as in OverallOrder.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) OverallOrderWaves() ([][]string, error) {
+	order, err := g.OverallOrder()
+
+	if err != nil {
+		return nil, err
+	}
+
+	waves := make([][]string, 0)
+	waveOf := make(map[string]int, len(order))
+
+	// Dependencies precede their dependents in the overall order.
+	for _, node := range order {
+		wave := 0
+
+		for _, dependency := range g.outgoingEdges[node] {
+			wave = max(wave, waveOf[dependency]+1)
+		}
+
+		waveOf[node] = wave
+
+		if wave == len(waves) {
+			waves = append(waves, make([]string, 0))
+		}
+		waves[wave] = append(waves[wave], node)
+	}
+
+	return waves, nil
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -13,6 +13,7 @@ import (
 // DataSourceWithConfigure is a structure to be embedded within a DataSource that implements the DataSourceWithConfigure interface.
 type DataSourceWithConfigure struct {
 	withMeta
+	WithRegionOverride
 }
 
 // Configure enables provider-level data or clients to be set in the
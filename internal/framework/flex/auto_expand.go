@@ -136,6 +136,12 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 	}
 
+	if fromUnion, ok := valFrom.Interface().(UnionModel); ok && vTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionModel")
+		diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, vTo, expander)...)
+		return diags
+	}
+
 	vFrom, ok := valFrom.Interface().(attr.Value)
 	if !ok {
 		tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
@@ -1251,6 +1257,81 @@ func expandTypedExpander(ctx context.Context, fromTypedExpander TypedExpander, t
 	return diags
 }
 
+// expandUnion copies the set field of a Terraform union model to the `Value` field of the corresponding AWS API union member.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, fromUnion UnionModel, targetPath path.Path, valTo reflect.Value, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	typeFrom := valFrom.Type()
+
+	var fields []reflect.StructField
+	for i := 0; i < typeFrom.NumField(); i++ {
+		field := typeFrom.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+
+		if v, ok := valFrom.Field(i).Interface().(attr.Value); ok && isUnionMemberSet(v) {
+			fields = append(fields, field)
+		}
+	}
+
+	switch n := len(fields); n {
+	case 0:
+		tflog.SubsystemTrace(ctx, subsystemName, "Expanding union with no member set")
+		return diags
+
+	case 1:
+
+	default:
+		tflog.SubsystemError(ctx, subsystemName, "Expanding union with multiple members set")
+		diags.Append(diagExpandingMultipleUnionMembers(typeFrom, fields[0].Name, fields[1].Name))
+		return diags
+	}
+
+	field := fields[0]
+	typeTo := valTo.Type()
+	for _, typeMember := range unionMemberTypes(fromUnion) {
+		if !reflect.PointerTo(typeMember).Implements(typeTo) {
+			continue
+		}
+
+		if f, ok := unionMemberField(typeFrom, typeMember); !ok || f.Name != field.Name {
+			continue
+		}
+
+		to := reflect.New(typeMember)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(field.Name), valFrom.FieldByIndex(field.Index), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+
+		return diags
+	}
+
+	tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+		logAttrKeySourceFieldname: field.Name,
+	})
+	diags.Append(diagExpandingNoUnionMember(typeFrom, field.Name, typeTo))
+
+	return diags
+}
+
+// isUnionMemberSet returns whether a union model field has a value.
+// Nested blocks that are not configured have no elements.
+func isUnionMemberSet(v attr.Value) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+
+	if v, ok := v.(valueWithElementsAs); ok {
+		return len(v.Elements()) > 0
+	}
+
+	return true
+}
+
 func diagExpandingSourceIsNil(sourceType reflect.Type) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Incompatible Types",
@@ -1320,3 +1401,23 @@ func diagExpandingIncompatibleTypes(sourceType, targetType reflect.Type) diag.Er
 			fmt.Sprintf("Source type %q cannot be expanded to target type %q.", fullTypeName(sourceType), fullTypeName(targetType)),
 	)
 }
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union %q has multiple members set: %q and %q.", fullTypeName(sourceType), fieldName1, fieldName2),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string, targetType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Union %q member %q has no corresponding member of %q.", fullTypeName(sourceType), fieldName, fullTypeName(targetType)),
+	)
+}
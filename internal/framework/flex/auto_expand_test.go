@@ -34,6 +34,7 @@ func TestExpand(t *testing.T) {
 	)
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
+	testQueueARN := "arn:aws:sqs:us-west-2:123456789012:test"                                               //lintignore:AWSAT003,AWSAT005
 
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
@@ -492,6 +493,28 @@ func TestExpand(t *testing.T) {
 				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ARN](), "Field1", reflect.TypeFor[*string]()),
 			},
 		},
+		"single ARNOf Source and single string Target": {
+			Source:     &tfSingleARNOfField{Field1: fwtypes.ARNOfValue[fwtypes.SQSQueueARN](testQueueARN)},
+			Target:     &awsSingleStringValue{},
+			WantTarget: &awsSingleStringValue{Field1: testQueueARN},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleARNOfField](), reflect.TypeFor[*awsSingleStringValue]()),
+				infoConverting(reflect.TypeFor[tfSingleARNOfField](), reflect.TypeFor[*awsSingleStringValue]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleARNOfField](), "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ARNOf[fwtypes.SQSQueueARN]](), "Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"single ARNOf Source and single *string Target": {
+			Source:     &tfSingleARNOfField{Field1: fwtypes.ARNOfValue[fwtypes.SQSQueueARN](testQueueARN)},
+			Target:     &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{Field1: aws.String(testQueueARN)},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleARNOfField](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfSingleARNOfField](), reflect.TypeFor[*awsSingleStringPointer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleARNOfField](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ARNOf[fwtypes.SQSQueueARN]](), "Field1", reflect.TypeFor[*string]()),
+			},
+		},
 		"timestamp pointer": {
 			Source: &tfRFC3339Time{
 				CreationDateTime: timetypes.NewRFC3339ValueMust(testTimeStr),
@@ -4845,6 +4868,171 @@ func testFlexAWSInterfaceInterfacePtr(v awsInterfaceInterface) *awsInterfaceInte
 	return &v
 }
 
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"top level string member": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				String: types.StringValue("value1"),
+			},
+			Target:     new(awsUnionInterface),
+			WantTarget: testFlexAWSUnionInterfacePtr(&awsUnionMemberString{Value: "value1"}),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnionInterface]()),
+				infoConvertingWithPath("String", reflect.TypeFor[types.String](), "Value", reflect.TypeFor[string]()),
+			},
+		},
+		"top level string member of other union": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+				String: types.StringValue("value1"),
+			},
+			Target:     new(awsUpdateUnionInterface),
+			WantTarget: testFlexAWSUpdateUnionInterfacePtr(&awsUpdateUnionMemberString{Value: "value1"}),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUpdateUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUpdateUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUpdateUnionInterface]()),
+				infoConvertingWithPath("String", reflect.TypeFor[types.String](), "Value", reflect.TypeFor[string]()),
+			},
+		},
+		"top level no member set": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{}),
+				String: types.StringNull(),
+			},
+			Target:     new(awsUnionInterface),
+			WantTarget: testFlexAWSUnionInterfacePtr(nil),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnionInterface]()),
+				traceExpandingUnionNoMemberSet("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnionInterface]()),
+			},
+		},
+		"top level multiple members set": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{Field1: types.StringValue("value1")},
+				}),
+				String: types.StringValue("value2"),
+			},
+			Target: new(awsUnionInterface),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), "Object", "String"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnionInterface]()),
+				errorExpandingUnionMultipleMembersSet("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUnionInterface]()),
+			},
+		},
+		"top level member without corresponding union member": {
+			Source: tfUnion{
+				Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+					{Field1: types.StringValue("value1")},
+				}),
+				String: types.StringNull(),
+			},
+			Target: new(awsUpdateUnionInterface),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingNoUnionMember(reflect.TypeFor[tfUnion](), "Object", reflect.TypeFor[awsUpdateUnionInterface]()),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUpdateUnionInterface]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[awsUpdateUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[awsUpdateUnionInterface]()),
+				errorNoCorrespondingUnionMember("", reflect.TypeFor[tfUnion](), "Object", "", reflect.TypeFor[awsUpdateUnionInterface]()),
+			},
+		},
+		"single list Source and single union Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+						String: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSingle{},
+			WantTarget: &awsUnionSingle{
+				Field1: &awsUnionMemberObject{
+					Value: awsSingleStringValue{Field1: "value1"},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSingle]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSingle]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Object[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Object[0].Field1", reflect.TypeFor[types.String](), "Field1.Value.Field1", reflect.TypeFor[string]()),
+			},
+		},
+		"list Source and union slice Target": {
+			Source: tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+						String: types.StringNull(),
+					},
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value2"),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionMemberObject{
+						Value: awsSingleStringValue{Field1: "value1"},
+					},
+					&awsUnionMemberString{
+						Value: "value2",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				infoConverting(reflect.TypeFor[tfListNestedObject[tfUnion]](), reflect.TypeFor[*awsUnionSlice]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfListNestedObject[tfUnion]](), "Field1", reflect.TypeFor[*awsUnionSlice]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				traceExpandingNestedObjectCollection("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), 2, "Field1", reflect.TypeFor[[]awsUnionInterface]()),
+				infoSourceImplementsFlexUnionModel("Field1[0]", reflect.TypeFor[tfUnion](), "Field1[0]", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[0].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1[0].Value", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Object[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1[0].Value", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Object[0].Field1", reflect.TypeFor[types.String](), "Field1[0].Value.Field1", reflect.TypeFor[string]()),
+				infoSourceImplementsFlexUnionModel("Field1[1]", reflect.TypeFor[tfUnion](), "Field1[1]", reflect.TypeFor[*awsUnionInterface]()),
+				infoConvertingWithPath("Field1[1].String", reflect.TypeFor[types.String](), "Field1[1].Value", reflect.TypeFor[string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func testFlexAWSUnionInterfacePtr(v awsUnionInterface) *awsUnionInterface { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
+func testFlexAWSUpdateUnionInterfacePtr(v awsUpdateUnionInterface) *awsUpdateUnionInterface { // nosemgrep:ci.aws-in-func-name
+	return &v
+}
+
 func TestExpandExpander(t *testing.T) {
 	t.Parallel()
 
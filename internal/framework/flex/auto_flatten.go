@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -188,7 +189,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -501,7 +502,7 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 			}
 		} else {
 			if !isNullFrom {
-				if value := vFrom.String(); !(value == "" && (strings.HasPrefix(tTo.String(), "StringEnumType[") || fieldOpts.omitempty)) {
+				if value := vFrom.String(); !(value == "" && (strings.HasPrefix(tTo.String(), "StringEnumType[") || strings.HasPrefix(tTo.String(), "ARNOfType[") || fieldOpts.omitempty)) {
 					stringValue = types.StringValue(value)
 				}
 			}
@@ -607,7 +608,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -651,7 +652,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1200,7 +1201,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1220,6 +1221,23 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 		return diags
 	}
 
+	if _, ok := to.(UnionModel); ok {
+		diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Set the target structure as a mapped Object.
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
 	toFlattener, ok := to.(Flattener)
 	if !ok {
 		val, d := tTo.NullValue(ctx)
@@ -1569,6 +1587,33 @@ func flattenFlattener(ctx context.Context, fromVal reflect.Value, toFlattener Fl
 	return diags
 }
 
+// flattenUnion copies the `Value` field of an AWS API union member to the corresponding field of a Terraform union model.
+// All other fields are set to null.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, toUnion UnionModel, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	typeFrom := valFrom.Type()
+	if !slices.Contains(unionMemberTypes(toUnion), typeFrom) {
+		tflog.SubsystemDebug(ctx, subsystemName, "Source is not a union member")
+		return diags
+	}
+
+	field, ok := unionMemberField(valTo.Type(), typeFrom)
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member field")
+		return diags
+	}
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+
+	return diags
+}
+
 func flattenPrePopulate(ctx context.Context, toVal reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -38,6 +38,7 @@ func TestFlatten(t *testing.T) {
 	testString := "test"
 
 	testARN := "arn:aws:securityhub:us-west-2:1234567890:control/cis-aws-foundations-benchmark/v/1.2.0/1.1" //lintignore:AWSAT003,AWSAT005
+	testQueueARN := "arn:aws:sqs:us-west-2:123456789012:test"                                               //lintignore:AWSAT003,AWSAT005
 
 	testTimeStr := "2013-09-25T09:34:01Z"
 	testTimeTime := errs.Must(time.Parse(time.RFC3339, testTimeStr))
@@ -725,6 +726,39 @@ func TestFlatten(t *testing.T) {
 				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[fwtypes.ARN]()),
 			},
 		},
+		"single string Source and single ARNOf Target": {
+			Source:     &awsSingleStringValue{Field1: testQueueARN},
+			Target:     &tfSingleARNOfField{},
+			WantTarget: &tfSingleARNOfField{Field1: fwtypes.ARNOfValue[fwtypes.SQSQueueARN](testQueueARN)},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsSingleStringValue](), reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConverting(reflect.TypeFor[awsSingleStringValue](), reflect.TypeFor[*tfSingleARNOfField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringValue](), "Field1", reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1", reflect.TypeFor[fwtypes.ARNOf[fwtypes.SQSQueueARN]]()),
+			},
+		},
+		"single empty string Source and single ARNOf Target": {
+			Source:     &awsSingleStringValue{},
+			Target:     &tfSingleARNOfField{},
+			WantTarget: &tfSingleARNOfField{Field1: fwtypes.ARNOfNull[fwtypes.SQSQueueARN]()},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsSingleStringValue](), reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConverting(reflect.TypeFor[awsSingleStringValue](), reflect.TypeFor[*tfSingleARNOfField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringValue](), "Field1", reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[string](), "Field1", reflect.TypeFor[fwtypes.ARNOf[fwtypes.SQSQueueARN]]()),
+			},
+		},
+		"single nil *string Source and single ARNOf Target": {
+			Source:     &awsSingleStringPointer{},
+			Target:     &tfSingleARNOfField{},
+			WantTarget: &tfSingleARNOfField{Field1: fwtypes.ARNOfNull[fwtypes.SQSQueueARN]()},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsSingleStringPointer](), reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConverting(reflect.TypeFor[awsSingleStringPointer](), reflect.TypeFor[*tfSingleARNOfField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsSingleStringPointer](), "Field1", reflect.TypeFor[*tfSingleARNOfField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[fwtypes.ARNOf[fwtypes.SQSQueueARN]]()),
+			},
+		},
 		"timestamp": {
 			Source: &awsRFC3339TimeValue{
 				CreationDateTime: testTimeTime,
@@ -4792,6 +4826,124 @@ func TestFlattenInterface(t *testing.T) {
 	runAutoFlattenTestCases(t, testCases)
 }
 
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nil union Source and list Target": {
+			Source: awsUnionSingle{
+				Field1: nil,
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+		"string member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberString{
+					Value: "value1",
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value1"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexUnionModel("Field1", reflect.TypeFor[awsUnionMemberString](), "Field1", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[string](), "Field1.String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"object member Source and single list Target": {
+			Source: awsUnionSingle{
+				Field1: &awsUnionMemberObject{
+					Value: awsSingleStringValue{Field1: "value1"},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+						String: types.StringNull(),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSingle](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSingle](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexUnionModel("Field1", reflect.TypeFor[awsUnionMemberObject](), "Field1", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Object", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Object.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"union slice Source and list Target": {
+			Source: awsUnionSlice{
+				Field1: []awsUnionInterface{
+					&awsUnionMemberObject{
+						Value: awsSingleStringValue{Field1: "value1"},
+					},
+					&awsUnionMemberString{
+						Value: "value2",
+					},
+				},
+			},
+			Target: &tfListNestedObject[tfUnion]{},
+			WantTarget: &tfListNestedObject[tfUnion]{
+				Field1: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfUnion{
+					{
+						Object: fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, []tfSingleStringField{
+							{Field1: types.StringValue("value1")},
+						}),
+						String: types.StringNull(),
+					},
+					{
+						Object: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+						String: types.StringValue("value2"),
+					},
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConverting(reflect.TypeFor[awsUnionSlice](), reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionSlice](), "Field1", reflect.TypeFor[*tfListNestedObject[tfUnion]]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]awsUnionInterface](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				traceFlatteningNestedObjectCollection("Field1", reflect.TypeFor[[]awsUnionInterface](), 2, "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoTargetImplementsFlexUnionModel("Field1[0]", reflect.TypeFor[awsUnionMemberObject](), "Field1[0]", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[0].Value", reflect.TypeFor[awsSingleStringValue](), "Field1[0].Object", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1[0].Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1[0].Object", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1[0].Value.Field1", reflect.TypeFor[string](), "Field1[0].Object.Field1", reflect.TypeFor[types.String]()),
+				infoTargetImplementsFlexUnionModel("Field1[1]", reflect.TypeFor[awsUnionMemberString](), "Field1[1]", reflect.TypeFor[*tfUnion]()),
+				infoConvertingWithPath("Field1[1].Value", reflect.TypeFor[string](), "Field1[1].String", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenFlattener(t *testing.T) {
 	t.Parallel()
 
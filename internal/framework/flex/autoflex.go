@@ -23,6 +23,8 @@ const (
 	fieldNameSuffixRecurse fieldNamePrefixCtxKey = "FIELD_NAME_SUFFIX_RECURSE"
 
 	mapBlockKeyFieldName = "MapBlockKey"
+
+	unionMemberValueFieldName = "Value"
 )
 
 // Expand  = TF -->  AWS
@@ -100,6 +102,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Expanding
+	if fromUnion, ok := valFrom.Interface().(UnionModel); ok && valTo.Kind() == reflect.Interface {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.UnionModel")
+		diags.Append(expandUnion(ctx, sourcePath, valFrom, fromUnion, targetPath, valTo, flexer)...)
+		return diags
+	}
+
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
@@ -116,6 +125,13 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if toUnion, ok := to.(UnionModel); ok {
+		tflog.SubsystemInfo(ctx, subsystemName, "Target implements flex.UnionModel")
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, toUnion, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
@@ -275,6 +291,53 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.
 	return reflect.StructField{}, false
 }
 
+// UnionModel is implemented by Terraform model types that correspond to an AWS API union type.
+// Each of the model's fields corresponds to a union member, and at most one field is set.
+// A field corresponds to a union member type whose name ends in "Member" followed by the field's name,
+// e.g. field `S3Configuration` corresponds to `awstypes.DataSourceConfigurationMemberS3Configuration`.
+// The field's value is expanded to and flattened from the member's `Value` field.
+type UnionModel interface {
+	// UnionMembers returns zero values of the union member types that the model expands to and flattens from.
+	// Members of more than one union type, e.g. `Configuration` and `UpdateConfiguration`, may be returned;
+	// when expanding, the member implementing the target union type is used.
+	UnionMembers() []any
+}
+
+// unionMemberTypes returns the member struct types declared by a UnionModel.
+func unionMemberTypes(union UnionModel) []reflect.Type {
+	var memberTypes []reflect.Type
+
+	for _, member := range union.UnionMembers() {
+		typ := reflect.TypeOf(member)
+		if typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if _, ok := typ.FieldByName(unionMemberValueFieldName); typ.Kind() == reflect.Struct && ok {
+			memberTypes = append(memberTypes, typ)
+		}
+	}
+
+	return memberTypes
+}
+
+// unionMemberField returns the field of union model struct type `unionType` that corresponds to union member struct type `memberType`.
+func unionMemberField(unionType, memberType reflect.Type) (reflect.StructField, bool) {
+	memberName := strings.ToLower(memberType.Name())
+
+	for i := 0; i < unionType.NumField(); i++ {
+		field := unionType.Field(i)
+		if !field.IsExported() {
+			continue // Skip unexported fields.
+		}
+
+		if strings.HasSuffix(memberName, "member"+strings.ToLower(field.Name)) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
 func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	_, ok := structType.FieldByName(field)
 	return ok
@@ -386,6 +386,10 @@ type tfSingleARNField struct {
 	Field1 fwtypes.ARN `tfsdk:"field1"`
 }
 
+type tfSingleARNOfField struct {
+	Field1 fwtypes.ARNOf[fwtypes.SQSQueueARN] `tfsdk:"field1"`
+}
+
 type tfMapBlockList struct {
 	MapBlock fwtypes.ListNestedObjectValueOf[tfMapBlockElement] `tfsdk:"map_block"`
 }
@@ -554,6 +558,55 @@ var _ awsInterfaceInterface = &awsInterfaceInterfaceImpl{}
 
 func (t *awsInterfaceInterfaceImpl) isAWSInterfaceInterface() {} // nosemgrep:ci.aws-in-func-name
 
+type tfUnion struct {
+	Object fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"object"`
+	String types.String                                         `tfsdk:"string"`
+}
+
+var _ UnionModel = tfUnion{}
+
+func (tfUnion) UnionMembers() []any {
+	return []any{
+		awsUnionMemberObject{},
+		awsUnionMemberString{},
+		awsUpdateUnionMemberString{},
+	}
+}
+
+type awsUnionSingle struct {
+	Field1 awsUnionInterface
+}
+
+type awsUnionSlice struct {
+	Field1 []awsUnionInterface
+}
+
+type awsUnionInterface interface {
+	isAWSUnionInterface()
+}
+
+type awsUnionMemberObject struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberObject) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
+type awsUpdateUnionInterface interface {
+	isAWSUpdateUnionInterface()
+}
+
+type awsUpdateUnionMemberString struct {
+	Value string
+}
+
+func (*awsUpdateUnionMemberString) isAWSUpdateUnionInterface() {} // nosemgrep:ci.aws-in-func-name
+
 type tfFlexer struct {
 	Field1 types.String `tfsdk:"field1"`
 }
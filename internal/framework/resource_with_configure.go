@@ -18,6 +18,7 @@ import (
 // ResourceWithConfigure is a structure to be embedded within a Resource that implements the ResourceWithConfigure interface.
 type ResourceWithConfigure struct {
 	withMeta
+	WithRegionOverride
 }
 
 // Configure enables provider-level data or clients to be set in the
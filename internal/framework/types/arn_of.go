@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ARNResource describes the ARNs accepted by an ARNOf type.
+// Implementations are empty structs, e.g. SQSQueueARN.
+type ARNResource interface {
+	// ARNService returns the ARN's service namespace, e.g. "events".
+	ARNService() string
+	// ARNResourceType returns the resource type that prefixes the ARN's resource section, e.g. "rule" for "rule/example".
+	// It is empty if the resource section is only the resource's name, as in SQS queue and S3 bucket ARNs.
+	ARNResourceType() string
+}
+
+type (
+	// EventBridgeEventBusARN describes Amazon EventBridge event bus ARNs, e.g. "arn:aws:events:us-west-2:123456789012:event-bus/default".
+	EventBridgeEventBusARN struct{}
+	// EventBridgeRuleARN describes Amazon EventBridge rule ARNs, e.g. "arn:aws:events:us-west-2:123456789012:rule/example".
+	EventBridgeRuleARN struct{}
+	// S3BucketARN describes Amazon S3 bucket ARNs, e.g. "arn:aws:s3:::example".
+	S3BucketARN struct{}
+	// SQSQueueARN describes Amazon SQS queue ARNs, e.g. "arn:aws:sqs:us-west-2:123456789012:example".
+	SQSQueueARN struct{}
+)
+
+func (EventBridgeEventBusARN) ARNService() string      { return "events" }
+func (EventBridgeEventBusARN) ARNResourceType() string { return "event-bus" }
+func (EventBridgeRuleARN) ARNService() string          { return "events" }
+func (EventBridgeRuleARN) ARNResourceType() string     { return "rule" }
+func (S3BucketARN) ARNService() string                 { return "s3" }
+func (S3BucketARN) ARNResourceType() string            { return "" }
+func (SQSQueueARN) ARNService() string                 { return "sqs" }
+func (SQSQueueARN) ARNResourceType() string            { return "" }
+
+var (
+	_ basetypes.StringTypable = (*arnOfType[SQSQueueARN])(nil)
+)
+
+type arnOfType[T ARNResource] struct {
+	basetypes.StringType
+}
+
+// ARNOfType returns the type of ARNs described by T.
+func ARNOfType[T ARNResource]() basetypes.StringTypable {
+	return arnOfType[T]{}
+}
+
+func (t arnOfType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(arnOfType[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (arnOfType[T]) String() string {
+	var zero T
+	// The format of this returned value is used inside AutoFlEx.
+	return fmt.Sprintf("ARNOfType[%T]", zero)
+}
+
+func (t arnOfType[T]) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return ARNOfNull[T](), diags
+	}
+	if in.IsUnknown() {
+		return ARNOfUnknown[T](), diags
+	}
+
+	return ARNOf[T]{StringValue: in}, diags
+}
+
+func (t arnOfType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t arnOfType[T]) ValueType(context.Context) attr.Value {
+	return ARNOf[T]{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*ARNOf[SQSQueueARN])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*ARNOf[SQSQueueARN])(nil)
+	_ xattr.ValidateableAttribute                = (*ARNOf[SQSQueueARN])(nil)
+)
+
+// ARNOf is an ARN of the service and resource type described by T.
+// Values are validated at plan time, and ARNs that differ only in the case of their partition, service, Region or resource type,
+// or that use the "aws" partition in place of the partition of their Region, are semantically equal.
+type ARNOf[T ARNResource] struct {
+	basetypes.StringValue
+}
+
+func ARNOfNull[T ARNResource]() ARNOf[T] {
+	return ARNOf[T]{StringValue: basetypes.NewStringNull()}
+}
+
+func ARNOfUnknown[T ARNResource]() ARNOf[T] {
+	return ARNOf[T]{StringValue: basetypes.NewStringUnknown()}
+}
+
+// ARNOfValue initializes a new ARNOf type with the provided value.
+// Invalid values are detected by the ValidateAttribute method.
+func ARNOfValue[T ARNResource](value string) ARNOf[T] {
+	return ARNOf[T]{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v ARNOf[T]) Equal(o attr.Value) bool {
+	other, ok := o.(ARNOf[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (ARNOf[T]) Type(context.Context) attr.Type {
+	return ARNOfType[T]()
+}
+
+// ValueARN returns the known arn.ARN value. If ARNOf is null, unknown, or invalid returns ARN{}.
+func (v ARNOf[T]) ValueARN() arn.ARN {
+	value, _ := arn.Parse(v.ValueString())
+
+	return value
+}
+
+func (v ARNOf[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ARNOf[T])
+
+	if !ok {
+		return false, diags
+	}
+
+	return arnsEquivalent(v.ValueString(), newValue.ValueString(), newValue.resourceTyped()), diags
+}
+
+func (v ARNOf[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	var resource T
+	value, err := arn.Parse(v.ValueString())
+
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid ARN Value",
+			"The provided value cannot be parsed as an ARN.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	case !strings.EqualFold(value.Service, resource.ARNService()):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid ARN Service",
+			"The provided ARN's service must be "+resource.ARNService()+".\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	case resource.ARNResourceType() != "":
+		if resourceType, _, ok := cutARNResourceType(value.Resource); !ok || !strings.EqualFold(resourceType, resource.ARNResourceType()) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid ARN Resource Type",
+				"The provided ARN's resource type must be "+resource.ARNResourceType()+".\n\n"+
+					"Path: "+req.Path.String()+"\n"+
+					"Value: "+v.ValueString(),
+			)
+		}
+	case strings.ContainsAny(value.Resource, "/:"):
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid ARN Resource",
+			"The provided ARN's resource must be a "+resource.ARNService()+" resource name, without a resource type or path.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
+
+func (v ARNOf[T]) resourceTyped() bool {
+	var resource T
+
+	return resource.ARNResourceType() != ""
+}
+
+// arnsEquivalent returns whether two ARNs identify the same resource.
+// The partition, service, Region and any resource type are compared case-insensitively,
+// and the "aws" partition matches the partition of the ARNs' Region so that ARNs built with a hard-coded "aws" partition
+// equal those returned by AWS in other partitions. The partition of ARNs without a Region, e.g. S3 bucket ARNs, must match.
+func arnsEquivalent(s1, s2 string, resourceTyped bool) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	arn1, err := arn.Parse(s1)
+	if err != nil {
+		return false
+	}
+	arn2, err := arn.Parse(s2)
+	if err != nil {
+		return false
+	}
+
+	if !partitionsEquivalent(arn1.Partition, arn2.Partition, arn1.Region) {
+		return false
+	}
+
+	if !strings.EqualFold(arn1.Service, arn2.Service) || !strings.EqualFold(arn1.Region, arn2.Region) || arn1.AccountID != arn2.AccountID {
+		return false
+	}
+
+	if resourceTyped {
+		resourceType1, resource1, ok1 := cutARNResourceType(arn1.Resource)
+		resourceType2, resource2, ok2 := cutARNResourceType(arn2.Resource)
+
+		if ok1 && ok2 {
+			return strings.EqualFold(resourceType1, resourceType2) && resource1 == resource2
+		}
+	}
+
+	return arn1.Resource == arn2.Resource
+}
+
+// partitionsEquivalent returns whether two ARN partitions are equal, the "aws" partition also matching the partition of the specified Region.
+func partitionsEquivalent(partition1, partition2, region string) bool {
+	if strings.EqualFold(partition1, partition2) {
+		return true
+	}
+
+	partition := names.PartitionForRegion(strings.ToLower(region))
+
+	switch {
+	case strings.EqualFold(partition1, names.StandardPartitionID):
+		return strings.EqualFold(partition2, partition)
+	case strings.EqualFold(partition2, names.StandardPartitionID):
+		return strings.EqualFold(partition1, partition)
+	}
+
+	return false
+}
+
+// cutARNResourceType slices an ARN's resource section around the separator, "/" or ":", that follows its resource type.
+func cutARNResourceType(s string) (string, string, bool) {
+	i := strings.IndexAny(s, "/:")
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:i], s[i+1:], true
+}
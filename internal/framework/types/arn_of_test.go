@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestARNOfTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.ARNOfNull[fwtypes.SQSQueueARN](),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.ARNOfUnknown[fwtypes.SQSQueueARN](),
+		},
+		"valid ARN": {
+			val:      tftypes.NewValue(tftypes.String, "arn:aws:sqs:us-west-2:123456789012:test"),        // lintignore:AWSAT003,AWSAT005
+			expected: fwtypes.ARNOfValue[fwtypes.SQSQueueARN]("arn:aws:sqs:us-west-2:123456789012:test"), // lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.ARNOfType[fwtypes.SQSQueueARN]().ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestARNOfTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	if !fwtypes.ARNOfType[fwtypes.SQSQueueARN]().Equal(fwtypes.ARNOfType[fwtypes.SQSQueueARN]()) {
+		t.Error("expected ARNOfType[SQSQueueARN] to equal itself")
+	}
+	if fwtypes.ARNOfType[fwtypes.SQSQueueARN]().Equal(fwtypes.ARNOfType[fwtypes.S3BucketARN]()) {
+		t.Error("expected ARNOfType[SQSQueueARN] not to equal ARNOfType[S3BucketARN]")
+	}
+	if fwtypes.ARNOfType[fwtypes.SQSQueueARN]().Equal(fwtypes.ARNType) {
+		t.Error("expected ARNOfType[SQSQueueARN] not to equal ARNType")
+	}
+}
+
+func TestARNOfValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         xattr.ValidateableAttribute
+		expectError bool
+	}{
+		"null value": {
+			val: fwtypes.ARNOfNull[fwtypes.EventBridgeRuleARN](),
+		},
+		"unknown value": {
+			val: fwtypes.ARNOfUnknown[fwtypes.EventBridgeRuleARN](),
+		},
+		"invalid ARN": {
+			val:         fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("not ok"),
+			expectError: true,
+		},
+		"rule": {
+			val: fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("arn:aws:events:us-west-2:123456789012:rule/test"), // lintignore:AWSAT003,AWSAT005
+		},
+		"rule on custom event bus": {
+			val: fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("arn:aws:events:us-west-2:123456789012:rule/bus/test"), // lintignore:AWSAT003,AWSAT005
+		},
+		"rule wrong service": {
+			val:         fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("arn:aws:sqs:us-west-2:123456789012:rule/test"), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"rule wrong resource type": {
+			val:         fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("arn:aws:events:us-west-2:123456789012:event-bus/test"), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"rule no resource type": {
+			val:         fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN]("arn:aws:events:us-west-2:123456789012:test"), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"event bus": {
+			val: fwtypes.ARNOfValue[fwtypes.EventBridgeEventBusARN]("arn:aws:events:us-west-2:123456789012:event-bus/default"), // lintignore:AWSAT003,AWSAT005
+		},
+		"bucket": {
+			val: fwtypes.ARNOfValue[fwtypes.S3BucketARN]("arn:aws:s3:::test"), // lintignore:AWSAT005
+		},
+		"bucket object": {
+			val:         fwtypes.ARNOfValue[fwtypes.S3BucketARN]("arn:aws:s3:::test/key"), // lintignore:AWSAT005
+			expectError: true,
+		},
+		"queue": {
+			val: fwtypes.ARNOfValue[fwtypes.SQSQueueARN]("arn:aws-us-gov:sqs:us-gov-west-1:123456789012:test"), // lintignore:AWSAT003,AWSAT005
+		},
+		"queue wrong service": {
+			val:         fwtypes.ARNOfValue[fwtypes.SQSQueueARN]("arn:aws:sns:us-west-2:123456789012:test"), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestARNOfStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val1, val2 string
+		equals     bool
+	}{
+		"identical": {
+			val1:   "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2:   "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			equals: true,
+		},
+		"case-insensitive sections": {
+			val1:   "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2:   "arn:AWS:Events:US-WEST-2:123456789012:Rule/test", // lintignore:AWSAT003,AWSAT005
+			equals: true,
+		},
+		"case-sensitive resource name": {
+			val1: "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2: "arn:aws:events:us-west-2:123456789012:rule/TEST", // lintignore:AWSAT003,AWSAT005
+		},
+		"aws partition placeholder": {
+			val1:   "arn:aws:events:us-gov-west-1:123456789012:rule/test",        // lintignore:AWSAT003,AWSAT005
+			val2:   "arn:aws-us-gov:events:us-gov-west-1:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			equals: true,
+		},
+		"aws partition placeholder in another partition's Region": {
+			val1: "arn:aws:events:us-west-2:123456789012:rule/test",        // lintignore:AWSAT003,AWSAT005
+			val2: "arn:aws-us-gov:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+		},
+		"aws partition placeholder in China Region": {
+			val1:   "arn:aws-cn:events:cn-north-1:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2:   "arn:aws:events:cn-north-1:123456789012:rule/test",    // lintignore:AWSAT003,AWSAT005
+			equals: true,
+		},
+		"aws partition placeholder without Region": {
+			val1: "arn:aws:events::123456789012:rule/test",        // lintignore:AWSAT003,AWSAT005
+			val2: "arn:aws-us-gov:events::123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+		},
+		"different partitions": {
+			val1: "arn:aws-cn:events:us-gov-west-1:123456789012:rule/test",     // lintignore:AWSAT003,AWSAT005
+			val2: "arn:aws-us-gov:events:us-gov-west-1:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+		},
+		"different accounts": {
+			val1: "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2: "arn:aws:events:us-west-2:210987654321:rule/test", // lintignore:AWSAT003,AWSAT005
+		},
+		"invalid": {
+			val1: "arn:aws:events:us-west-2:123456789012:rule/test", // lintignore:AWSAT003,AWSAT005
+			val2: "not ok",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN](test.val1).StringSemanticEquals(ctx, fwtypes.ARNOfValue[fwtypes.EventBridgeRuleARN](test.val2))
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/asl"
+)
+
+// stateMachineDefinitionValidator validates that a string Attribute's value is a valid Amazon States Language definition.
+type stateMachineDefinitionValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator stateMachineDefinitionValidator) Description(_ context.Context) string {
+	return "value must be a valid Amazon States Language definition"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator stateMachineDefinitionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (validator stateMachineDefinitionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, finding := range asl.Validate(request.ConfigValue.ValueString()) {
+		switch finding.Severity {
+		case asl.SeverityError:
+			response.Diagnostics.AddAttributeError(request.Path, "Invalid State Machine Definition", fmt.Sprintf("Attribute %s %s, got %s at %s: %s", request.Path, validator.Description(ctx), finding.Code, finding.Location, finding.Message))
+		default:
+			response.Diagnostics.AddAttributeWarning(request.Path, "State Machine Definition Warning", fmt.Sprintf("%s at %s: %s", finding.Code, finding.Location, finding.Message))
+		}
+	}
+}
+
+// StateMachineDefinition returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid Amazon States Language (Step Functions state machine) definition.
+//
+// Findings of severity WARNING, such as the use of deprecated fields, are reported as warnings.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func StateMachineDefinition() validator.String {
+	return stateMachineDefinitionValidator{}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+func TestStateMachineDefinitionValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.String
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid definition": {
+			val: types.StringValue(`{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Result": "World", "End": true}}}`),
+		},
+		"missing transition target": {
+			val: types.StringValue(`{"StartAt": "Hello", "States": {"Hello": {"Type": "Pass", "Next": "World"}}}`),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid State Machine Definition",
+					`Attribute test value must be a valid Amazon States Language definition, got MISSING_TRANSITION_TARGET at /States/Hello/Next: state "World" does not exist`,
+				),
+			},
+		},
+		"deprecated field": {
+			val: types.StringValue(`{"StartAt": "Map", "States": {"Map": {"Type": "Map", "Iterator": {"StartAt": "Hello", "States": {"Hello": {"Type": "Succeed"}}}, "End": true}}}`),
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test"),
+					"State Machine Definition Warning",
+					`DEPRECATED_FIELD at /States/Map/Iterator: Iterator is deprecated, use ItemProcessor`,
+				),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			fwvalidators.StateMachineDefinition().ValidateString(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
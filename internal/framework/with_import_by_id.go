@@ -13,6 +13,9 @@ import (
 
 // WithImportByID is intended to be embedded in resources which import state via the "id" attribute.
 // See https://developer.hashicorp.com/terraform/plugin/framework/resources/import.
+//
+// Resources annotated with `@Identity` can also be imported via an `import` block's `identity` argument;
+// the provider builds the import ID from the identity's natural key attributes before ImportState is called.
 type WithImportByID struct{}
 
 func (w *WithImportByID) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
@@ -4,6 +4,8 @@
 package framework
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
@@ -26,11 +28,12 @@ func (w *withMeta) Meta() *conns.AWSClient {
 }
 
 // RegionalARN returns a regional ARN for the specified service namespace and resource.
-func (w *withMeta) RegionalARN(service, resource string) string {
+// The ARN's Region is the Region that the current operation targets.
+func (w *withMeta) RegionalARN(ctx context.Context, service, resource string) string {
 	return arn.ARN{
 		Partition: w.meta.Partition,
 		Service:   service,
-		Region:    w.meta.Region,
+		Region:    w.meta.EffectiveRegion(ctx),
 		AccountID: w.meta.AccountID,
 		Resource:  resource,
 	}.String()
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+// WithRegionOverride is embedded in ResourceWithConfigure and DataSourceWithConfigure so that all resources and data sources
+// for regional services support a per-resource Region override.
+// The provider adds the top-level `region` argument to the schema unless the resource or data source already defines one.
+// The argument is hidden from the resource's or data source's own schema and model; use conns.AWSClient.EffectiveRegion to read the Region.
+type WithRegionOverride struct{}
+
+// SupportsRegionOverride indicates that the top-level `region` argument is to be added to the schema.
+func (w *WithRegionOverride) SupportsRegionOverride() {}
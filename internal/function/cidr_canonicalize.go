@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrCanonicalizeFunction{}
+
+func NewCIDRCanonicalizeFunction() function.Function {
+	return &cidrCanonicalizeFunction{}
+}
+
+type cidrCanonicalizeFunction struct{}
+
+func (f cidrCanonicalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_canonicalize"
+}
+
+func (f cidrCanonicalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_canonicalize Function",
+		MarkdownDescription: "Returns the canonical representation of a CIDR block, " +
+			"for example with IPv6 addresses in lowercase and with zeros compressed",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR block",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cidrCanonicalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := itypes.ParseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefix.String()))
+}
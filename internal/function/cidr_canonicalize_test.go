@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRCanonicalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRCanonicalizeFunctionConfig("10.0.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "10.0.0.0/16"),
+				),
+			},
+			{
+				Config: testCIDRCanonicalizeFunctionConfig("2001:0DB8:0000::/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2001:db8::/32"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRCanonicalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRCanonicalizeFunctionConfig("10.0.0.1/16"),
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block;[\s\n]*did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
+
+func testCIDRCanonicalizeFunctionConfig(cidr string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::cidr_canonicalize(%[1]q)
+}
+`, cidr)
+}
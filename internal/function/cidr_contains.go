@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrContainsFunction{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+type cidrContainsFunction struct{}
+
+func (f cidrContainsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f cidrContainsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_contains Function",
+		MarkdownDescription: "Checks whether a CIDR block contains an IP address or all of the IP addresses of another CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR block",
+			},
+			function.StringParameter{
+				Name:                "address",
+				MarkdownDescription: "IP address or CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, address string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &address))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := itypes.ParseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+
+	var other netip.Prefix
+	if strings.Contains(address, "/") {
+		other, err = itypes.ParseCIDRBlock(address)
+	} else {
+		var addr netip.Addr
+		addr, err = netip.ParseAddr(address)
+		if err != nil {
+			err = fmt.Errorf("%q is not a valid IP address: %w", address, err)
+		}
+		other = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	contains := prefix.Bits() <= other.Bits() && prefix.Contains(other.Addr())
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, contains))
+}
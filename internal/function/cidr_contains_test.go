@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRContainsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "ip" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "10.0.1.1")
+}
+
+output "cidr" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "10.0.1.0/24")
+}
+
+output "larger_cidr" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "10.0.0.0/8")
+}
+
+output "ipv6_in_ipv4" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "2001:db8::1")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("ip", "true"),
+					resource.TestCheckOutput("cidr", "true"),
+					resource.TestCheckOutput("larger_cidr", "false"),
+					resource.TestCheckOutput("ipv6_in_ipv4", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRContainsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "10.0.1.1/24")
+}
+`,
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block;[\s\n]*did[\s\n]*you[\s\n]*mean`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_contains("10.0.0.0/16", "10.0.1")
+}
+`,
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*IP[\s\n]*address`),
+			},
+		},
+	})
+}
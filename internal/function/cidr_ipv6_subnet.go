@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ipv6SubnetPrefixLength is the prefix length of VPC subnet IPv6 CIDR blocks.
+const ipv6SubnetPrefixLength = 64
+
+var _ function.Function = cidrIPv6SubnetFunction{}
+
+func NewCIDRIPv6SubnetFunction() function.Function {
+	return &cidrIPv6SubnetFunction{}
+}
+
+type cidrIPv6SubnetFunction struct{}
+
+func (f cidrIPv6SubnetFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_ipv6_subnet"
+}
+
+func (f cidrIPv6SubnetFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_ipv6_subnet Function",
+		MarkdownDescription: "Returns the /64 subnet with the specified number within an IPv6 CIDR block, " +
+			"such as the /56 CIDR block of a VPC",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv6 CIDR block with a prefix length of at most 64",
+			},
+			function.Int64Parameter{
+				Name:                "netnum",
+				MarkdownDescription: "Subnet number",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f cidrIPv6SubnetFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var netNum int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &netNum))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := verify.ValidateIPv6CIDRBlock(cidr); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	prefix, err := itypes.ParseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	if prefix.Bits() > ipv6SubnetPrefixLength {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q prefix length must be at most %d", cidr, ipv6SubnetPrefixLength)))
+		return
+	}
+
+	subnet, err := itypes.CIDRSubnet(prefix, ipv6SubnetPrefixLength-prefix.Bits(), netNum)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, subnet.String()))
+}
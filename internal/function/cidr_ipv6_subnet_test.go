@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRIPv6SubnetFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "first" {
+  value = provider::aws::cidr_ipv6_subnet("2600:1f14:abc:de00::/56", 0)
+}
+
+output "last" {
+  value = provider::aws::cidr_ipv6_subnet("2600:1f14:abc:de00::/56", 255)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("first", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("last", "2600:1f14:abc:deff::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRIPv6SubnetFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_ipv6_subnet("10.0.0.0/16", 0)
+}
+`,
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*IPv6[\s\n]*CIDR[\s\n]*block`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_ipv6_subnet("2600:1f14:abc:de01::/56", 0)
+}
+`,
+				ExpectError: regexache.MustCompile(`did[\s\n]*you[\s\n]*mean`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_ipv6_subnet("2600:1f14:abc:de00::/56", 256)
+}
+`,
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*accommodate[\s\n]*a[\s\n]*subnet[\s\n]*numbered[\s\n]*256`),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrOverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+type cidrOverlapsFunction struct{}
+
+func (f cidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f cidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "cidr_overlaps Function",
+		MarkdownDescription: "Checks whether two CIDR blocks have any IP addresses in common",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr1",
+				MarkdownDescription: "CIDR block",
+			},
+			function.StringParameter{
+				Name:                "cidr2",
+				MarkdownDescription: "CIDR block",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr1, cidr2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr1, &cidr2))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix1, err := itypes.ParseCIDRBlock(cidr1)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+
+	prefix2, err := itypes.ParseCIDRBlock(cidr2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, prefix1.Overlaps(prefix2)))
+}
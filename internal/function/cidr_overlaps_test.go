@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDROverlapsFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "overlapping" {
+  value = provider::aws::cidr_overlaps("10.0.0.0/16", "10.0.128.0/17")
+}
+
+output "disjoint" {
+  value = provider::aws::cidr_overlaps("10.0.0.0/16", "10.1.0.0/16")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("overlapping", "true"),
+					resource.TestCheckOutput("disjoint", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDROverlapsFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_overlaps("10.0.0.0/16", "10.1.0.1/16")
+}
+`,
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block;[\s\n]*did[\s\n]*you[\s\n]*mean`),
+			},
+		},
+	})
+}
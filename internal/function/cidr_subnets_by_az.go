@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var _ function.Function = cidrSubnetsByAZFunction{}
+
+func NewCIDRSubnetsByAZFunction() function.Function {
+	return &cidrSubnetsByAZFunction{}
+}
+
+type cidrSubnetsByAZFunction struct{}
+
+func (f cidrSubnetsByAZFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_az"
+}
+
+func (f cidrSubnetsByAZFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_by_az Function",
+		MarkdownDescription: "Divides a CIDR block into consecutive subnets of equal size, one per Availability Zone, " +
+			"and returns a map of Availability Zone to subnet CIDR block",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "CIDR block, such as the CIDR block of a VPC",
+			},
+			function.Int64Parameter{
+				Name:                "newbits",
+				MarkdownDescription: "Number of additional bits with which to extend the prefix of each subnet",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zones, in the order in which subnets are allocated",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f cidrSubnetsByAZFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var newBits int64
+	var availabilityZones []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &newBits, &availabilityZones))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := itypes.ParseCIDRBlock(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make(map[string]string, len(availabilityZones))
+
+	for i, availabilityZone := range availabilityZones {
+		if _, ok := result[availabilityZone]; ok {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("duplicate Availability Zone (%s)", availabilityZone)))
+			return
+		}
+
+		subnet, err := itypes.CIDRSubnet(prefix, int(newBits), int64(i))
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+			return
+		}
+
+		result[availabilityZone] = subnet.String()
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
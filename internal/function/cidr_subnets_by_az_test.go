@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsByAZFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  subnets = provider::aws::cidr_subnets_by_az("10.0.0.0/16", 4, ["us-west-2a", "us-west-2b", "us-west-2c"])
+}
+
+output "a" {
+  value = local.subnets["us-west-2a"]
+}
+
+output "b" {
+  value = local.subnets["us-west-2b"]
+}
+
+output "c" {
+  value = local.subnets["us-west-2c"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("a", "10.0.0.0/20"),
+					resource.TestCheckOutput("b", "10.0.16.0/20"),
+					resource.TestCheckOutput("c", "10.0.32.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_subnets_by_az("10.0.0.1/16", 4, ["us-west-2a"])
+}
+`,
+				ExpectError: regexache.MustCompile(`is[\s\n]*not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block;[\s\n]*did[\s\n]*you[\s\n]*mean`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_subnets_by_az("10.0.0.0/16", 1, ["us-west-2a", "us-west-2b", "us-west-2c"])
+}
+`,
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*accommodate[\s\n]*a[\s\n]*subnet[\s\n]*numbered[\s\n]*2`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::aws::cidr_subnets_by_az("10.0.0.0/16", 4, ["us-west-2a", "us-west-2a"])
+}
+`,
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Availability[\s\n]*Zone`),
+			},
+		},
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/cloudfrontfunction"
+)
+
+var cloudFrontFunctionEvaluateResultAttrTypes = map[string]attr.Type{
+	"compute_utilization":     types.Int64Type,
+	"function_error_message":  types.StringType,
+	"function_execution_logs": types.ListType{ElemType: types.StringType},
+	"function_output":         types.StringType,
+}
+
+var _ function.Function = cloudFrontFunctionEvaluateFunction{}
+
+func NewCloudFrontFunctionEvaluateFunction() function.Function {
+	return &cloudFrontFunctionEvaluateFunction{}
+}
+
+type cloudFrontFunctionEvaluateFunction struct{}
+
+func (f cloudFrontFunctionEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloudfront_function_evaluate"
+}
+
+func (f cloudFrontFunctionEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cloudfront_function_evaluate Function",
+		MarkdownDescription: "Runs CloudFront Function code against a viewer request or viewer response event without calling AWS. " +
+			"Returns the function's output, error message, execution logs and estimated compute utilization",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "code",
+				MarkdownDescription: "Function code",
+			},
+			function.StringParameter{
+				Name:                "runtime",
+				MarkdownDescription: "Runtime, `cloudfront-js-1.0` or `cloudfront-js-2.0`",
+			},
+			function.StringParameter{
+				Name:                "event_object",
+				MarkdownDescription: "Event object in JSON format",
+			},
+			function.MapParameter{
+				Name:                "key_value_store",
+				MarkdownDescription: "Keys and values of the key value store associated with the function",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cloudFrontFunctionEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f cloudFrontFunctionEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var code, runtime, eventObject string
+	var keyValueStore map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &code, &runtime, &eventObject, &keyValueStore))
+	if resp.Error != nil {
+		return
+	}
+
+	fn, err := cloudfrontfunction.Compile(runtime, code)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	output, err := fn.Evaluate(ctx, &cloudfrontfunction.Input{
+		Event:         eventObject,
+		KeyValueStore: keyValueStore,
+	})
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, err.Error()))
+		return
+	}
+
+	executionLogs := make([]attr.Value, 0, len(output.ExecutionLogs))
+	for _, v := range output.ExecutionLogs {
+		executionLogs = append(executionLogs, types.StringValue(v))
+	}
+
+	logs, d := types.ListValue(types.StringType, executionLogs)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"compute_utilization":     types.Int64Value(int64(output.ComputeUtilization)),
+		"function_error_message":  types.StringValue(output.ErrorMessage),
+		"function_execution_logs": logs,
+		"function_output":         types.StringValue(output.Output),
+	}
+
+	result, d := types.ObjectValue(cloudFrontFunctionEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCloudFrontFunctionEvaluateFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudFrontFunctionEvaluateFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("redirect_status_code", "301"),
+					resource.TestCheckOutput("redirect_location", "https://www.example.com/index.html"),
+					resource.TestCheckOutput("redirect_log", "redirecting example.com"),
+					resource.TestCheckOutput("pass_through_uri", "/index.html"),
+					resource.TestCheckOutput("error_message", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudFrontFunctionEvaluateFunction_keyValueStore(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudFrontFunctionEvaluateFunctionConfig_keyValueStore(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("uri", "/v2/index.html"),
+					resource.TestCheckOutput("error_message", "Error: key not found: version"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudFrontFunctionEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCloudFrontFunctionEvaluateFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`SyntaxError`),
+			},
+		},
+	})
+}
+
+func testCloudFrontFunctionEvaluateFunctionConfig_basic() string {
+	return `
+locals {
+  code = <<-EOT
+function handler(event) {
+  var host = event.request.headers.host.value;
+  if (host.indexOf('www.') !== 0) {
+    console.log('redirecting', host);
+    return {
+      statusCode: 301,
+      statusDescription: 'Moved Permanently',
+      headers: { location: { value: 'https://www.' + host + event.request.uri } }
+    };
+  }
+  return event.request;
+}
+EOT
+
+  redirect = provider::aws::cloudfront_function_evaluate(local.code, "cloudfront-js-1.0", jsonencode({
+    version = "1.0"
+    context = { eventType = "viewer-request" }
+    viewer  = { ip = "198.51.100.11" }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = { host = { value = "example.com" } }
+      querystring = {}
+      cookies     = {}
+    }
+  }), {})
+
+  pass_through = provider::aws::cloudfront_function_evaluate(local.code, "cloudfront-js-1.0", jsonencode({
+    version = "1.0"
+    context = { eventType = "viewer-request" }
+    viewer  = { ip = "198.51.100.11" }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = { host = { value = "www.example.com" } }
+      querystring = {}
+      cookies     = {}
+    }
+  }), {})
+}
+
+output "redirect_status_code" {
+  value = jsondecode(local.redirect.function_output).response.statusCode
+}
+
+output "redirect_location" {
+  value = jsondecode(local.redirect.function_output).response.headers.location.value
+}
+
+output "redirect_log" {
+  value = local.redirect.function_execution_logs[0]
+}
+
+output "pass_through_uri" {
+  value = jsondecode(local.pass_through.function_output).request.uri
+}
+
+output "error_message" {
+  value = local.pass_through.function_error_message
+}`
+}
+
+func testCloudFrontFunctionEvaluateFunctionConfig_keyValueStore() string {
+	return `
+locals {
+  code = <<-EOT
+import cf from 'cloudfront';
+
+const kvsHandle = cf.kvs();
+
+async function handler(event) {
+  const request = event.request;
+  const version = await kvsHandle.get('version');
+  request.uri = '/' + version + request.uri;
+  return request;
+}
+EOT
+
+  event = jsonencode({
+    version = "1.0"
+    context = { eventType = "viewer-request" }
+    viewer  = { ip = "198.51.100.11" }
+    request = {
+      method      = "GET"
+      uri         = "/index.html"
+      headers     = {}
+      querystring = {}
+      cookies     = {}
+    }
+  })
+
+  found   = provider::aws::cloudfront_function_evaluate(local.code, "cloudfront-js-2.0", local.event, { version = "v2" })
+  missing = provider::aws::cloudfront_function_evaluate(local.code, "cloudfront-js-2.0", local.event, {})
+}
+
+output "uri" {
+  value = jsondecode(local.found.function_output).request.uri
+}
+
+output "error_message" {
+  value = local.missing.function_error_message
+}`
+}
+
+func testCloudFrontFunctionEvaluateFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::cloudfront_function_evaluate("function handler(event) {", "cloudfront-js-1.0", "{}", {})
+}`
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = dnsSuffixFunction{}
+
+func NewDNSSuffixFunction() function.Function {
+	return &dnsSuffixFunction{}
+}
+
+type dnsSuffixFunction struct{}
+
+func (f dnsSuffixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_suffix"
+}
+
+func (f dnsSuffixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dns_suffix Function",
+		MarkdownDescription: "Returns the DNS suffix of AWS service endpoints in a partition",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition identifier, such as `aws` or `aws-cn`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dnsSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partition string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &partition))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validatePartition(partition); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, names.DNSSuffixForPartition(partition)))
+}
+
+func validatePartition(partition string) error {
+	if partitions := names.Partitions(); !slices.Contains(partitions, partition) {
+		return fmt.Errorf("%q is not a valid partition; must be one of %q", partition, partitions)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+var _ function.Function = eventPatternMatchesFunction{}
+
+func NewEventPatternMatchesFunction() function.Function {
+	return &eventPatternMatchesFunction{}
+}
+
+type eventPatternMatchesFunction struct{}
+
+func (f eventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_matches"
+}
+
+func (f eventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "event_pattern_matches Function",
+		MarkdownDescription: "Checks whether an event matches an Amazon EventBridge event pattern, without calling AWS",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "Event pattern in JSON format",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Event in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := eventpattern.Parse(pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("invalid event pattern: %s", err)))
+		return
+	}
+
+	result, err := p.Matches(event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("invalid event: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testEventPatternMatchesConfig_base = `
+locals {
+  event = jsonencode({
+    source        = "aws.ec2"
+    "detail-type" = "EC2 Instance State-change Notification"
+    detail = {
+      "instance-id" = "i-1234567890abcdef0"
+      state         = "stopped"
+    }
+  })
+}
+`
+
+func TestEventPatternMatchesFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesConfig_base + `
+output "match" {
+  value = provider::aws::event_pattern_matches(jsonencode({
+    source = ["aws.ec2"]
+    detail = {
+      state = [{ "anything-but" = ["running", "pending"] }]
+    }
+  }), local.event)
+}
+
+output "no_match" {
+  value = provider::aws::event_pattern_matches(jsonencode({
+    source = [{ prefix = "aws.s3" }]
+  }), local.event)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("match", "true"),
+					resource.TestCheckOutput("no_match", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesConfig_base + `
+output "test" {
+  value = provider::aws::event_pattern_matches(jsonencode({
+    source = [{ "begins-with" = "aws." }]
+  }), local.event)
+}
+`,
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*event[\s\n]*pattern`),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyEquivalentFunction{}
+
+func NewIAMPolicyEquivalentFunction() function.Function {
+	return &iamPolicyEquivalentFunction{}
+}
+
+type iamPolicyEquivalentFunction struct{}
+
+func (f iamPolicyEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_equivalent"
+}
+
+func (f iamPolicyEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_equivalent Function",
+		MarkdownDescription: "Checks whether two IAM policy documents are semantically equivalent, " +
+			"ignoring differences such as the order of statements and of values within statements.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document1",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+			function.StringParameter{
+				Name:                "document2",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f iamPolicyEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document1, document2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document1, &document2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, document := range []string{document1, document2} {
+		if _, err := parseIAMPolicyDocument(document); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), err.Error()))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.PolicyStringsEquivalent(document1, document2)))
+}
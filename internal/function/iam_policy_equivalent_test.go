@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEquivalentFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEquivalentFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("equivalent", "true"),
+					resource.TestCheckOutput("different", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEquivalentFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEquivalentFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`Invalid value for "document2" parameter`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEquivalentFunctionConfig_basic() string {
+	return `
+locals {
+  policy1 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Resource = "*"
+    }]
+  })
+
+  policy2 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Resource = ["*"]
+      Action   = ["s3:PutObject", "s3:GetObject"]
+      Effect   = "Allow"
+    }]
+  })
+
+  policy3 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+}
+
+output "equivalent" {
+  value = provider::aws::iam_policy_equivalent(local.policy1, local.policy2)
+}
+
+output "different" {
+  value = provider::aws::iam_policy_equivalent(local.policy1, local.policy3)
+}`
+}
+
+func testIAMPolicyEquivalentFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_equivalent(jsonencode({ Version = "2012-10-17", Statement = [] }), "{")
+}`
+}
@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var iamPolicyEvaluateResultAttrTypes = map[string]attr.Type{
+	"allowed":               types.BoolType,
+	"decision":              types.StringType,
+	"decision_policy_type":  types.StringType,
+	"matched_statement_sid": types.StringType,
+}
+
+var _ function.Function = iamPolicyEvaluateFunction{}
+
+func NewIAMPolicyEvaluateFunction() function.Function {
+	return &iamPolicyEvaluateFunction{}
+}
+
+type iamPolicyEvaluateFunction struct{}
+
+func (f iamPolicyEvaluateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_evaluate"
+}
+
+func (f iamPolicyEvaluateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_evaluate Function",
+		MarkdownDescription: "Evaluates whether IAM policies allow a request, without calling AWS. " +
+			"Returns the decision, the type of policy that decided it and the Sid of the statement that decided it.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy documents in JSON format",
+				ElementType:         types.StringType,
+			},
+			function.StringParameter{
+				Name:                "action",
+				MarkdownDescription: "Action being requested, like `s3:GetObject`",
+			},
+			function.StringParameter{
+				Name:                "resource",
+				MarkdownDescription: "ARN of the resource that the action is performed on",
+			},
+			function.MapParameter{
+				Name:                "context",
+				MarkdownDescription: "Values of the condition keys used in the policies",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name: "options",
+			MarkdownDescription: "Object with optional `permissions_boundary_policies`, `resource_policies` and `service_control_policies` lists of IAM policy documents in JSON format " +
+				"and the `principal_arn` of the principal making the request",
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: iamPolicyEvaluateResultAttrTypes,
+		},
+	}
+}
+
+func (f iamPolicyEvaluateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var documents []string
+	var action, resource string
+	var requestContext map[string][]string
+	var options []types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &documents, &action, &resource, &requestContext, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	input := &tfiam.PolicyEvaluationInput{
+		Action:   action,
+		Resource: resource,
+		Context:  requestContext,
+	}
+
+	for i, document := range documents {
+		doc, err := parseIAMPolicyDocument(document)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("parsing policy document %d: %s", i, err)))
+			return
+		}
+
+		input.IdentityPolicies = append(input.IdentityPolicies, doc)
+	}
+
+	switch len(options) {
+	case 0:
+	case 1:
+		if err := expandIAMPolicyEvaluateOptions(options[0], input); err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, err.Error()))
+			return
+		}
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(5, "at most one options object may be specified"))
+		return
+	}
+
+	output, err := tfiam.EvaluatePolicies(input)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"allowed":               types.BoolValue(output.Allowed()),
+		"decision":              types.StringValue(string(output.Decision)),
+		"decision_policy_type":  types.StringValue(string(output.PolicyType)),
+		"matched_statement_sid": types.StringValue(output.MatchedStatementSid),
+	}
+
+	result, d := types.ObjectValue(iamPolicyEvaluateResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// expandIAMPolicyEvaluateOptions sets the fields of the input from the options argument.
+// The attribute names match the arguments of the aws_iam_policy_evaluation data source.
+func expandIAMPolicyEvaluateOptions(options types.Dynamic, input *tfiam.PolicyEvaluationInput) error {
+	if options.IsNull() || options.IsUnderlyingValueNull() {
+		return nil
+	}
+
+	object, ok := options.UnderlyingValue().(types.Object)
+	if !ok {
+		return errors.New("options must be an object")
+	}
+
+	attributes := object.Attributes()
+	for _, k := range slices.Sorted(maps.Keys(attributes)) {
+		v := attributes[k]
+
+		var policies *[]*tfiam.IAMPolicyDoc
+
+		switch k {
+		case "permissions_boundary_policies":
+			policies = &input.PermissionsBoundaries
+		case "principal_arn":
+			v, ok := v.(types.String)
+			if !ok {
+				return fmt.Errorf("%s must be a string", k)
+			}
+			input.Principal = v.ValueString()
+			continue
+		case "resource_policies":
+			policies = &input.ResourcePolicies
+		case "service_control_policies":
+			policies = &input.ServiceControlPolicies
+		default:
+			return fmt.Errorf("unsupported option %q", k)
+		}
+
+		documents, err := iamPolicyEvaluateStrings(v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+
+		for i, document := range documents {
+			doc, err := parseIAMPolicyDocument(document)
+			if err != nil {
+				return fmt.Errorf("%s: parsing policy document %d: %w", k, i, err)
+			}
+
+			*policies = append(*policies, doc)
+		}
+	}
+
+	return nil
+}
+
+// iamPolicyEvaluateStrings returns the elements of a list, set or tuple of strings.
+func iamPolicyEvaluateStrings(v attr.Value) ([]string, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	var elements []attr.Value
+	switch v := v.(type) {
+	case types.List:
+		elements = v.Elements()
+	case types.Set:
+		elements = v.Elements()
+	case types.Tuple:
+		elements = v.Elements()
+	default:
+		return nil, errors.New("must be a list of strings")
+	}
+
+	s := make([]string, 0, len(elements))
+	for _, e := range elements {
+		e, ok := e.(types.String)
+		if !ok || e.IsNull() {
+			return nil, errors.New("must be a list of strings")
+		}
+		s = append(s, e.ValueString())
+	}
+
+	return s, nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEvaluateFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("allowed", "true"),
+					resource.TestCheckOutput("allowed_sid", "Read"),
+					resource.TestCheckOutput("wrong_ip", "implicitDeny"),
+					resource.TestCheckOutput("denied", "explicitDeny"),
+					resource.TestCheckOutput("denied_sid", "DenyDelete"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_options(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEvaluateFunctionConfig_options(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("identity", "identity"),
+					resource.TestCheckOutput("boundary", "implicitDeny"),
+					resource.TestCheckOutput("boundary_type", "permissions_boundary"),
+					resource.TestCheckOutput("scp", "explicitDeny"),
+					resource.TestCheckOutput("scp_type", "service_control"),
+					resource.TestCheckOutput("resource_policy", "allowed"),
+					resource.TestCheckOutput("resource_policy_type", "resource"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEvaluateFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEvaluateFunctionConfig_invalid(),
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*policy[\s\n]*document[\s\n]*0`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyEvaluateFunctionConfig_basic() string {
+	return `
+locals {
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid      = "Read"
+        Effect   = "Allow"
+        Action   = ["s3:Get*", "s3:DeleteObject"]
+        Resource = "arn:aws:s3:::example/*"
+        Condition = {
+          IpAddress = { "aws:SourceIp" = "10.0.0.0/8" }
+        }
+      },
+      {
+        Sid      = "DenyDelete"
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+    ]
+  })
+
+  allowed  = provider::aws::iam_policy_evaluate([local.policy], "s3:GetObject", "arn:aws:s3:::example/key", { "aws:SourceIp" = ["10.1.2.3"] })
+  wrong_ip = provider::aws::iam_policy_evaluate([local.policy], "s3:GetObject", "arn:aws:s3:::example/key", { "aws:SourceIp" = ["192.168.0.1"] })
+  denied   = provider::aws::iam_policy_evaluate([local.policy], "s3:DeleteObject", "arn:aws:s3:::example/key", { "aws:SourceIp" = ["10.1.2.3"] })
+}
+
+output "allowed" {
+  value = local.allowed.allowed
+}
+
+output "allowed_sid" {
+  value = local.allowed.matched_statement_sid
+}
+
+output "wrong_ip" {
+  value = local.wrong_ip.decision
+}
+
+output "denied" {
+  value = local.denied.decision
+}
+
+output "denied_sid" {
+  value = local.denied.matched_statement_sid
+}`
+}
+
+func testIAMPolicyEvaluateFunctionConfig_options() string {
+	return `
+locals {
+  identity = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = "s3:*", Resource = "*" }]
+  })
+  boundary = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Allow", Action = "s3:GetObject", Resource = "*" }]
+  })
+  scp = jsonencode({
+    Version   = "2012-10-17"
+    Statement = [{ Effect = "Deny", Action = "s3:DeleteBucket", Resource = "*" }]
+  })
+  resource_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = "arn:aws:iam::123456789012:role/example" }
+      Action    = "s3:GetObject"
+      Resource  = "arn:aws:s3:::example/*"
+    }]
+  })
+
+  identity_result = provider::aws::iam_policy_evaluate([local.identity], "s3:PutObject", "arn:aws:s3:::example/key", {})
+  boundary_result = provider::aws::iam_policy_evaluate([local.identity], "s3:PutObject", "arn:aws:s3:::example/key", {}, {
+    permissions_boundary_policies = [local.boundary]
+  })
+  scp_result = provider::aws::iam_policy_evaluate([local.identity], "s3:DeleteBucket", "arn:aws:s3:::example", {}, {
+    service_control_policies = [local.scp]
+  })
+  resource_policy_result = provider::aws::iam_policy_evaluate([], "s3:GetObject", "arn:aws:s3:::example/key", {}, {
+    principal_arn     = "arn:aws:iam::123456789012:role/example"
+    resource_policies = [local.resource_policy]
+  })
+}
+
+output "identity" {
+  value = local.identity_result.decision_policy_type
+}
+
+output "boundary" {
+  value = local.boundary_result.decision
+}
+
+output "boundary_type" {
+  value = local.boundary_result.decision_policy_type
+}
+
+output "scp" {
+  value = local.scp_result.decision
+}
+
+output "scp_type" {
+  value = local.scp_result.decision_policy_type
+}
+
+output "resource_policy" {
+  value = local.resource_policy_result.decision
+}
+
+output "resource_policy_type" {
+  value = local.resource_policy_result.decision_policy_type
+}`
+}
+
+func testIAMPolicyEvaluateFunctionConfig_invalid() string {
+	return `
+output "test" {
+  value = provider::aws::iam_policy_evaluate(["not JSON"], "s3:GetObject", "*", {})
+}`
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges a list of IAM policy documents into a single policy document. " +
+			"Statements with non-blank `Sid`s in later documents replace statements with the same `Sid` in earlier documents.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "documents",
+				ElementType:         types.StringType,
+				MarkdownDescription: "IAM policy documents in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var documents []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &documents))
+	if resp.Error != nil {
+		return
+	}
+
+	mergedDoc := &tfiam.IAMPolicyDoc{}
+
+	for i, document := range documents {
+		doc, err := parseIAMPolicyDocument(document)
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("parsing policy document %d: %s", i, err)))
+			return
+		}
+
+		mergedDoc.Merge(doc)
+	}
+
+	result, err := json.Marshal(mergedDoc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
+
+// parseIAMPolicyDocument parses an IAM policy document in JSON format.
+func parseIAMPolicyDocument(document string) (*tfiam.IAMPolicyDoc, error) {
+	doc := &tfiam.IAMPolicyDoc{}
+
+	if err := json.Unmarshal([]byte(document), doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+var _ function.Function = iamPolicyNormalizeFunction{}
+
+func NewIAMPolicyNormalizeFunction() function.Function {
+	return &iamPolicyNormalizeFunction{}
+}
+
+type iamPolicyNormalizeFunction struct{}
+
+func (f iamPolicyNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_normalize"
+}
+
+func (f iamPolicyNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_normalize Function",
+		MarkdownDescription: "Normalizes an IAM policy document so that semantically equivalent policy documents " +
+			"have the same minified JSON representation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "IAM policy document in JSON format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	doc, err := parseIAMPolicyDocument(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	normalizeIAMPolicyDocument(doc)
+
+	result, err := json.Marshal(doc)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
+
+// normalizeIAMPolicyDocument sorts and removes duplicates from the values of each of a policy document's statements' elements,
+// including principals and condition values, and sorts the statements.
+// Elements with a single value are represented as a string rather than a list.
+func normalizeIAMPolicyDocument(doc *tfiam.IAMPolicyDoc) {
+	for _, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		statement.Actions = normalizeIAMPolicyValues(statement.Actions)
+		statement.NotActions = normalizeIAMPolicyValues(statement.NotActions)
+		statement.Resources = normalizeIAMPolicyValues(statement.Resources)
+		statement.NotResources = normalizeIAMPolicyValues(statement.NotResources)
+		statement.Principals = normalizeIAMPolicyPrincipals(statement.Principals)
+		statement.NotPrincipals = normalizeIAMPolicyPrincipals(statement.NotPrincipals)
+		statement.Conditions = normalizeIAMPolicyConditions(statement.Conditions)
+	}
+
+	// The order of statements is not significant.
+	slices.SortStableFunc(doc.Statements, func(a, b *tfiam.IAMPolicyStatement) int {
+		return strings.Compare(iamPolicyStatementKey(a), iamPolicyStatementKey(b))
+	})
+}
+
+// iamPolicyStatementKey returns the JSON representation of a normalized statement, used to sort statements.
+func iamPolicyStatementKey(statement *tfiam.IAMPolicyStatement) string {
+	b, err := json.Marshal(statement)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
+
+// normalizeIAMPolicyPrincipals merges the identifiers of principals of the same type and sorts the principals by type.
+func normalizeIAMPolicyPrincipals(principals tfiam.IAMPolicyStatementPrincipalSet) tfiam.IAMPolicyStatementPrincipalSet {
+	if len(principals) == 0 {
+		return principals
+	}
+
+	identifiers := make(map[string][]string)
+	for _, principal := range principals {
+		switch v := principal.Identifiers.(type) {
+		case string:
+			identifiers[principal.Type] = append(identifiers[principal.Type], v)
+		case []string:
+			identifiers[principal.Type] = append(identifiers[principal.Type], v...)
+		default:
+			return principals
+		}
+	}
+
+	var normalized tfiam.IAMPolicyStatementPrincipalSet
+	for _, typ := range slices.Sorted(maps.Keys(identifiers)) {
+		normalized = append(normalized, tfiam.IAMPolicyStatementPrincipal{
+			Type:        typ,
+			Identifiers: normalizeIAMPolicyValues(identifiers[typ]),
+		})
+	}
+
+	return normalized
+}
+
+// normalizeIAMPolicyConditions merges the values of conditions with the same operator and key and sorts the conditions by operator and key.
+// Values within a condition are ORed, so their order is not significant.
+func normalizeIAMPolicyConditions(conditions tfiam.IAMPolicyStatementConditionSet) tfiam.IAMPolicyStatementConditionSet {
+	if len(conditions) == 0 {
+		return conditions
+	}
+
+	type conditionKey struct {
+		test, variable string
+	}
+	values := make(map[conditionKey][]string)
+	for _, condition := range conditions {
+		k := conditionKey{test: condition.Test, variable: condition.Variable}
+
+		switch v := condition.Values.(type) {
+		case string:
+			values[k] = append(values[k], v)
+		case []string:
+			values[k] = append(values[k], v...)
+		default:
+			return conditions
+		}
+	}
+
+	keys := slices.SortedFunc(maps.Keys(values), func(a, b conditionKey) int {
+		if v := strings.Compare(a.test, b.test); v != 0 {
+			return v
+		}
+		return strings.Compare(a.variable, b.variable)
+	})
+
+	var normalized tfiam.IAMPolicyStatementConditionSet
+	for _, k := range keys {
+		normalized = append(normalized, tfiam.IAMPolicyStatementCondition{
+			Test:     k.test,
+			Variable: k.variable,
+			Values:   normalizeIAMPolicyValues(values[k]),
+		})
+	}
+
+	return normalized
+}
+
+// normalizeIAMPolicyValues returns a policy element's string values in the order used by the aws_iam_policy_document data source, without duplicates.
+// Values that are not strings or lists of strings are returned unchanged.
+func normalizeIAMPolicyValues(v any) any {
+	var values []string
+
+	switch v := v.(type) {
+	case []string:
+		values = slices.Clone(v)
+	case []any:
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return v
+			}
+			values = append(values, s)
+		}
+	default:
+		return v
+	}
+
+	if len(values) == 0 {
+		return v
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+	slices.Reverse(values)
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values
+}
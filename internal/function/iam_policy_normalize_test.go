@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyNormalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyNormalizeFunctionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::example/*","Principal":{"AWS":"arn:aws:iam::123456789012:root"}}]}`),
+					resource.TestCheckOutput("reordered", "true"),
+					resource.TestCheckOutput("reordered_statements", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testIAMPolicyNormalizeFunctionConfig_basic() string {
+	return `
+locals {
+  policy1 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Action    = ["s3:GetObject", "s3:PutObject", "s3:GetObject"]
+      Resource  = ["arn:aws:s3:::example/*"]
+      Principal = { AWS = ["arn:aws:iam::123456789012:root"] }
+    }]
+  })
+
+  policy2 = jsonencode({
+    Statement = [{
+      Principal = { AWS = "arn:aws:iam::123456789012:root" }
+      Resource  = "arn:aws:s3:::example/*"
+      Action    = ["s3:PutObject", "s3:GetObject"]
+      Effect    = "Allow"
+    }]
+    Version = "2012-10-17"
+  })
+
+  policy3 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Action    = "s3:GetObject"
+        Resource  = "*"
+        Principal = { AWS = ["arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:root"], Service = "s3.amazonaws.com" }
+        Condition = {
+          StringEquals = { "aws:SourceAccount" = ["123456789012", "210987654321"] }
+          Bool         = { "aws:SecureTransport" = "true" }
+        }
+      },
+      {
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+    ]
+  })
+
+  policy4 = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect   = "Deny"
+        Action   = "s3:DeleteObject"
+        Resource = "*"
+      },
+      {
+        Effect    = "Allow"
+        Action    = "s3:GetObject"
+        Resource  = "*"
+        Principal = { Service = ["s3.amazonaws.com"], AWS = ["arn:aws:iam::210987654321:root", "arn:aws:iam::123456789012:root"] }
+        Condition = {
+          Bool         = { "aws:SecureTransport" = ["true"] }
+          StringEquals = { "aws:SourceAccount" = ["210987654321", "123456789012"] }
+        }
+      },
+    ]
+  })
+}
+
+output "test" {
+  value = provider::aws::iam_policy_normalize(local.policy1)
+}
+
+output "reordered" {
+  value = provider::aws::iam_policy_normalize(local.policy1) == provider::aws::iam_policy_normalize(local.policy2)
+}
+
+output "reordered_statements" {
+  value = provider::aws::iam_policy_normalize(local.policy3) == provider::aws::iam_policy_normalize(local.policy4)
+}`
+}
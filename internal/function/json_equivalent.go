@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = jsonEquivalentFunction{}
+
+func NewJSONEquivalentFunction() function.Function {
+	return &jsonEquivalentFunction{}
+}
+
+type jsonEquivalentFunction struct{}
+
+func (f jsonEquivalentFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_equivalent"
+}
+
+func (f jsonEquivalentFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "json_equivalent Function",
+		MarkdownDescription: "Checks whether two JSON documents are semantically equivalent, ignoring formatting and object key order",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document1",
+				MarkdownDescription: "JSON document",
+			},
+			function.StringParameter{
+				Name:                "document2",
+				MarkdownDescription: "JSON document",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f jsonEquivalentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document1, document2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document1, &document2))
+	if resp.Error != nil {
+		return
+	}
+
+	for i, document := range []string{document1, document2} {
+		if !json.Valid([]byte(document)) {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "invalid JSON document"))
+		}
+	}
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, verify.JSONStringsEqual(document1, document2)))
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONEquivalentFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "equivalent" {
+  value = provider::aws::json_equivalent("{\"source\": [\"aws.ec2\"], \"detail-type\": [\"EC2 Instance State-change Notification\"]}", jsonencode({
+    "detail-type" = ["EC2 Instance State-change Notification"]
+    source        = ["aws.ec2"]
+  }))
+}
+
+output "different" {
+  value = provider::aws::json_equivalent("{\"source\": [\"aws.ec2\", \"aws.s3\"]}", "{\"source\": [\"aws.s3\", \"aws.ec2\"]}")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("equivalent", "true"),
+					resource.TestCheckOutput("different", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONEquivalentFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::json_equivalent("{}", "{")
+}
+`,
+				ExpectError: regexache.MustCompile(`Invalid value for "document2" parameter: invalid JSON document`),
+			},
+		},
+	})
+}
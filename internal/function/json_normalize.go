@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var _ function.Function = jsonNormalizeFunction{}
+
+func NewJSONNormalizeFunction() function.Function {
+	return &jsonNormalizeFunction{}
+}
+
+type jsonNormalizeFunction struct{}
+
+func (f jsonNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_normalize"
+}
+
+func (f jsonNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "json_normalize Function",
+		MarkdownDescription: "Normalizes a JSON document to minified JSON with object keys sorted, optionally removing fields",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "JSON document",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "remove_fields",
+			MarkdownDescription: "Names of object fields to remove, at any depth",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f jsonNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+	var removeFields []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &removeFields))
+	if resp.Error != nil {
+		return
+	}
+
+	if !json.Valid([]byte(document)) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "invalid JSON document"))
+		return
+	}
+
+	if len(removeFields) > 0 {
+		// Fields are matched against quoted object keys.
+		fields := make([]string, 0, len(removeFields))
+		for _, field := range removeFields {
+			b, err := json.Marshal(field)
+			if err != nil {
+				resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+				return
+			}
+			fields = append(fields, string(b))
+		}
+
+		document = tfjson.RemoveFields(document, fields...)
+	}
+
+	result, err := structure.NormalizeJsonString(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("normalizing JSON document: %s", err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONNormalizeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  definition = <<-EOT
+  {
+    "StartAt": "Hello",
+    "Comment": "Example",
+    "States": {
+      "Hello": {"Type": "Pass", "ResultPath": null, "End": true, "Comment": "Step"}
+    }
+  }
+  EOT
+}
+
+output "normalized" {
+  value = provider::aws::json_normalize(local.definition)
+}
+
+output "removed" {
+  value = provider::aws::json_normalize(local.definition, "Comment")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("normalized", `{"Comment":"Example","StartAt":"Hello","States":{"Hello":{"Comment":"Step","End":true,"ResultPath":null,"Type":"Pass"}}}`),
+					resource.TestCheckOutput("removed", `{"StartAt":"Hello","States":{"Hello":{"End":true,"ResultPath":null,"Type":"Pass"}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::json_normalize("{")
+}
+`,
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*JSON[\s\n]*document`),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = partitionOfFunction{}
+
+func NewPartitionOfFunction() function.Function {
+	return &partitionOfFunction{}
+}
+
+type partitionOfFunction struct{}
+
+func (f partitionOfFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "partition_of"
+}
+
+func (f partitionOfFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "partition_of Function",
+		MarkdownDescription: "Returns the partition in which an AWS Region is located",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f partitionOfFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, partition))
+}
+
+// partitionForRegion returns the partition in which the specified Region is located.
+func partitionForRegion(region string) (string, error) {
+	if !verify.IsValidRegionName(region) {
+		return "", fmt.Errorf("%q is not a valid Region code", region)
+	}
+
+	return names.PartitionForRegion(region), nil
+}
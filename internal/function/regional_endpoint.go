@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = regionalEndpointFunction{}
+
+func NewRegionalEndpointFunction() function.Function {
+	return &regionalEndpointFunction{}
+}
+
+type regionalEndpointFunction struct{}
+
+func (f regionalEndpointFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "regional_endpoint"
+}
+
+func (f regionalEndpointFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "regional_endpoint Function",
+		MarkdownDescription: "Returns the URL of the regional endpoint of an AWS service",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Name of the service as used in the provider's `endpoints` configuration block, such as `sqs`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+			function.BoolParameter{
+				Name:                "fips",
+				MarkdownDescription: "Whether to return a FIPS endpoint",
+			},
+			function.BoolParameter{
+				Name:                "dualstack",
+				MarkdownDescription: "Whether to return a dual-stack (IPv4 and IPv6) endpoint",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f regionalEndpointFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+	var fips, dualStack bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region, &fips, &dualStack))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "service must not be empty"))
+		return
+	}
+
+	if !verify.IsValidRegionName(region) {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a valid Region code", region)))
+		return
+	}
+
+	resolver, ok := regionalEndpointResolvers[service]
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("unsupported service %q", service)))
+		return
+	}
+
+	endpoint, err := resolver(ctx, region, fips, dualStack)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("resolving %s endpoint in %s: %s", service, region, err)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, endpoint.URI.String()))
+}
+
+// regionalEndpointResolver returns the endpoint of a service in a Region.
+type regionalEndpointResolver func(ctx context.Context, region string, fips, dualStack bool) (smithyendpoints.Endpoint, error)
+
+// newRegionalEndpointResolver returns a regionalEndpointResolver that calls a service's AWS SDK for Go v2 endpoint resolver.
+// Each service has its own EndpointParameters type, so the common parameters are set by name.
+func newRegionalEndpointResolver[P any](resolve func(context.Context, P) (smithyendpoints.Endpoint, error)) regionalEndpointResolver {
+	return func(ctx context.Context, region string, fips, dualStack bool) (smithyendpoints.Endpoint, error) {
+		var params P
+		v := reflect.ValueOf(&params).Elem()
+
+		set := func(name string, value any) bool {
+			field := v.FieldByName(name)
+			if !field.IsValid() || field.Type() != reflect.TypeOf(value) {
+				return false
+			}
+			field.Set(reflect.ValueOf(value))
+			return true
+		}
+
+		if !set("Region", aws.String(region)) {
+			return smithyendpoints.Endpoint{}, errors.New("service does not have regional endpoints")
+		}
+		if !set("UseFIPS", aws.Bool(fips)) && fips {
+			return smithyendpoints.Endpoint{}, errors.New("service does not support FIPS endpoints")
+		}
+		if !set("UseDualStack", aws.Bool(dualStack)) && dualStack {
+			return smithyendpoints.Endpoint{}, errors.New("service does not support dual-stack endpoints")
+		}
+
+		return resolve(ctx, params)
+	}
+}
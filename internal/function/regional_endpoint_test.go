@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRegionalEndpointFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "default" {
+  value = provider::aws::regional_endpoint("sqs", "us-west-2", false, false)
+}
+
+output "fips" {
+  value = provider::aws::regional_endpoint("sqs", "us-west-2", true, false)
+}
+
+output "dualstack" {
+  value = provider::aws::regional_endpoint("sqs", "cn-north-1", false, true)
+}
+
+output "fips_dualstack" {
+  value = provider::aws::regional_endpoint("sqs", "us-gov-west-1", true, true)
+}
+
+output "endpoint_prefix" {
+  value = provider::aws::regional_endpoint("cloudwatch", "us-west-2", false, false)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("default", "https://sqs.us-west-2.amazonaws.com"),
+					resource.TestCheckOutput("fips", "https://sqs-fips.us-west-2.amazonaws.com"),
+					resource.TestCheckOutput("dualstack", "https://sqs.cn-north-1.api.amazonwebservices.com.cn"),
+					resource.TestCheckOutput("fips_dualstack", "https://sqs-fips.us-gov-west-1.api.aws"),
+					resource.TestCheckOutput("endpoint_prefix", "https://monitoring.us-west-2.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestRegionalEndpointFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::regional_endpoint("sqs", "us-iso-east-1", false, true)
+}
+`,
+				ExpectError: regexache.MustCompile(`does[\s\n]*not[\s\n]*support[\s\n]*DualStack`),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = servicePrincipalFunction{}
+
+func NewServicePrincipalFunction() function.Function {
+	return &servicePrincipalFunction{}
+}
+
+type servicePrincipalFunction struct{}
+
+func (f servicePrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "service_principal"
+}
+
+func (f servicePrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "service_principal Function",
+		MarkdownDescription: "Returns the name of the service principal of an AWS service in a Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service name, such as `logs`",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f servicePrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, "service must not be empty"))
+		return
+	}
+
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	// Consistent with the aws_service_principal data source's name attribute.
+	result := service + "." + names.ServicePrincipalNameForPartition(service, partition)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
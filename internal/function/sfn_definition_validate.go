@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/asl"
+)
+
+var sfnDefinitionValidateFindingAttrTypes = map[string]attr.Type{
+	"code":     types.StringType,
+	"location": types.StringType,
+	"message":  types.StringType,
+	"severity": types.StringType,
+}
+
+var _ function.Function = sfnDefinitionValidateFunction{}
+
+func NewSFNDefinitionValidateFunction() function.Function {
+	return &sfnDefinitionValidateFunction{}
+}
+
+type sfnDefinitionValidateFunction struct{}
+
+func (f sfnDefinitionValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "sfn_definition_validate"
+}
+
+func (f sfnDefinitionValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "sfn_definition_validate Function",
+		MarkdownDescription: "Validates an Amazon States Language (Step Functions state machine) definition without calling AWS. " +
+			"Returns the problems found, or an empty list if there are none",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "definition",
+				MarkdownDescription: "State machine definition in JSON format",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: sfnDefinitionValidateFindingAttrTypes},
+		},
+	}
+}
+
+func (f sfnDefinitionValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var definition string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &definition))
+	if resp.Error != nil {
+		return
+	}
+
+	findings := make([]attr.Value, 0)
+	for _, finding := range asl.Validate(definition) {
+		value := map[string]attr.Value{
+			"code":     types.StringValue(finding.Code),
+			"location": types.StringValue(finding.Location),
+			"message":  types.StringValue(finding.Message),
+			"severity": types.StringValue(string(finding.Severity)),
+		}
+
+		v, d := types.ObjectValue(sfnDefinitionValidateFindingAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+
+		findings = append(findings, v)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: sfnDefinitionValidateFindingAttrTypes}, findings)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestSFNDefinitionValidateFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "valid" {
+  value = length(provider::aws::sfn_definition_validate(jsonencode({
+    StartAt = "Hello"
+    States = {
+      Hello = {
+        Type     = "Task"
+        Resource = "arn:aws:states:::lambda:invoke"
+        Parameters = {
+          FunctionName = "example"
+          "Payload.$"  = "$"
+        }
+        End = true
+      }
+    }
+  })))
+}
+
+locals {
+  findings = provider::aws::sfn_definition_validate(jsonencode({
+    StartAt = "Hello"
+    States = {
+      Hello = {
+        Type = "Pass"
+        Next = "World"
+      }
+    }
+  }))
+}
+
+output "count" {
+  value = length(local.findings)
+}
+
+output "code" {
+  value = local.findings[0].code
+}
+
+output "location" {
+  value = local.findings[0].location
+}
+
+output "severity" {
+  value = local.findings[0].severity
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("valid", "0"),
+					resource.TestCheckOutput("count", "1"),
+					resource.TestCheckOutput("code", "MISSING_TRANSITION_TARGET"),
+					resource.TestCheckOutput("location", "/States/Hello/Next"),
+					resource.TestCheckOutput("severity", "ERROR"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"gopkg.in/yaml.v3"
+)
+
+var _ function.Function = yamlToJSONCanonicalFunction{}
+
+func NewYAMLToJSONCanonicalFunction() function.Function {
+	return &yamlToJSONCanonicalFunction{}
+}
+
+type yamlToJSONCanonicalFunction struct{}
+
+func (f yamlToJSONCanonicalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "yaml_to_json_canonical"
+}
+
+func (f yamlToJSONCanonicalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "yaml_to_json_canonical Function",
+		MarkdownDescription: "Converts a YAML document to minified JSON with object keys sorted",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "YAML or JSON document",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f yamlToJSONCanonicalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := yamlToJSONCanonical(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// yamlToJSONCanonical converts a YAML document to minified JSON with object keys sorted.
+func yamlToJSONCanonical(document string) (string, error) {
+	// JSON documents are normalized and YAML documents are checked.
+	document, err := verify.NormalizeJSONOrYAMLString(document)
+	if err != nil {
+		return "", fmt.Errorf("parsing YAML: %w", err)
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte(document), &node); err != nil {
+		return "", fmt.Errorf("parsing YAML: %w", err)
+	}
+
+	// Local tags, such as CloudFormation's `!Ref` short form, have no JSON equivalent.
+	if err := prepareYAMLNode(&node); err != nil {
+		return "", err
+	}
+
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return "", fmt.Errorf("parsing YAML: %w", err)
+	}
+
+	result, err := tfjson.EncodeToString(v)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(result, "\n"), nil
+}
+
+// prepareYAMLNode returns an error if a YAML node or any of its descendants has a local tag.
+// Timestamps are retagged as strings so that they keep their original text.
+func prepareYAMLNode(node *yaml.Node) error {
+	if tag := node.Tag; strings.HasPrefix(tag, "!") && !strings.HasPrefix(tag, "!!") {
+		return fmt.Errorf("line %d: unsupported YAML tag %s", node.Line, tag)
+	}
+
+	if node.Kind == yaml.ScalarNode && node.ShortTag() == "!!timestamp" {
+		node.Tag = "!!str"
+	}
+
+	for _, node := range node.Content {
+		if err := prepareYAMLNode(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestYAMLToJSONCanonicalFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+locals {
+  template = <<-EOT
+  AWSTemplateFormatVersion: 2010-09-09
+  Defaults: &defaults
+    Count: 2
+  Conditions:
+    IsProd:
+      Fn::Equals: [{Ref: Env}, prod]
+  Resources:
+    Bucket:
+      Type: AWS::S3::Bucket
+      Properties:
+        <<: *defaults
+        BucketName:
+          Fn::Sub: "$${AWS::StackName}-bucket"
+        Arn:
+          Fn::GetAtt: [Role, Arn]
+  EOT
+}
+
+output "test" {
+  value = provider::aws::yaml_to_json_canonical(local.template)
+}
+
+output "json" {
+  value = provider::aws::yaml_to_json_canonical("{\"b\": 1, \"a\": [true, null]}")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"AWSTemplateFormatVersion":"2010-09-09","Conditions":{"IsProd":{"Fn::Equals":[{"Ref":"Env"},"prod"]}},"Defaults":{"Count":2},"Resources":{"Bucket":{"Properties":{"Arn":{"Fn::GetAtt":["Role","Arn"]},"BucketName":{"Fn::Sub":"${AWS::StackName}-bucket"},"Count":2},"Type":"AWS::S3::Bucket"}}}`),
+					resource.TestCheckOutput("json", `{"a":[true,null],"b":1}`),
+				),
+			},
+		},
+	})
+}
+
+func TestYAMLToJSONCanonicalFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::yaml_to_json_canonical("a: [")
+}
+`,
+				ExpectError: regexache.MustCompile(`parsing[\s\n]*YAML`),
+			},
+		},
+	})
+}
+
+func TestYAMLToJSONCanonicalFunction_localTag(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::aws::yaml_to_json_canonical("Bucket: !Ref Name")
+}
+`,
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*YAML[\s\n]*tag[\s\n]*!Ref`),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+type serviceDatum struct {
+	ProviderPackage string
+	Aliases         []string
+	GoV2Package     string
+}
+
+type TemplateData struct {
+	Services []serviceDatum
+}
+
+func main() {
+	const (
+		filename = `regional_endpoint_gen.go`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating internal/function/%s", filename)
+
+	data, err := data.ReadAllServiceData()
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	td := TemplateData{}
+
+	for _, l := range data {
+		if l.Exclude() {
+			continue
+		}
+
+		if l.NotImplemented() && !l.EndpointOnly() {
+			continue
+		}
+
+		// Only AWS SDK for Go v2 clients have endpoint resolvers that can be called directly.
+		if !l.IsClientSDKV2() {
+			continue
+		}
+
+		td.Services = append(td.Services, serviceDatum{
+			ProviderPackage: l.ProviderPackage(),
+			Aliases:         l.Aliases(),
+			GoV2Package:     l.GoV2Package(),
+		})
+	}
+
+	sort.Slice(td.Services, func(i, j int) bool {
+		return td.Services[i].ProviderPackage < td.Services[j].ProviderPackage
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("regionalendpoints", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.gtpl
+var tmpl string
@@ -49,13 +49,15 @@ func main() {
 			continue
 		}
 
-		// Look for Terraform Plugin Framework and SDK resource and data source annotations.
+		// Look for Terraform Plugin Framework and SDK resource, data source and ephemeral resource annotations.
 		// These annotations are implemented as comments on factory functions.
 		v := &visitor{
 			g: g,
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
+			listResources:        make(map[string]ResourceDatum),
 			sdkDataSources:       make(map[string]ResourceDatum),
 			sdkResources:         make(map[string]ResourceDatum),
 		}
@@ -74,8 +76,10 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
+			ListResources:        v.listResources,
 			SDKDataSources:       v.sdkDataSources,
 			SDKResources:         v.sdkResources,
 		}
@@ -84,6 +88,9 @@ func main() {
 			s.GoV1ClientTypeName = l.GoV1ClientTypeName()
 		}
 
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -125,6 +132,9 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	Identity                bool
+	IdentityAttributes      []string
+	IdentityIDSeparator     string
 }
 
 type ServiceDatum struct {
@@ -136,8 +146,10 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
+	ListResources        map[string]ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
 	SDKResources         map[string]ResourceDatum
 }
@@ -161,8 +173,10 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
+	listResources        map[string]ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
 	sdkResources         map[string]ResourceDatum
 }
@@ -202,11 +216,11 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource, data source or ephemeral resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and identity annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
@@ -229,6 +243,24 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Identity" {
+			args := common.ParseArgs(m[3])
+
+			if d.Identity {
+				v.errs = append(v.errs, fmt.Errorf("multiple Identity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.Identity = true
+
+			for _, attr := range args.Positional {
+				d.IdentityAttributes = append(d.IdentityAttributes, namesgen.ConstOrQuote(attr))
+			}
+
+			if attr, ok := args.Keyword["idSeparator"]; ok {
+				d.IdentityIDSeparator = attr
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -244,6 +276,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -256,6 +294,19 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.frameworkResources = append(v.frameworkResources, d)
 				}
+			case "ListResource":
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				typeName := args.Positional[0]
+
+				if _, ok := v.listResources[typeName]; ok {
+					v.errs = append(v.errs, fmt.Errorf("duplicate List Resource (%s): %s", typeName, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.listResources[typeName] = d
+				}
 			case "SDKDataSource":
 				if len(args.Positional) == 0 {
 					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -282,7 +333,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "Identity", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
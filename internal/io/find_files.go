@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// File is a regular file found by FindFiles.
+type File struct {
+	Name string // Slash-separated path relative to the root directory.
+	Path string // Local file path.
+}
+
+// FindFiles returns the regular files in the root directory and its subdirectories, sorted by name.
+// A file is returned if its name matches any include pattern, or there are no include patterns, and matches no exclude pattern.
+// Symbolic links to files are followed; symbolic links to directories are not.
+func FindFiles(root string, include, exclude []string) ([]File, error) {
+	var files []File
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+
+		if len(include) > 0 && !slices.ContainsFunc(include, func(v string) bool { return MatchPattern(v, name) }) {
+			return nil
+		}
+
+		if slices.ContainsFunc(exclude, func(v string) bool { return MatchPattern(v, name) }) {
+			return nil
+		}
+
+		files = append(files, File{
+			Name: name,
+			Path: path,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(files, func(a, b File) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return files, nil
+}
+
+// MatchPattern reports whether the slash-separated name matches the pattern.
+// In addition to the syntax supported by path.Match, a "**" path element matches zero or more path elements.
+func MatchPattern(pattern, name string) bool {
+	return matchPatternElements(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchPatternElements(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchPatternElements(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package io_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+)
+
+func TestFindFiles(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	for _, name := range []string{"a.txt", "a/b.txt", "a/c.map", "b/d.txt", "z.txt"} {
+		path := filepath.Join(root, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{
+		{
+			name: "all",
+			want: []string{"a.txt", "a/b.txt", "a/c.map", "b/d.txt", "z.txt"},
+		},
+		{
+			name:    "include",
+			include: []string{"a/**", "z.txt"},
+			want:    []string{"a/b.txt", "a/c.map", "z.txt"},
+		},
+		{
+			name:    "exclude",
+			exclude: []string{"**/*.map", "b/**"},
+			want:    []string{"a.txt", "a/b.txt", "z.txt"},
+		},
+		{
+			name:    "include and exclude",
+			include: []string{"a/**"},
+			exclude: []string{"**/*.map"},
+			want:    []string{"a/b.txt"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfio.FindFiles(root, testCase.include, testCase.exclude)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, v := range files {
+				got = append(got, v.Name)
+
+				if want := filepath.Join(root, filepath.FromSlash(v.Name)); v.Path != want {
+					t.Errorf("Path = %s, want %s", v.Path, want)
+				}
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/api/index.html", true},
+		{"**/*.html", "docs/style.css", false},
+		{"assets/**", "assets/img/logo.png", true},
+		{"assets/**", "assets", true},
+		{"assets/**", "static/assets/logo.png", false},
+		{"docs/**/index.html", "docs/index.html", true},
+		{"docs/**/index.html", "docs/a/b/index.html", true},
+		{"**", "a/b/c", true},
+		{"?.txt", "a.txt", true},
+		{"[ab].txt", "c.txt", false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("%s %s", testCase.pattern, testCase.name), func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfio.MatchPattern(testCase.pattern, testCase.name); got != testCase.want {
+				t.Errorf("got %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package leastprivilege
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// AddAPIAuditLog records the API operations in an API audit log (see the provider's `api_audit_log_file` argument).
+func (p *Policy) AddAPIAuditLog(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var record conns.APIAuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return fmt.Errorf("reading API audit log line %d: %w", line, err)
+		}
+
+		if err := p.addAuditRecord(record); err != nil {
+			return fmt.Errorf("reading API audit log line %d: %w", line, err)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// addAuditRecord records the API operation in an API audit log record.
+// Amazon S3 bucket names and object keys are converted to ARNs.
+func (p *Policy) addAuditRecord(record conns.APIAuditRecord) error {
+	if record.Service == "S3" {
+		if bucket, ok := record.Resources["Bucket"].(string); ok && bucket != "" {
+			resource := bucket
+			if key, ok := record.Resources["Key"].(string); ok && key != "" {
+				resource += "/" + key
+			}
+
+			return p.Add(record.Service, record.Operation, fmt.Sprintf("arn:%s:s3:::%s", names.PartitionForRegion(record.Region), resource))
+		}
+	}
+
+	identifiers := make(map[string][]string)
+	for k, v := range record.Resources {
+		switch v := v.(type) {
+		case string:
+			identifiers[k] = append(identifiers[k], v)
+		case []any:
+			for _, v := range v {
+				if v, ok := v.(string); ok {
+					identifiers[k] = append(identifiers[k], v)
+				}
+			}
+		}
+	}
+
+	return p.addCall(record.Service, record.Operation, identifiers)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package leastprivilege
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// endpointPrefixes maps endpoint prefixes to service names where they differ.
+var endpointPrefixes = map[string]string{
+	"email":      "ses",
+	"monitoring": "cloudwatch",
+}
+
+// AddCassette records the API operations in a go-vcr cassette recorded by an acceptance test (see internal/acctest/vcr.go).
+// The cassette name is its path without the ".yaml" extension.
+// API operations using the AWS JSON and Query protocols are recorded.
+// Authorization headers are removed from recorded requests, so services are identified by endpoint prefix.
+// Operations using REST protocols cannot be identified from the HTTP request alone,
+// and the requests are returned as unidentified interactions instead, e.g. "GET https://lambda.us-west-2.amazonaws.com/...".
+func (p *Policy) AddCassette(name string) ([]string, error) {
+	c, err := cassette.Load(name)
+	if err != nil {
+		return nil, fmt.Errorf("loading cassette (%s): %w", name, err)
+	}
+
+	var unidentified []string
+	for _, i := range c.Interactions {
+		service, operation, identifiers := identifyRequest(&i.Request)
+
+		if service == "" || operation == "" {
+			unidentified = append(unidentified, i.Request.Method+" "+i.Request.URL)
+			continue
+		}
+
+		if err := p.addCall(service, operation, identifiers); err != nil {
+			return nil, fmt.Errorf("reading cassette (%s) interaction %d: %w", name, i.ID, err)
+		}
+	}
+
+	return unidentified, nil
+}
+
+// identifyRequest returns the service endpoint prefix, API operation and resource identifiers, keyed by request member name, of a recorded HTTP request.
+func identifyRequest(r *cassette.Request) (string, string, map[string][]string) {
+	u, err := url.Parse(r.URL)
+	if err != nil {
+		return "", "", nil
+	}
+
+	service, _, _ := strings.Cut(u.Hostname(), ".")
+	if v, ok := endpointPrefixes[service]; ok {
+		service = v
+	}
+
+	// AWS JSON protocol, e.g. "X-Amz-Target: Logs_20140328.CreateLogGroup".
+	if v := r.Headers.Get("X-Amz-Target"); v != "" {
+		operation := v[strings.LastIndex(v, ".")+1:]
+
+		var body map[string]any
+		if err := json.Unmarshal([]byte(r.Body), &body); err != nil {
+			return service, operation, nil
+		}
+
+		identifiers := make(map[string][]string)
+		for k, v := range body {
+			if !conns.IsAPIAuditResourceName(k) {
+				continue
+			}
+
+			switch v := v.(type) {
+			case string:
+				identifiers[k] = append(identifiers[k], v)
+			case []any:
+				for _, v := range v {
+					if v, ok := v.(string); ok {
+						identifiers[k] = append(identifiers[k], v)
+					}
+				}
+			}
+		}
+
+		return service, operation, identifiers
+	}
+
+	// AWS Query protocol, e.g. "Action=DescribeVpcs&Version=2016-11-15".
+	form := r.Form
+	if len(form) == 0 && strings.HasPrefix(r.Headers.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		form, _ = url.ParseQuery(r.Body)
+	}
+	if operation := form.Get("Action"); operation != "" {
+		identifiers := make(map[string][]string)
+		for k, values := range form {
+			// List members are flattened, e.g. "GroupId.1".
+			k = strings.TrimRightFunc(k, func(r rune) bool { return r == '.' || unicode.IsDigit(r) })
+			if k = k[strings.LastIndex(k, ".")+1:]; !conns.IsAPIAuditResourceName(k) {
+				continue
+			}
+
+			identifiers[k] = append(identifiers[k], values...)
+		}
+
+		return service, operation, identifiers
+	}
+
+	return service, "", nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package leastprivilege generates least-privilege IAM policies from recorded provider activity.
+package leastprivilege
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	passRoleAction = "iam:PassRole"
+	policyVersion  = "2012-10-17"
+	wildcard       = "*"
+)
+
+// identifierSuffixes are the suffixes of API input member names that identify resources, longest first.
+var identifierSuffixes = []string{
+	"Identifier",
+	"Bucket",
+	"Names",
+	"ARNs",
+	"Arns",
+	"Name",
+	"ARN",
+	"Arn",
+	"Ids",
+	"Key",
+	"Id",
+}
+
+// actionOverrides maps API operations to IAM actions where the names differ.
+var actionOverrides = map[string]string{
+	"lambda:Invoke":                               "lambda:InvokeFunction",
+	"s3:DeleteBucketCors":                         "s3:PutBucketCORS",
+	"s3:DeleteBucketEncryption":                   "s3:PutEncryptionConfiguration",
+	"s3:DeleteBucketLifecycle":                    "s3:PutLifecycleConfiguration",
+	"s3:DeleteBucketReplication":                  "s3:PutReplicationConfiguration",
+	"s3:DeleteObjects":                            "s3:DeleteObject",
+	"s3:GetBucketAccelerateConfiguration":         "s3:GetAccelerateConfiguration",
+	"s3:GetBucketCors":                            "s3:GetBucketCORS",
+	"s3:GetBucketEncryption":                      "s3:GetEncryptionConfiguration",
+	"s3:GetBucketIntelligentTieringConfiguration": "s3:GetIntelligentTieringConfiguration",
+	"s3:GetBucketLifecycleConfiguration":          "s3:GetLifecycleConfiguration",
+	"s3:GetBucketNotificationConfiguration":       "s3:GetBucketNotification",
+	"s3:GetBucketReplication":                     "s3:GetReplicationConfiguration",
+	"s3:GetObjectLockConfiguration":               "s3:GetBucketObjectLockConfiguration",
+	"s3:HeadBucket":                               "s3:ListBucket",
+	"s3:HeadObject":                               "s3:GetObject",
+	"s3:ListBuckets":                              "s3:ListAllMyBuckets",
+	"s3:ListObjectVersions":                       "s3:ListBucketVersions",
+	"s3:ListObjects":                              "s3:ListBucket",
+	"s3:ListObjectsV2":                            "s3:ListBucket",
+	"s3:PutBucketAccelerateConfiguration":         "s3:PutAccelerateConfiguration",
+	"s3:PutBucketCors":                            "s3:PutBucketCORS",
+	"s3:PutBucketEncryption":                      "s3:PutEncryptionConfiguration",
+	"s3:PutBucketIntelligentTieringConfiguration": "s3:PutIntelligentTieringConfiguration",
+	"s3:PutBucketLifecycleConfiguration":          "s3:PutLifecycleConfiguration",
+	"s3:PutBucketNotificationConfiguration":       "s3:PutBucketNotification",
+	"s3:PutBucketReplication":                     "s3:PutReplicationConfiguration",
+	"s3:PutObjectLockConfiguration":               "s3:PutBucketObjectLockConfiguration",
+}
+
+// Policy accumulates the IAM actions and resource ARNs used by the provider.
+type Policy struct {
+	// actions maps each IAM action to the resource ARNs it was used on.
+	actions         map[string]map[string]struct{}
+	prefixesByName  map[string]string
+	prefixesBySDKID map[string]string
+}
+
+// NewPolicy returns a new, empty Policy.
+func NewPolicy() (*Policy, error) {
+	serviceData, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, fmt.Errorf("reading service data: %w", err)
+	}
+
+	p := &Policy{
+		actions:         make(map[string]map[string]struct{}),
+		prefixesByName:  make(map[string]string),
+		prefixesBySDKID: make(map[string]string),
+	}
+
+	for _, sr := range serviceData {
+		prefix := sr.IAMActionPrefix()
+		if prefix == "" {
+			continue
+		}
+
+		if v := sr.SDKID(); v != "" {
+			if _, ok := p.prefixesBySDKID[v]; !ok || !sr.Exclude() {
+				p.prefixesBySDKID[v] = prefix
+			}
+		}
+
+		for _, v := range []string{prefix, sr.AWSCLIV2Command()} {
+			if _, ok := p.prefixesByName[v]; !ok && v != "" {
+				p.prefixesByName[v] = prefix
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// Add records that the specified API operation was called on the specified target resources.
+// The service is identified by its AWS SDK service ID (e.g. "CloudWatch Logs") or endpoint prefix (e.g. "logs").
+// If every resource is an ARN, the action is allowed on those ARNs.
+// Otherwise the resource ARN cannot be derived and the action is allowed on all resources.
+func (p *Policy) Add(service, operation string, resources ...string) error {
+	prefix, ok := p.prefixesBySDKID[service]
+	if !ok {
+		prefix, ok = p.prefixesByName[service]
+	}
+	if !ok {
+		return fmt.Errorf("no IAM action prefix for service %q", service)
+	}
+
+	action := prefix + ":" + operation
+	if v, ok := actionOverrides[action]; ok {
+		action = v
+	}
+
+	p.allow(action, resources)
+
+	return nil
+}
+
+// addCall records that the specified API operation was called with the specified resource identifiers,
+// keyed by API input member name.
+// Only the identifiers of the operation's target resource (see isTargetMember) restrict the action's resources.
+// Roles passed to the operation are allowed by iam:PassRole.
+func (p *Policy) addCall(service, operation string, identifiers map[string][]string) error {
+	var targets []string
+	for _, k := range slices.Sorted(maps.Keys(identifiers)) {
+		switch {
+		case isTargetMember(operation, k):
+			targets = append(targets, identifiers[k]...)
+		case isRoleMember(k):
+			for _, v := range identifiers[k] {
+				p.allow(passRoleAction, []string{v})
+			}
+		}
+	}
+
+	// With no known target, the action is allowed on all resources.
+	return p.Add(service, operation, targets...)
+}
+
+func (p *Policy) allow(action string, resources []string) {
+	v, ok := p.actions[action]
+	if !ok {
+		v = make(map[string]struct{})
+		p.actions[action] = v
+	}
+
+	if len(resources) == 0 || slices.ContainsFunc(resources, func(v string) bool { return !arn.IsARN(v) }) {
+		v[wildcard] = struct{}{}
+		return
+	}
+
+	for _, r := range resources {
+		v[r] = struct{}{}
+	}
+}
+
+// isTargetMember returns whether the named API input member identifies the operation's target resource.
+// The member's resource type, its name without the identifier suffix (e.g. "StateMachine" for "StateMachineArn"),
+// must appear in the operation name (e.g. "DescribeStateMachine"). Members such as "Arn" or "Name" have no resource type
+// and identify the target.
+func isTargetMember(operation, name string) bool {
+	resourceType := name
+	for _, suffix := range identifierSuffixes {
+		if v, ok := strings.CutSuffix(name, suffix); ok {
+			resourceType = v
+			break
+		}
+	}
+
+	return strings.Contains(strings.ToLower(operation), strings.ToLower(resourceType))
+}
+
+// isRoleMember returns whether the named API input member is an IAM role passed to the operation, e.g. "RoleArn" or "Role".
+func isRoleMember(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasSuffix(name, "role") || strings.HasSuffix(name, "rolearn")
+}
+
+// Actions returns the sorted IAM actions recorded.
+func (p *Policy) Actions() []string {
+	return slices.Sorted(maps.Keys(p.actions))
+}
+
+// Document returns an IAM policy document allowing exactly the recorded actions on the recorded resources.
+// Actions with identical resources are combined into a single statement.
+func (p *Policy) Document() *tfiam.IAMPolicyDoc {
+	// Group actions by their resources.
+	actionsByResources := make(map[string][]string)
+	for _, action := range p.Actions() {
+		resources := slices.Sorted(maps.Keys(p.actions[action]))
+		if slices.Contains(resources, wildcard) {
+			resources = []string{wildcard}
+		}
+		key := strings.Join(resources, "\n")
+		actionsByResources[key] = append(actionsByResources[key], action)
+	}
+
+	doc := &tfiam.IAMPolicyDoc{
+		Version: policyVersion,
+	}
+	for _, key := range slices.Sorted(maps.Keys(actionsByResources)) {
+		doc.Statements = append(doc.Statements, &tfiam.IAMPolicyStatement{
+			Effect:    "Allow",
+			Actions:   stringOrSlice(actionsByResources[key]),
+			Resources: stringOrSlice(strings.Split(key, "\n")),
+		})
+	}
+
+	return doc
+}
+
+func stringOrSlice(s []string) any {
+	if len(s) == 1 {
+		return s[0]
+	}
+
+	return s
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package leastprivilege
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+func TestPolicyAddAPIAuditLog(t *testing.T) {
+	t.Parallel()
+
+	auditLog := strings.Join([]string{
+		`{"service":"CloudWatch Logs","operation":"CreateLogGroup","region":"us-west-2","resources":{"LogGroupName":"test"}}`,                                                         //lintignore:AWSAT003
+		`{"service":"CloudWatch Logs","operation":"DescribeLogGroups","region":"us-west-2"}`,                                                                                          //lintignore:AWSAT003
+		`{"service":"SFN","operation":"DescribeStateMachine","region":"us-west-2","resources":{"StateMachineArn":"arn:aws:states:us-west-2:123456789012:stateMachine:test"}}`,         //lintignore:AWSAT003,AWSAT005
+		`{"service":"SFN","operation":"ListTagsForResource","region":"us-west-2","resources":{"ResourceArn":"arn:aws:states:us-west-2:123456789012:stateMachine:test"}}`,              //lintignore:AWSAT003,AWSAT005
+		`{"service":"S3","operation":"HeadObject","region":"us-west-2","resources":{"Bucket":"test","Key":"a/b"}}`,                                                                    //lintignore:AWSAT003
+		`{"service":"S3","operation":"ListObjectsV2","region":"us-west-2","resources":{"Bucket":"test"}}`,                                                                             //lintignore:AWSAT003
+		`{"service":"Lambda","operation":"CreateFunction","region":"us-west-2","resources":{"FunctionName":"test","Role":"arn:aws:iam::123456789012:role/test"}}`,                     //lintignore:AWSAT003,AWSAT005
+		`{"service":"IAM","operation":"AttachRolePolicy","region":"us-east-1","resources":{"PolicyArn":"arn:aws:iam::aws:policy/ReadOnlyAccess","RoleName":"test"},"error_code":"x"}`, //lintignore:AWSAT003,AWSAT005
+		``,
+	}, "\n")
+
+	p, err := NewPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.AddAPIAuditLog(strings.NewReader(auditLog)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.Marshal(p.Document())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["iam:AttachRolePolicy", "lambda:CreateFunction", "logs:CreateLogGroup", "logs:DescribeLogGroups"],
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "iam:PassRole",
+      "Resource": "arn:aws:iam::123456789012:role/test"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:ListBucket",
+      "Resource": "arn:aws:s3:::test"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::test/a/b"
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["states:DescribeStateMachine", "states:ListTagsForResource"],
+      "Resource": "arn:aws:states:us-west-2:123456789012:stateMachine:test"
+    }
+  ]
+}` //lintignore:AWSAT003,AWSAT005
+
+	if diff := cmp.Diff(compactJSON(t, string(got)), compactJSON(t, want)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestPolicyAddAPIAuditLogUnknownService(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.AddAPIAuditLog(strings.NewReader(`{"service":"Unknown","operation":"DoThing"}`)); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestPolicyAddCassette(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "TestAccSomething_basic")
+	c := cassette.New(name)
+	for _, r := range []cassette.Request{
+		{
+			Method: http.MethodPost,
+			URL:    "https://logs.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			Headers: http.Header{
+				"X-Amz-Target": []string{"Logs_20140328.CreateLogGroup"},
+			},
+			Body: `{"logGroupName":"test"}`,
+		},
+		{
+			Method: http.MethodPost,
+			URL:    "https://ec2.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			Headers: http.Header{
+				"Content-Type": []string{"application/x-www-form-urlencoded; charset=utf-8"},
+			},
+			Body: "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-12345678",
+		},
+		{
+			Method: http.MethodPost,
+			URL:    "https://sns.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+			Form: map[string][]string{
+				"Action":   {"GetTopicAttributes"},
+				"TopicArn": {"arn:aws:sns:us-west-2:123456789012:test"}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+		{
+			Method: http.MethodGet,
+			URL:    "https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/test", //lintignore:AWSAT003
+		},
+	} {
+		c.AddInteraction(&cassette.Interaction{Request: r})
+	}
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unidentified, err := p.AddCassette(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(unidentified, []string{"GET https://lambda.us-west-2.amazonaws.com/2015-03-31/functions/test"}); diff != "" { //lintignore:AWSAT003
+		t.Errorf("unexpected unidentified diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(p.Actions(), []string{"ec2:DescribeVpcs", "logs:CreateLogGroup", "sns:GetTopicAttributes"}); diff != "" {
+		t.Errorf("unexpected actions diff (+wanted, -got): %s", diff)
+	}
+
+	doc := p.Document()
+	if got, want := len(doc.Statements), 2; got != want {
+		t.Fatalf("statements = %d, want %d", got, want)
+	}
+	if got, want := doc.Statements[1].Resources, any("arn:aws:sns:us-west-2:123456789012:test"); got != want { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("resources = %v, want %v", got, want)
+	}
+}
+
+func TestPolicyAddCassettePassRole(t *testing.T) {
+	t.Parallel()
+
+	name := filepath.Join(t.TempDir(), "TestAccSFNStateMachine_basic")
+	c := cassette.New(name)
+	c.AddInteraction(&cassette.Interaction{Request: cassette.Request{
+		Method: http.MethodPost,
+		URL:    "https://states.us-west-2.amazonaws.com/", //lintignore:AWSAT003
+		Headers: http.Header{
+			"X-Amz-Target": []string{"AWSStepFunctions.CreateStateMachine"},
+		},
+		Body: `{"definition":"{}","name":"test","roleArn":"arn:aws:iam::123456789012:role/test"}`, //lintignore:AWSAT005
+	}})
+	if err := c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := NewPolicy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.AddCassette(name); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := json.Marshal(p.Document())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The role ARN is not the state machine's ARN.
+	want := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "states:CreateStateMachine",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "iam:PassRole",
+      "Resource": "arn:aws:iam::123456789012:role/test"
+    }
+  ]
+}` //lintignore:AWSAT005
+
+	if diff := cmp.Diff(compactJSON(t, string(got)), compactJSON(t, want)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func compactJSON(t *testing.T, s string) string {
+	t.Helper()
+
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(b)
+}
@@ -3,6 +3,50 @@
 
 package logging
 
+import (
+	"slices"
+	"strings"
+)
+
 const (
 	KeyResourceId = "id"
 )
+
+// Redacted replaces the value of a sensitive field.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeySuffixes are the lower-cased suffixes of field names whose values are secrets.
+// Generic suffixes such as "token" would also match non-secret fields, e.g. NextToken and ClientToken.
+var sensitiveKeySuffixes = []string{
+	"authtoken",
+	"clientsecret",
+	"passphrase",
+	"password",
+	"privatekey",
+	"secretaccesskey",
+	"secretbinary",
+	"secretkey",
+	"secretstring",
+	"securitytoken",
+	"sessiontoken",
+}
+
+// SensitiveKeySuffixes returns the lower-cased suffixes of field names whose values are secrets.
+func SensitiveKeySuffixes() []string {
+	return slices.Clone(sensitiveKeySuffixes)
+}
+
+// IsSensitiveKey returns whether the specified field name, e.g. an AWS API input member or an attribute name,
+// names a value that must never be logged or recorded.
+// Underscores and hyphens are ignored, so `secret_key` matches as well as SecretKey.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+
+	for _, suffix := range sensitiveKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
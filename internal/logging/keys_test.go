@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logging_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		// AWS API members.
+		"AuthToken":                true,
+		"ClientSecret":             true,
+		"MasterUserPassword":       true,
+		"NewPassword":              true,
+		"OldPassword":              true,
+		"Passphrase":               true,
+		"PrivateKey":               true,
+		"SecretAccessKey":          true,
+		"SecretBinary":             true,
+		"SecretString":             true,
+		"SessionToken":             true,
+		"Credentials.SessionToken": true,
+		"AccessKeyId":              false,
+		"ClientToken":              false,
+		"Credentials":              false,
+		"IdempotencyToken":         false,
+		"KeyId":                    false,
+		"MaxResults":               false,
+		"NextToken":                false,
+		"PasswordLastUsed":         false,
+		"PublicKey":                false,
+		"SecretId":                 false,
+		"TokenCode":                false,
+		// Attribute names.
+		"master_password": true,
+		"private_key":     true,
+		"secret_key":      true,
+		"auth-token":      true,
+		"kms_key_id":      false,
+		"password_policy": false,
+		"secret_arn":      false,
+		"":                false,
+	}
+
+	for key, want := range testCases {
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			if got := logging.IsSensitiveKey(key); got != want {
+				t.Errorf("IsSensitiveKey(%q) = %t, want %t", key, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// iamPolicyLintResourceInterceptor lints a resource's configured IAM policy documents at plan time.
+type iamPolicyLintResourceInterceptor struct{}
+
+func (r iamPolicyLintResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r iamPolicyLintResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r iamPolicyLintResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r iamPolicyLintResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// modifyPlan lints the resource's configured IAM policy attributes, those of type fwtypes.IAMPolicyType including those nested in blocks,
+// if the provider's iam_policy_lint is configured.
+// Findings of the configured error finding types are reported as errors and all other findings as warnings.
+func (r iamPolicyLintResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if meta == nil || meta.IAMPolicyLintConfig == nil {
+		return ctx, diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	err := tftypes.Walk(request.Config.Raw, func(tfPath *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+			return true, nil
+		}
+
+		if t, err := request.Config.Schema.TypeAtTerraformPath(ctx, tfPath); err != nil || !t.Equal(fwtypes.IAMPolicyType) {
+			return true, nil
+		}
+
+		var policy string
+		if err := v.As(&policy); err != nil {
+			return true, nil
+		}
+
+		// Policies that can't be parsed are reported by the attribute's validation.
+		findings, err := tfiam.LintPolicy(policy)
+		if err != nil {
+			return true, nil
+		}
+
+		attributePath := iamPolicyLintAttributePath(tfPath)
+		for _, finding := range findings {
+			if meta.IAMPolicyLintConfig.IsIgnored(finding.IssueCode) {
+				continue
+			}
+
+			if meta.IAMPolicyLintConfig.IsError(string(finding.FindingType)) {
+				diags.AddAttributeError(attributePath, "IAM Policy Lint Finding", finding.String())
+			} else {
+				diags.AddAttributeWarning(attributePath, "IAM Policy Lint Finding", finding.String())
+			}
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.AddError("Linting IAM policies", err.Error())
+	}
+
+	return ctx, diags
+}
+
+// iamPolicyLintAttributePath returns the framework path of a policy attribute.
+// Set elements are identified by value, so the path of a policy nested in a set block stops at the set.
+func iamPolicyLintAttributePath(tfPath *tftypes.AttributePath) path.Path {
+	var p path.Path
+
+	for _, step := range tfPath.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			p = p.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			p = p.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			p = p.AtMapKey(string(step))
+		default:
+			return p
+		}
+	}
+
+	return p
+}
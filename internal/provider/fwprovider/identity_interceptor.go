@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityResourceSchema returns the identity schema for a resource.
+// A resource's identity consists of its AWS account ID, its Region (for regional services) and its natural key attributes.
+// Only the natural key attributes are required in an `import` block's `identity` argument.
+func identityResourceSchema(servicePackageName string, identity *types.ServicePackageResourceIdentity) identityschema.Schema {
+	s := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			names.AttrAccountID: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The AWS account ID in which the resource is managed. Defaults to the account ID of the provider configuration.",
+			},
+		},
+	}
+
+	if !names.IsGlobalService(servicePackageName) {
+		s.Attributes[names.AttrRegion] = identityschema.StringAttribute{
+			OptionalForImport: true,
+			Description:       "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		}
+	}
+
+	for _, attr := range identity.IdentityAttributes() {
+		s.Attributes[attr] = identityschema.StringAttribute{
+			RequiredForImport: true,
+		}
+	}
+
+	return s
+}
+
+// setIdentity sets a resource's identity from its state.
+func setIdentity(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, spec *types.ServicePackageResourceIdentity, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if state.Raw.IsNull() || identity == nil {
+		return diags
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrAccountID), meta.AccountID)...)
+
+	if inContext, ok := conns.FromContext(ctx); ok && !names.IsGlobalService(inContext.ServicePackageName) {
+		diags.Append(identity.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	for _, attr := range spec.IdentityAttributes() {
+		var v fwtypes.String
+		diags.Append(state.GetAttribute(ctx, path.Root(attr), &v)...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(identity.SetAttribute(ctx, path.Root(attr), v)...)
+	}
+
+	return diags
+}
+
+// identityResourceInterceptor sets a resource's identity after CRU.
+type identityResourceInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags = setIdentity(ctx, &response.State, response.Identity, r.identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags = setIdentity(ctx, &response.State, response.Identity, r.identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case After:
+		diags = setIdentity(ctx, &response.State, response.Identity, r.identity, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r identityResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+// identityImportState handles import by resource identity, i.e. an `import` block with an `identity` argument.
+// The import ID passed to the resource's ImportState method is built from the identity's natural key attributes
+// so that existing implementations, e.g. framework.WithImportByID, can be used unchanged.
+// Any Region in the identity is treated as a per-resource Region override.
+func identityImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, meta *conns.AWSClient, identity *types.ServicePackageResourceIdentity, regionOverride bool, f func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)) {
+	// Import by ID.
+	if request.ID != "" || request.Identity == nil || request.Identity.Raw.IsNull() {
+		f(ctx, request, response)
+
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		f(ctx, request, response)
+
+		return
+	}
+
+	var accountID fwtypes.String
+	response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrAccountID), &accountID)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := accountID.ValueString(); v != "" && v != meta.AccountID {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrAccountID),
+			"Invalid Resource Identity",
+			fmt.Sprintf("The identity's %s (%s) does not match the provider's account ID (%s).", names.AttrAccountID, v, meta.AccountID),
+		)
+
+		return
+	}
+
+	var region fwtypes.String
+	if !names.IsGlobalService(inContext.ServicePackageName) {
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if v := region.ValueString(); v != "" {
+		if regionOverride {
+			inContext.OverrideRegion = v
+		} else if v != meta.EffectiveRegion(ctx) {
+			response.Diagnostics.AddAttributeError(
+				path.Root(names.AttrRegion),
+				"Invalid Resource Identity",
+				fmt.Sprintf("The identity's %s (%s) does not match the provider's Region (%s).", names.AttrRegion, v, meta.EffectiveRegion(ctx)),
+			)
+
+			return
+		}
+	}
+
+	attrs := identity.IdentityAttributes()
+	values := make([]string, len(attrs))
+	for i, attr := range attrs {
+		var v fwtypes.String
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root(attr), &v)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		values[i] = v.ValueString()
+	}
+
+	request.ID = identity.ID(values)
+
+	f(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := region.ValueString(); v != "" && regionOverride {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), v)...)
+	}
+}
@@ -6,9 +6,11 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -66,6 +68,12 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resource modify plan interceptor is functionality invoked after the resource's ModifyPlan method, if any.
+type resourceModifyPlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] interceptorFunc[Request, Response]
@@ -199,13 +207,23 @@ type wrappedDataSource struct {
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverride is set if the top-level `region` attribute is injected into the data source's schema.
+	// For global services the attribute refuses any configured value.
+	regionOverride bool
+	// innerSchemaOnce guards the cached inner data source schema, which is only needed with a Region override.
+	innerSchemaOnce     sync.Once
+	innerSchemaResponse datasource.SchemaResponse
+	// servicePackageName is the name of the service package implementing the data source.
+	servicePackageName string
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, regionOverride bool, servicePackageName string) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:   bootstrapContext,
+		inner:              inner,
+		interceptors:       interceptors,
+		regionOverride:     regionOverride,
+		servicePackageName: servicePackageName,
 	}
 }
 
@@ -217,11 +235,15 @@ func (w *wrappedDataSource) Metadata(ctx context.Context, request datasource.Met
 func (w *wrappedDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		response.Schema.Attributes[names.AttrRegion] = regionDataSourceAttribute(w.servicePackageName)
+	}
 }
 
 func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
 	f := func(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		w.innerRead(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -247,6 +269,74 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -254,14 +344,34 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// regionOverride is set if the top-level `region` attribute is injected into the resource's schema.
+	// For global services the attribute refuses any configured value.
+	regionOverride bool
+	// innerSchemaOnce guards the cached inner resource schema, which is only needed with a Region override.
+	innerSchemaOnce     sync.Once
+	innerSchemaResponse resource.SchemaResponse
+	// identity is set if the resource supports import by resource identity.
+	identity *types.ServicePackageResourceIdentity
+	// servicePackageName is the name of the service package implementing the resource.
+	servicePackageName string
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
-	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, regionOverride bool, servicePackageName string, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
+	w := &wrappedResource{
+		bootstrapContext:   bootstrapContext,
+		inner:              inner,
+		interceptors:       interceptors,
+		regionOverride:     regionOverride,
+		identity:           identity,
+		servicePackageName: servicePackageName,
+	}
+
+	// Only resources with an identity implement resource.ResourceWithIdentity.
+	if identity != nil {
+		return &wrappedResourceWithIdentity{wrappedResource: w}
 	}
+
+	return w
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -272,11 +382,15 @@ func (w *wrappedResource) Metadata(ctx context.Context, request resource.Metadat
 func (w *wrappedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	ctx = w.bootstrapContext(ctx, w.meta)
 	w.inner.Schema(ctx, request, response)
+
+	if w.regionOverride {
+		response.Schema.Attributes[names.AttrRegion] = regionResourceAttribute(w.servicePackageName)
+	}
 }
 
 func (w *wrappedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	f := func(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) diag.Diagnostics {
-		w.inner.Create(ctx, request, response)
+		w.innerCreate(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -286,7 +400,7 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 
 func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	f := func(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) diag.Diagnostics {
-		w.inner.Read(ctx, request, response)
+		w.innerRead(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -296,7 +410,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 
 func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	f := func(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) diag.Diagnostics {
-		w.inner.Update(ctx, request, response)
+		w.innerUpdate(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -306,7 +420,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 
 func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
 	f := func(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) diag.Diagnostics {
-		w.inner.Delete(ctx, request, response)
+		w.innerDelete(ctx, request, response)
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
@@ -325,7 +439,20 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ImportState(ctx, request, response)
+		f := func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+			w.innerImportState(ctx, request, response, v.ImportState)
+		}
+		if w.regionOverride && !names.IsGlobalService(w.servicePackageName) {
+			g := f
+			f = func(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+				regionImportState(ctx, request, response, g)
+			}
+		}
+		if w.identity != nil {
+			identityImportState(ctx, request, response, w.meta, w.identity, w.regionOverride, f)
+		} else {
+			f(ctx, request, response)
+		}
 
 		return
 	}
@@ -337,9 +464,29 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ModifyPlan(ctx, request, response)
+		if w.regionOverride && !request.Plan.Raw.IsNull() {
+			var diags diag.Diagnostics
+			ctx, diags = getRegionOverride(ctx, request.Plan.GetAttribute, diags)
+			response.Diagnostics.Append(diags...)
+
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+		w.innerModifyPlan(ctx, request, response, v.ModifyPlan)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourceModifyPlanInterceptor); ok {
+			ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+		}
 	}
 }
 
@@ -355,7 +502,7 @@ func (w *wrappedResource) ConfigValidators(ctx context.Context) []resource.Confi
 func (w *wrappedResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
 	if v, ok := w.inner.(resource.ResourceWithValidateConfig); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
-		v.ValidateConfig(ctx, request, response)
+		w.innerValidateConfig(ctx, request, response, v.ValidateConfig)
 	}
 }
 
@@ -363,7 +510,7 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	if v, ok := w.inner.(resource.ResourceWithUpgradeState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.UpgradeState(ctx)
+		return w.innerStateUpgraders(ctx, v.UpgradeState(ctx))
 	}
 
 	return nil
@@ -373,12 +520,21 @@ func (w *wrappedResource) MoveState(ctx context.Context) []resource.StateMover {
 	if v, ok := w.inner.(resource.ResourceWithMoveState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
 
-		return v.MoveState(ctx)
+		return w.innerStateMovers(ctx, v.MoveState(ctx))
 	}
 
 	return nil
 }
 
+// wrappedResourceWithIdentity represents an interceptor dispatcher for a Plugin Framework resource with a resource identity.
+type wrappedResourceWithIdentity struct {
+	*wrappedResource
+}
+
+func (w *wrappedResourceWithIdentity) IdentitySchema(ctx context.Context, request resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityResourceSchema(w.servicePackageName, w.identity)
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -653,3 +809,56 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// modifyPlan validates the resource's planned tags, merged with any provider configured default_tags, against any provider configured tag_policy.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil || meta == nil || meta.TagPolicyConfig == nil {
+		return ctx, diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var planTags tftags.Map
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// Tags that are not known until apply can't be validated.
+	if planTags.IsUnknown() {
+		return ctx, diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return ctx, diags
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	for _, v := range meta.TagPolicyConfig.Violations(tags) {
+		if meta.TagPolicyConfig.IsWarning() {
+			diags.AddAttributeWarning(path.Root(names.AttrTags), "Tag Policy Violation", v)
+		} else {
+			diags.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", v)
+		}
+	}
+
+	return ctx, diags
+}
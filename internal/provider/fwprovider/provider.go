@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -27,6 +28,7 @@ import (
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -57,6 +59,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a local file to which every AWS API operation is appended as a line of JSON, recording the service, operation, Region, resource identifiers, duration, retry count and error code. Sensitive values are redacted. Can also be set using the `TF_AWS_API_AUDIT_LOG_FILE` environment variable.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -267,6 +273,26 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				},
 			},
 			"endpoints": endpointsBlock(),
+			"iam_policy_lint": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to lint IAM policy documents across all resources at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"error_finding_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Finding types that are reported as errors. Findings of other types are reported as warnings. Valid values are `ERROR`, `SECURITY_WARNING`, `SUGGESTION` and `WARNING`. Defaults to `[\"ERROR\"]`.",
+						},
+						"ignore_issue_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Issue codes of findings that are not reported.",
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -289,6 +315,73 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"rate_limit": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings for adaptive client-side rate limiting of AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The maximum rate, in requests per second, of each API operation.",
+						},
+						"min_requests_per_second": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The minimum rate, in requests per second, to which each API operation backs off when throttled. Defaults to a tenth of `max_requests_per_second`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to rate limit. Valid values are the keys of the `endpoints` configuration block.",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to validate resource tags across all resources at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enforcement": schema.StringAttribute{
+							Optional:    true,
+							Description: "Whether tag policy violations are reported as errors or as warnings. Valid values are `error` and `warning`. Defaults to `error`.",
+						},
+						"organizations_tag_policy": schema.StringAttribute{
+							Optional:    true,
+							Description: "AWS Organizations tag policy JSON document whose tag key casing and allowed values are enforced.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"rule": schema.ListNestedBlock{
+							Description: "Configuration blocks with rules for individual tag keys.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_value_pattern": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression that the tag's value must match.",
+									},
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "The tag's allowed values. A value ending in `*` allows any value with that prefix.",
+									},
+									"ignore_key_case": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether tag keys that differ from `key` only in casing satisfy the rule.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key, with its required casing.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether resources must have the tag.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -300,6 +393,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -343,6 +437,21 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 			interceptors := dataSourceInterceptors{}
 
+			// Inject the top-level `region` argument unless the data source already defines one.
+			// For global services the argument refuses any configured value.
+			regionOverride := false
+			if _, ok := inner.(regionOverrider); ok {
+				schemaResponse := datasource.SchemaResponse{}
+				inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regionOverride = true
+					if !names.IsGlobalService(servicePackageName) {
+						interceptors = append(interceptors, regionDataSourceInterceptor{})
+					}
+				}
+			}
+
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -363,7 +472,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, inner, interceptors, regionOverride, servicePackageName)
 			})
 		}
 	}
@@ -414,6 +523,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 			interceptors := resourceInterceptors{}
 
+			// Inject the top-level `region` argument unless the resource already defines one.
+			// For global services the argument refuses any configured value.
+			regionOverride := false
+			if _, ok := inner.(regionOverrider); ok {
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[names.AttrRegion]; !ok {
+					regionOverride = true
+					if !names.IsGlobalService(servicePackageName) {
+						interceptors = append(interceptors, regionResourceInterceptor{})
+					}
+				}
+			}
+
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
 				// Ensure that the schema look OK.
@@ -442,8 +566,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			if v.Identity != nil {
+				// The resource has opted in to import by resource identity.
+				if _, ok := inner.(resource.ResourceWithImportState); !ok {
+					errs = append(errs, fmt.Errorf("resource identity requires an ImportState method: %s", typeName))
+					continue
+				}
+
+				interceptors = append(interceptors, identityResourceInterceptor{identity: v.Identity})
+			}
+
+			// IAM policy attributes are identified from the resource schema at plan time.
+			interceptors = append(interceptors, iamPolicyLintResourceInterceptor{})
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, regionOverride, servicePackageName, v.Identity)
 			})
 		}
 	}
@@ -457,6 +594,57 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		servicePackageName := sp.ServicePackageName()
+
+		for _, v := range v.EphemeralResources(ctx) {
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods before any interceptors.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+					ctx = flex.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -466,6 +654,25 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRCanonicalizeFunction,
+		tffunction.NewCIDRContainsFunction,
+		tffunction.NewCIDRIPv6SubnetFunction,
+		tffunction.NewCIDROverlapsFunction,
+		tffunction.NewCIDRSubnetsByAZFunction,
+		tffunction.NewCloudFrontFunctionEvaluateFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewEventPatternMatchesFunction,
+		tffunction.NewIAMPolicyEquivalentFunction,
+		tffunction.NewIAMPolicyEvaluateFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewJSONEquivalentFunction,
+		tffunction.NewJSONNormalizeFunction,
+		tffunction.NewPartitionOfFunction,
+		tffunction.NewRegionalEndpointFunction,
+		tffunction.NewServicePrincipalFunction,
+		tffunction.NewSFNDefinitionValidateFunction,
 		tffunction.NewTrimIAMRolePathFunction,
+		tffunction.NewYAMLToJSONCanonicalFunction,
 	}
 }
@@ -0,0 +1,689 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	regionGlobalDescription = "Not supported. Resources in this service are global."
+
+	// regionImportIDSeparator separates a resource's import ID from any per-resource Region override,
+	// e.g. `terraform import aws_vpc.example vpc-12345678@eu-west-1`.
+	regionImportIDSeparator = "@"
+)
+
+var (
+	// Some import IDs legitimately contain the separator, e.g. email addresses,
+	// so only treat a trailing value that looks like a Region as an override.
+	regionImportIDRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+)
+
+// regionOverrider is implemented by resources and data sources which support a per-resource Region override.
+// See framework.WithRegionOverride.
+type regionOverrider interface {
+	SupportsRegionOverride()
+}
+
+func regionDataSourceAttribute(servicePackageName string) datasourceschema.StringAttribute {
+	if names.IsGlobalService(servicePackageName) {
+		return datasourceschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				globalServiceRegionValidator{servicePackageName: servicePackageName},
+			},
+			Description: regionGlobalDescription,
+		}
+	}
+
+	return datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+func regionResourceAttribute(servicePackageName string) resourceschema.StringAttribute {
+	if names.IsGlobalService(servicePackageName) {
+		return resourceschema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				globalServiceRegionValidator{servicePackageName: servicePackageName},
+			},
+			Description: regionGlobalDescription,
+		}
+	}
+
+	return resourceschema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplaceIfConfigured(),
+		},
+		Description: "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// globalServiceRegionValidator refuses any configured `region` value for resources and data sources of global services.
+type globalServiceRegionValidator struct {
+	servicePackageName string
+}
+
+func (v globalServiceRegionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v globalServiceRegionValidator) MarkdownDescription(_ context.Context) string {
+	return "value must not be configured as the service is global"
+}
+
+func (v globalServiceRegionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() || request.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	response.Diagnostics.Append(errGlobalServiceRegionDiag(v.servicePackageName, request.Path))
+}
+
+// errGlobalServiceRegionDiag returns the same diagnostic as is returned for Plugin SDK resources and data sources.
+func errGlobalServiceRegionDiag(servicePackageName string, path path.Path) diag.Diagnostic {
+	serviceName, err := names.HumanFriendly(servicePackageName)
+	if err != nil {
+		serviceName = servicePackageName
+	}
+
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("The %q argument cannot be configured for %s resources or data sources as the service is global.", names.AttrRegion, serviceName),
+	)
+}
+
+// getRegionOverride reads any configured per-resource Region override and places it in Context.
+func getRegionOverride(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var region types.String
+	diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		inContext.OverrideRegion = v
+	}
+
+	return ctx, diags
+}
+
+// setRegion sets the Region that the operation targeted in state.
+func setRegion(ctx context.Context, state *tfsdk.State, meta *conns.AWSClient, diags diag.Diagnostics) diag.Diagnostics {
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+	if state.Raw.IsNull() {
+		return diags
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+
+	return diags
+}
+
+// regionDataSourceInterceptor implements per-resource Region override for data sources.
+type regionDataSourceInterceptor struct{}
+
+func (r regionDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return getRegionOverride(ctx, request.Config.GetAttribute, diags)
+	case After:
+		diags.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), meta.EffectiveRegion(ctx))...)
+	}
+
+	return ctx, diags
+}
+
+// regionResourceInterceptor implements per-resource Region override for resources.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return getRegionOverride(ctx, request.Plan.GetAttribute, diags)
+	case After:
+		diags = setRegion(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return getRegionOverride(ctx, request.State.GetAttribute, diags)
+	case After:
+		diags = setRegion(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return getRegionOverride(ctx, request.Plan.GetAttribute, diags)
+	case After:
+		diags = setRegion(ctx, &response.State, meta, diags)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		return getRegionOverride(ctx, request.State.GetAttribute, diags)
+	}
+
+	return ctx, diags
+}
+
+// regionImportState handles any per-resource Region override appended to an import ID.
+// The Region is stripped from the ID passed to the resource's ImportState method and is set in state.
+func regionImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, f func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)) {
+	i := strings.LastIndex(request.ID, regionImportIDSeparator)
+	if i < 0 || !regionImportIDRegexp.MatchString(request.ID[i+len(regionImportIDSeparator):]) {
+		f(ctx, request, response)
+
+		return
+	}
+
+	region := request.ID[i+len(regionImportIDSeparator):]
+	request.ID = request.ID[:i]
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		inContext.OverrideRegion = region
+	}
+
+	f(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+}
+
+// The top-level `region` attribute is injected by the provider and isn't part of the inner resource's or data source's schema or model.
+// Values passed to the inner resource or data source have the attribute removed and values returned have it restored.
+
+// withoutRegion returns a copy of an object value, conforming to the inner schema, with the top-level `region` attribute removed.
+func withoutRegion(ctx context.Context, innerType attr.Type, v tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	return transformRegion(ctx, innerType, v, func(m map[string]tftypes.Value) {
+		delete(m, names.AttrRegion)
+	})
+}
+
+// withRegion returns a copy of an object value, conforming to the outer schema, with the top-level `region` attribute set.
+func withRegion(ctx context.Context, outerType attr.Type, v, region tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	return transformRegion(ctx, outerType, v, func(m map[string]tftypes.Value) {
+		m[names.AttrRegion] = region
+	})
+}
+
+func transformRegion(ctx context.Context, typ attr.Type, v tftypes.Value, f func(map[string]tftypes.Value)) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tfType := typ.TerraformType(ctx)
+
+	switch {
+	case v.Type() == nil, v.IsNull():
+		return tftypes.NewValue(tfType, nil), diags
+	case !v.IsKnown():
+		return tftypes.NewValue(tfType, tftypes.UnknownValue), diags
+	}
+
+	var m map[string]tftypes.Value
+	if err := v.As(&m); err != nil {
+		diags.AddError("Converting Value", err.Error())
+		return v, diags
+	}
+
+	// As returns the value's underlying map, which must not be modified.
+	m = maps.Clone(m)
+	f(m)
+
+	if err := tftypes.ValidateValue(tfType, m); err != nil {
+		diags.AddError("Converting Value", err.Error())
+		return v, diags
+	}
+
+	return tftypes.NewValue(tfType, m), diags
+}
+
+// regionValue returns the top-level `region` attribute of an object value.
+func regionValue(v tftypes.Value) tftypes.Value {
+	if v.Type() != nil && v.IsKnown() && !v.IsNull() {
+		var m map[string]tftypes.Value
+		if err := v.As(&m); err == nil {
+			if v, ok := m[names.AttrRegion]; ok {
+				return v
+			}
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// rawStateRegionValue returns the top-level `region` attribute of a raw state.
+func rawStateRegionValue(rawState *tfprotov6.RawState) tftypes.Value {
+	if rawState != nil {
+		var v struct {
+			Region *string `json:"region"`
+		}
+		if err := json.Unmarshal(rawState.JSON, &v); err == nil && v.Region != nil {
+			return tftypes.NewValue(tftypes.String, *v.Region)
+		}
+	}
+
+	return tftypes.NewValue(tftypes.String, nil)
+}
+
+// innerSchema returns the inner data source's schema, which doesn't include the injected top-level `region` attribute.
+// The schema is computed on first use and cached.
+func (w *wrappedDataSource) innerSchema(ctx context.Context) (datasourceschema.Schema, diag.Diagnostics) {
+	w.innerSchemaOnce.Do(func() {
+		w.inner.Schema(ctx, datasource.SchemaRequest{}, &w.innerSchemaResponse)
+	})
+
+	return w.innerSchemaResponse.Schema, w.innerSchemaResponse.Diagnostics
+}
+
+// innerRead calls the inner data source's Read method, hiding any injected top-level `region` attribute.
+func (w *wrappedDataSource) innerRead(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	if !w.regionOverride {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(request.Config.Raw)
+
+	config, diags := withoutRegion(ctx, schema.Type(), request.Config.Raw)
+	response.Diagnostics.Append(diags...)
+	state, diags := withoutRegion(ctx, schema.Type(), response.State.Raw)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.Config = tfsdk.Config{Raw: config, Schema: schema}
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	w.inner.Read(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerSchema returns the inner resource's schema, which doesn't include the injected top-level `region` attribute.
+// The schema is computed on first use and cached.
+func (w *wrappedResource) innerSchema(ctx context.Context) (resourceschema.Schema, diag.Diagnostics) {
+	w.innerSchemaOnce.Do(func() {
+		w.inner.Schema(ctx, resource.SchemaRequest{}, &w.innerSchemaResponse)
+	})
+
+	return w.innerSchemaResponse.Schema, w.innerSchemaResponse.Diagnostics
+}
+
+// regionlessValues converts values conforming to the outer schema to values conforming to the inner schema.
+func regionlessValues(ctx context.Context, schema resourceschema.Schema, values ...*tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range values {
+		raw, d := withoutRegion(ctx, schema.Type(), *v)
+		diags.Append(d...)
+		*v = raw
+	}
+
+	return diags
+}
+
+// innerCreate calls the inner resource's Create method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerCreate(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if !w.regionOverride {
+		w.inner.Create(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(request.Plan.Raw)
+
+	config, plan, state := request.Config.Raw, request.Plan.Raw, response.State.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &config, &plan, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.Config = tfsdk.Config{Raw: config, Schema: schema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: schema}
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	w.inner.Create(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerRead calls the inner resource's Read method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerRead(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	if !w.regionOverride {
+		w.inner.Read(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(request.State.Raw)
+
+	requestState, state := request.State.Raw, response.State.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &requestState, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.State = tfsdk.State{Raw: requestState, Schema: schema}
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	w.inner.Read(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerUpdate calls the inner resource's Update method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerUpdate(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if !w.regionOverride {
+		w.inner.Update(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(request.Plan.Raw)
+
+	config, plan, requestState, state := request.Config.Raw, request.Plan.Raw, request.State.Raw, response.State.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &config, &plan, &requestState, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.Config = tfsdk.Config{Raw: config, Schema: schema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: schema}
+	request.State = tfsdk.State{Raw: requestState, Schema: schema}
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	w.inner.Update(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerDelete calls the inner resource's Delete method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerDelete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if !w.regionOverride {
+		w.inner.Delete(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(request.State.Raw)
+
+	requestState, state := request.State.Raw, response.State.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &requestState, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.State = tfsdk.State{Raw: requestState, Schema: schema}
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	w.inner.Delete(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerImportState calls the inner resource's ImportState method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, f func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse)) {
+	if !w.regionOverride {
+		f(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.State.Schema
+	region := regionValue(response.State.Raw)
+
+	state := response.State.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.State = tfsdk.State{Raw: state, Schema: schema}
+
+	f(ctx, request, response)
+
+	state, diags = withRegion(ctx, outer.Type(), response.State.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.State = tfsdk.State{Raw: state, Schema: outer}
+}
+
+// innerModifyPlan calls the inner resource's ModifyPlan method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, f func(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse)) {
+	if !w.regionOverride {
+		f(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	outer := response.Plan.Schema
+	region := regionValue(response.Plan.Raw)
+
+	config, plan, state, responsePlan := request.Config.Raw, request.Plan.Raw, request.State.Raw, response.Plan.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &config, &plan, &state, &responsePlan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.Config = tfsdk.Config{Raw: config, Schema: schema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: schema}
+	request.State = tfsdk.State{Raw: state, Schema: schema}
+	response.Plan = tfsdk.Plan{Raw: responsePlan, Schema: schema}
+
+	f(ctx, request, response)
+
+	responsePlan, diags = withRegion(ctx, outer.Type(), response.Plan.Raw, region)
+	response.Diagnostics.Append(diags...)
+	response.Plan = tfsdk.Plan{Raw: responsePlan, Schema: outer}
+}
+
+// innerValidateConfig calls the inner resource's ValidateConfig method, hiding any injected top-level `region` attribute.
+func (w *wrappedResource) innerValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse, f func(context.Context, resource.ValidateConfigRequest, *resource.ValidateConfigResponse)) {
+	if !w.regionOverride {
+		f(ctx, request, response)
+
+		return
+	}
+
+	schema, diags := w.innerSchema(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	config := request.Config.Raw
+	response.Diagnostics.Append(regionlessValues(ctx, schema, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	request.Config = tfsdk.Config{Raw: config, Schema: schema}
+
+	f(ctx, request, response)
+}
+
+// innerStateUpgraders wraps the inner resource's state upgraders, hiding any injected top-level `region` attribute.
+// The upgraded state's `region` value is that of the prior state.
+func (w *wrappedResource) innerStateUpgraders(ctx context.Context, upgraders map[int64]resource.StateUpgrader) map[int64]resource.StateUpgrader {
+	if !w.regionOverride {
+		return upgraders
+	}
+
+	wrapped := make(map[int64]resource.StateUpgrader, len(upgraders))
+	for version, upgrader := range upgraders {
+		f := upgrader.StateUpgrader
+		upgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			schema, diags := w.innerSchema(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			outer := response.State.Schema
+			response.State = tfsdk.State{Schema: schema}
+
+			f(ctx, request, response)
+
+			// A state returned as a DynamicValue overrides State and must conform to the outer schema.
+			if response.DynamicValue != nil || response.State.Raw.Type() == nil {
+				response.State = tfsdk.State{Raw: response.State.Raw, Schema: outer}
+
+				return
+			}
+
+			state, diags := withRegion(ctx, outer.Type(), response.State.Raw, rawStateRegionValue(request.RawState))
+			response.Diagnostics.Append(diags...)
+			response.State = tfsdk.State{Raw: state, Schema: outer}
+		}
+		wrapped[version] = upgrader
+	}
+
+	return wrapped
+}
+
+// innerStateMovers wraps the inner resource's state movers, hiding any injected top-level `region` attribute.
+// The moved state's `region` value is that of the source state.
+func (w *wrappedResource) innerStateMovers(ctx context.Context, movers []resource.StateMover) []resource.StateMover {
+	if !w.regionOverride {
+		return movers
+	}
+
+	wrapped := make([]resource.StateMover, 0, len(movers))
+	for _, mover := range movers {
+		f := mover.StateMover
+		mover.StateMover = func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			schema, diags := w.innerSchema(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			outer := response.TargetState.Schema
+			state := response.TargetState.Raw
+			response.Diagnostics.Append(regionlessValues(ctx, schema, &state)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			response.TargetState = tfsdk.State{Raw: state, Schema: schema}
+
+			f(ctx, request, response)
+
+			state, diags = withRegion(ctx, outer.Type(), response.TargetState.Raw, rawStateRegionValue(request.SourceRawState))
+			response.Diagnostics.Append(diags...)
+			response.TargetState = tfsdk.State{Raw: state, Schema: outer}
+		}
+		wrapped = append(wrapped, mover)
+	}
+
+	return wrapped
+}
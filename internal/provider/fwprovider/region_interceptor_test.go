@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	testRegion = "eu-west-1" //lintignore:AWSAT003
+)
+
+// testRegionSchema returns a schema with nested attributes and blocks, optionally with the injected top-level `region` attribute.
+func testRegionSchema(region bool) schema.Schema {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+			},
+			"settings": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					names.AttrValue: schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						names.AttrFilter: schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrValues: schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if region {
+		s.Attributes[names.AttrRegion] = regionResourceAttribute(names.EC2)
+	}
+
+	return s
+}
+
+// testRegionValues returns attribute values, conforming to the inner schema, for a resource with nested blocks.
+func testRegionValues(ctx context.Context) map[string]tftypes.Value {
+	innerType := testRegionSchema(false).Type().TerraformType(ctx).(tftypes.Object)
+	settingsType := innerType.AttributeTypes["settings"].(tftypes.Object)
+	ruleType := innerType.AttributeTypes[names.AttrRule].(tftypes.List).ElementType.(tftypes.Object)
+	filterType := ruleType.AttributeTypes[names.AttrFilter].(tftypes.Set).ElementType.(tftypes.Object)
+	valuesType := filterType.AttributeTypes[names.AttrValues]
+
+	return map[string]tftypes.Value{
+		names.AttrID:   tftypes.NewValue(tftypes.String, "test-id"),
+		names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+		"settings": tftypes.NewValue(settingsType, map[string]tftypes.Value{
+			names.AttrValue: tftypes.NewValue(tftypes.String, "v1"),
+		}),
+		names.AttrRule: tftypes.NewValue(innerType.AttributeTypes[names.AttrRule], []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "rule1"),
+				names.AttrFilter: tftypes.NewValue(ruleType.AttributeTypes[names.AttrFilter], []tftypes.Value{
+					tftypes.NewValue(filterType, map[string]tftypes.Value{
+						names.AttrValues: tftypes.NewValue(valuesType, []tftypes.Value{
+							tftypes.NewValue(tftypes.String, "a"),
+							tftypes.NewValue(tftypes.String, "b"),
+						}),
+					}),
+				}),
+			}),
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				names.AttrName:   tftypes.NewValue(tftypes.String, "rule2"),
+				names.AttrFilter: tftypes.NewValue(ruleType.AttributeTypes[names.AttrFilter], []tftypes.Value{}),
+			}),
+		}),
+	}
+}
+
+func TestWithoutRegionWithRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	innerType := testRegionSchema(false).Type()
+	outerType := testRegionSchema(true).Type()
+	innerTFType := innerType.TerraformType(ctx).(tftypes.Object)
+
+	nullValues := func() map[string]tftypes.Value {
+		m := make(map[string]tftypes.Value)
+		for k, v := range innerTFType.AttributeTypes {
+			m[k] = tftypes.NewValue(v, nil)
+		}
+		return m
+	}
+	unknownValues := func() map[string]tftypes.Value {
+		m := make(map[string]tftypes.Value)
+		for k, v := range innerTFType.AttributeTypes {
+			m[k] = tftypes.NewValue(v, tftypes.UnknownValue)
+		}
+		return m
+	}
+
+	testCases := []struct {
+		name   string
+		values map[string]tftypes.Value // nil for a null object
+		region tftypes.Value
+		// unknown is set for an unknown object.
+		unknown bool
+	}{
+		{
+			name:   "nested blocks",
+			values: testRegionValues(ctx),
+			region: tftypes.NewValue(tftypes.String, testRegion),
+		},
+		{
+			name:   "nested blocks null region",
+			values: testRegionValues(ctx),
+			region: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:   "null values",
+			values: nullValues(),
+			region: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:   "unknown values",
+			values: unknownValues(),
+			region: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		{
+			name:   "null object",
+			region: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:    "unknown object",
+			region:  tftypes.NewValue(tftypes.String, nil),
+			unknown: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var outer, expectedInner tftypes.Value
+			switch {
+			case testCase.unknown:
+				outer = tftypes.NewValue(outerType.TerraformType(ctx), tftypes.UnknownValue)
+				expectedInner = tftypes.NewValue(innerTFType, tftypes.UnknownValue)
+			case testCase.values == nil:
+				outer = tftypes.NewValue(outerType.TerraformType(ctx), nil)
+				expectedInner = tftypes.NewValue(innerTFType, nil)
+			default:
+				outerValues := maps.Clone(testCase.values)
+				outerValues[names.AttrRegion] = testCase.region
+				outer = tftypes.NewValue(outerType.TerraformType(ctx), outerValues)
+				expectedInner = tftypes.NewValue(innerTFType, testCase.values)
+			}
+
+			inner, diags := withoutRegion(ctx, innerType, outer)
+			if diags.HasError() {
+				t.Fatalf("withoutRegion: %v", diags)
+			}
+
+			if !inner.Equal(expectedInner) {
+				t.Errorf("withoutRegion = %s, want %s", inner, expectedInner)
+			}
+
+			if got, want := regionValue(outer), testCase.region; !got.Equal(want) {
+				t.Errorf("regionValue = %s, want %s", got, want)
+			}
+
+			got, diags := withRegion(ctx, outerType, inner, testCase.region)
+			if diags.HasError() {
+				t.Fatalf("withRegion: %v", diags)
+			}
+
+			if !got.Equal(outer) {
+				t.Errorf("withRegion = %s, want %s", got, outer)
+			}
+		})
+	}
+}
+
+func TestWithoutRegionMismatchedType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	values := testRegionValues(ctx)
+	values[names.AttrRegion] = tftypes.NewValue(tftypes.String, testRegion)
+	values["unexpected"] = tftypes.NewValue(tftypes.String, "x")
+	outerTFType := testRegionSchema(true).Type().TerraformType(ctx).(tftypes.Object)
+	outerTFType.AttributeTypes = maps.Clone(outerTFType.AttributeTypes)
+	outerTFType.AttributeTypes["unexpected"] = tftypes.String
+
+	_, diags := withoutRegion(ctx, testRegionSchema(false).Type(), tftypes.NewValue(outerTFType, values))
+
+	if !diags.HasError() {
+		t.Error("expected error")
+	}
+}
+
+// testRegionResource is a minimal resource used to exercise the wrapping of inner resource methods with a Region override.
+type testRegionResource struct {
+	schemaCalls int
+	// newValues holds the attribute values set by the resource's state upgrader and state mover.
+	newValues map[string]tftypes.Value
+}
+
+func (r *testRegionResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testRegionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.schemaCalls++
+	response.Schema = testRegionSchema(false)
+}
+
+func (r *testRegionResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testRegionResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *testRegionResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (r *testRegionResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *testRegionResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *testRegionResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				if _, ok := response.State.Schema.GetAttributes()[names.AttrRegion]; ok {
+					response.Diagnostics.AddError("Upgrading State", "region attribute in inner schema")
+					return
+				}
+
+				response.State.Raw = tftypes.NewValue(response.State.Schema.Type().TerraformType(ctx), r.newValues)
+			},
+		},
+	}
+}
+
+func (r *testRegionResource) MoveState(context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+				if _, ok := response.TargetState.Schema.GetAttributes()[names.AttrRegion]; ok {
+					response.Diagnostics.AddError("Moving State", "region attribute in inner schema")
+					return
+				}
+
+				response.TargetState.Raw = tftypes.NewValue(response.TargetState.Schema.Type().TerraformType(ctx), r.newValues)
+			},
+		},
+	}
+}
+
+func newTestRegionWrappedResource(inner *testRegionResource) *wrappedResource {
+	bootstrapContext := func(ctx context.Context, _ *conns.AWSClient) context.Context {
+		return ctx
+	}
+
+	return newWrappedResource(bootstrapContext, inner, resourceInterceptors{}, true, names.EC2, nil).(*wrappedResource)
+}
+
+func TestWrappedResourceInnerSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	inner := &testRegionResource{}
+	w := newTestRegionWrappedResource(inner)
+
+	for range 3 {
+		schema, diags := w.innerSchema(ctx)
+		if diags.HasError() {
+			t.Fatalf("innerSchema: %v", diags)
+		}
+
+		if _, ok := schema.Attributes[names.AttrRegion]; ok {
+			t.Error("inner schema has region attribute")
+		}
+	}
+
+	if got, want := inner.schemaCalls, 1; got != want {
+		t.Errorf("Schema calls = %d, want %d", got, want)
+	}
+}
+
+func TestWrappedResourceStateUpgraders(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		rawState       string
+		expectedRegion tftypes.Value
+	}{
+		{
+			name:           "Region",
+			rawState:       `{"id":"test-id","region":"` + testRegion + `"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, testRegion),
+		},
+		{
+			name:           "no Region",
+			rawState:       `{"id":"test-id"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			inner := &testRegionResource{newValues: testRegionValues(ctx)}
+			w := newTestRegionWrappedResource(inner)
+			outer := testRegionSchema(true)
+
+			upgraders := w.UpgradeState(ctx)
+			request := resource.UpgradeStateRequest{
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.rawState)},
+			}
+			response := resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: outer},
+			}
+
+			upgraders[0].StateUpgrader(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("StateUpgrader: %v", response.Diagnostics)
+			}
+
+			if _, ok := response.State.Schema.GetAttributes()[names.AttrRegion]; !ok {
+				t.Error("upgraded state schema has no region attribute")
+			}
+
+			values := maps.Clone(inner.newValues)
+			values[names.AttrRegion] = testCase.expectedRegion
+			expected := tftypes.NewValue(outer.Type().TerraformType(ctx), values)
+
+			if got, want := response.State.Raw, expected; !got.Equal(want) {
+				t.Errorf("upgraded state = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceStateMovers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		sourceRawState string
+		expectedRegion tftypes.Value
+	}{
+		{
+			name:           "Region",
+			sourceRawState: `{"id":"test-id","region":"` + testRegion + `"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, testRegion),
+		},
+		{
+			name:           "no Region",
+			sourceRawState: `{"id":"test-id"}`,
+			expectedRegion: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			inner := &testRegionResource{newValues: testRegionValues(ctx)}
+			w := newTestRegionWrappedResource(inner)
+			outer := testRegionSchema(true)
+
+			movers := w.MoveState(ctx)
+			request := resource.MoveStateRequest{
+				SourceRawState: &tfprotov6.RawState{JSON: []byte(testCase.sourceRawState)},
+			}
+			response := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Raw:    tftypes.NewValue(outer.Type().TerraformType(ctx), nil),
+					Schema: outer,
+				},
+			}
+
+			movers[0].StateMover(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("StateMover: %v", response.Diagnostics)
+			}
+
+			if _, ok := response.TargetState.Schema.GetAttributes()[names.AttrRegion]; !ok {
+				t.Error("moved state schema has no region attribute")
+			}
+
+			values := maps.Clone(inner.newValues)
+			values[names.AttrRegion] = testCase.expectedRegion
+			expected := tftypes.NewValue(outer.Type().TerraformType(ctx), values)
+
+			if got, want := response.TargetState.Raw, expected; !got.Equal(want) {
+				t.Errorf("moved state = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceSchemaGlobalService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bootstrapContext := func(ctx context.Context, _ *conns.AWSClient) context.Context {
+		return ctx
+	}
+	w := newWrappedResource(bootstrapContext, &testRegionResource{}, resourceInterceptors{}, true, names.IAM, nil)
+
+	response := resource.SchemaResponse{}
+	w.Schema(ctx, resource.SchemaRequest{}, &response)
+
+	v, ok := response.Schema.Attributes[names.AttrRegion]
+	if !ok {
+		t.Fatal("schema has no region attribute")
+	}
+
+	if !v.IsOptional() || v.IsComputed() {
+		t.Errorf("region attribute Optional = %t, Computed = %t, want Optional only", v.IsOptional(), v.IsComputed())
+	}
+}
+
+func TestGlobalServiceRegionValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{
+			name:  "null",
+			value: types.StringNull(),
+		},
+		{
+			name:  "unknown",
+			value: types.StringUnknown(),
+		},
+		{
+			name:  "empty",
+			value: types.StringValue(""),
+		},
+		{
+			name:      "configured",
+			value:     types.StringValue(testRegion),
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			request := validator.StringRequest{
+				Path:        path.Root(names.AttrRegion),
+				ConfigValue: testCase.value,
+			}
+			response := validator.StringResponse{}
+			globalServiceRegionValidator{servicePackageName: names.IAM}.ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.wantError; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.wantError {
+				d := response.Diagnostics[0]
+				if got, want := d.Summary(), "Invalid Attribute Value"; got != want {
+					t.Errorf("Summary = %q, want %q", got, want)
+				}
+				if got, want := d.Detail(), `The "region" argument cannot be configured for IAM (Identity & Access Management) resources or data sources as the service is global.`; got != want {
+					t.Errorf("Detail = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// iamPolicyAttributes returns the paths of the configurable IAM policy attributes in a resource schema,
+// including those nested in list and set blocks.
+// IAM policy attributes are those that suppress differences between equivalent policies.
+func iamPolicyAttributes(s map[string]*schema.Schema) [][]string {
+	suppressEquivalentPolicyDiffs := reflect.ValueOf(verify.SuppressEquivalentPolicyDiffs).Pointer()
+
+	var paths [][]string
+
+	keys := tfmaps.Keys(s)
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !v.Optional && !v.Required {
+			continue
+		}
+
+		switch v.Type {
+		case schema.TypeString:
+			if v.DiffSuppressFunc != nil && reflect.ValueOf(v.DiffSuppressFunc).Pointer() == suppressEquivalentPolicyDiffs {
+				paths = append(paths, []string{k})
+			}
+		case schema.TypeList, schema.TypeSet:
+			if v, ok := v.Elem.(*schema.Resource); ok {
+				for _, path := range iamPolicyAttributes(v.SchemaMap()) {
+					paths = append(paths, append([]string{k}, path...))
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+// iamPolicyValues returns the known, non-null string values at an attribute path, descending into list and set elements.
+func iamPolicyValues(v cty.Value, path []string) []string {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	if len(path) == 0 {
+		if v.Type() == cty.String {
+			return []string{v.AsString()}
+		}
+
+		return nil
+	}
+
+	if ty := v.Type(); ty.IsListType() || ty.IsSetType() {
+		var values []string
+		for it := v.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			values = append(values, iamPolicyValues(v, path)...)
+		}
+
+		return values
+	}
+
+	if !v.Type().IsObjectType() || !v.Type().HasAttribute(path[0]) {
+		return nil
+	}
+
+	return iamPolicyValues(v.GetAttr(path[0]), path[1:])
+}
+
+// iamPolicyLintValidateRawResourceConfig lints a resource's configured IAM policy documents if the provider's iam_policy_lint is configured.
+// Findings of the configured error finding types are returned as errors and all other findings as warnings.
+func iamPolicyLintValidateRawResourceConfig(_ context.Context, config cty.Value, meta any, paths [][]string) diag.Diagnostics {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.IAMPolicyLintConfig == nil {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	for _, path := range paths {
+		attribute := strings.Join(path, ".")
+
+		for _, policy := range iamPolicyValues(config, path) {
+			// Policies that can't be parsed are reported by the attribute's validation.
+			findings, err := tfiam.LintPolicy(policy)
+			if err != nil {
+				continue
+			}
+
+			for _, finding := range findings {
+				if c.IAMPolicyLintConfig.IsIgnored(finding.IssueCode) {
+					continue
+				}
+
+				severity := diag.Warning
+				if c.IAMPolicyLintConfig.IsError(string(finding.FindingType)) {
+					severity = diag.Error
+				}
+
+				diags = append(diags, diag.Diagnostic{
+					Severity:      severity,
+					Summary:       "IAM Policy Lint Finding",
+					Detail:        fmt.Sprintf("%s: %s", attribute, finding),
+					AttributePath: cty.GetAttrPath(path[0]),
+				})
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func TestIAMPolicyAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"assume_role_policy": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+		"description": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+		},
+		"inline_policy": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"policy": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+					},
+				},
+			},
+		},
+		"policy": {
+			Type:             schema.TypeString,
+			Computed:         true,
+			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+		},
+	}
+
+	want := [][]string{
+		{"assume_role_policy"},
+		{"inline_policy", "policy"},
+	}
+
+	if diff := cmp.Diff(iamPolicyAttributes(s), want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestIAMPolicyValues(t *testing.T) {
+	t.Parallel()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"assume_role_policy": cty.StringVal("trust"),
+		"inline_policy": cty.SetVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{"policy": cty.StringVal("one")}),
+			cty.ObjectVal(map[string]cty.Value{"policy": cty.UnknownVal(cty.String)}),
+			cty.ObjectVal(map[string]cty.Value{"policy": cty.NullVal(cty.String)}),
+		}),
+		"policy": cty.UnknownVal(cty.String),
+	})
+
+	testCases := map[string]struct {
+		path []string
+		want []string
+	}{
+		"top-level": {
+			path: []string{"assume_role_policy"},
+			want: []string{"trust"},
+		},
+		"nested": {
+			path: []string{"inline_policy", "policy"},
+			want: []string{"one"},
+		},
+		"unknown": {
+			path: []string{"policy"},
+		},
+		"missing": {
+			path: []string{"name"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(iamPolicyValues(config, testCase.path), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// identityResourceSchema returns the identity schema for a resource.
+// A resource's identity consists of its AWS account ID, its Region (for regional services) and its natural key attributes.
+// Only the natural key attributes are required in an `import` block's `identity` argument.
+func identityResourceSchema(servicePackageName string, identity *types.ServicePackageResourceIdentity) *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			m := map[string]*schema.Schema{
+				names.AttrAccountID: {
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The AWS account ID in which the resource is managed. Defaults to the account ID of the provider configuration.",
+				},
+			}
+
+			if !names.IsGlobalService(servicePackageName) {
+				m[names.AttrRegion] = &schema.Schema{
+					Type:              schema.TypeString,
+					OptionalForImport: true,
+					Description:       "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+				}
+			}
+
+			for _, attr := range identity.IdentityAttributes() {
+				m[attr] = &schema.Schema{
+					Type:              schema.TypeString,
+					RequiredForImport: true,
+				}
+			}
+
+			return m
+		},
+	}
+}
+
+// identityInterceptor sets a resource's identity after CRU.
+type identityInterceptor struct {
+	identity *types.ServicePackageResourceIdentity
+}
+
+func (r identityInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case After:
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			identity, err := d.Identity()
+			if err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "getting identity: %s", err)
+			}
+
+			c := meta.(*conns.AWSClient)
+
+			if err := identity.Set(names.AttrAccountID, c.AccountID); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrAccountID, err)
+			}
+
+			if !names.IsGlobalService(inContext.ServicePackageName) {
+				if err := identity.Set(names.AttrRegion, c.EffectiveRegion(ctx)); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", names.AttrRegion, err)
+				}
+			}
+
+			for _, attr := range r.identity.IdentityAttributes() {
+				var v string
+				if attr == names.AttrID {
+					v = d.Id()
+				} else {
+					switch raw := d.Get(attr).(type) {
+					case string:
+						v = raw
+					default:
+						v = fmt.Sprint(raw)
+					}
+				}
+
+				if err := identity.Set(attr, v); err != nil {
+					return ctx, sdkdiag.AppendErrorf(diags, "setting identity %s: %s", attr, err)
+				}
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// identityImportState handles import by resource identity, i.e. an `import` block with an `identity` argument.
+// The resource ID is built from the identity's natural key attributes so that the resource's own importer can be used unchanged.
+// Any Region in the identity is treated as a per-resource Region override.
+func identityImportState(ctx context.Context, d *schema.ResourceData, meta any, identity *types.ServicePackageResourceIdentity, regionOverride bool) (context.Context, error) {
+	// Import by ID.
+	if d.Id() != "" {
+		return ctx, nil
+	}
+
+	identityData, err := d.Identity()
+	if err != nil {
+		return ctx, fmt.Errorf("getting identity: %w", err)
+	}
+
+	c := meta.(*conns.AWSClient)
+
+	if v, ok := identityData.GetOk(names.AttrAccountID); ok {
+		if v := v.(string); v != c.AccountID {
+			return ctx, fmt.Errorf("importing resource: identity %s (%s) does not match the provider's account ID (%s)", names.AttrAccountID, v, c.AccountID)
+		}
+	}
+
+	if v, ok := identityData.GetOk(names.AttrRegion); ok {
+		region := v.(string)
+
+		if regionOverride {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return ctx, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+			}
+		} else if region != c.EffectiveRegion(ctx) {
+			return ctx, fmt.Errorf("importing resource: identity %s (%s) does not match the provider's Region (%s)", names.AttrRegion, region, c.EffectiveRegion(ctx))
+		}
+	}
+
+	attrs := identity.IdentityAttributes()
+	values := make([]string, len(attrs))
+	for i, attr := range attrs {
+		v, ok := identityData.GetOk(attr)
+		if !ok {
+			return ctx, fmt.Errorf("importing resource: identity attribute %q is required", attr)
+		}
+		values[i] = v.(string)
+	}
+
+	d.SetId(identity.ID(values))
+
+	return ctx, nil
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestIdentityImportState(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		identity           *types.ServicePackageResourceIdentity
+		identityValues     map[string]string
+		expectedID         string
+		expectedRegion     string
+		expectError        bool
+	}{
+		{
+			name:               "id",
+			servicePackageName: names.EC2,
+			identity:           &types.ServicePackageResourceIdentity{},
+			identityValues: map[string]string{
+				names.AttrID: "vpc-12345678",
+			},
+			expectedID: "vpc-12345678",
+		},
+		{
+			name:               "natural key",
+			servicePackageName: names.IAM,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes:  []string{names.AttrRole, "policy_arn"},
+				IDSeparator: "/",
+			},
+			identityValues: map[string]string{
+				names.AttrRole: "my-role",
+				"policy_arn":   "arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+			},
+			expectedID: "my-role/arn:aws:iam::aws:policy/ReadOnlyAccess", //lintignore:AWSAT005
+		},
+		{
+			name:               "default separator",
+			servicePackageName: names.EC2,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{"transit_gateway_id", names.AttrVPCID},
+			},
+			identityValues: map[string]string{
+				"transit_gateway_id": "tgw-12345678",
+				names.AttrVPCID:      "vpc-12345678",
+			},
+			expectedID: "tgw-12345678,vpc-12345678",
+		},
+		{
+			name:               "matching account ID",
+			servicePackageName: names.Logs,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			identityValues: map[string]string{
+				names.AttrAccountID: accountID,
+				names.AttrName:      "my-log-group",
+			},
+			expectedID: "my-log-group",
+		},
+		{
+			name:               "other account ID",
+			servicePackageName: names.Logs,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			identityValues: map[string]string{
+				names.AttrAccountID: "210987654321",
+				names.AttrName:      "my-log-group",
+			},
+			expectError: true,
+		},
+		{
+			name:               "Region",
+			servicePackageName: names.Logs,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			identityValues: map[string]string{
+				names.AttrRegion: "eu-west-1", //lintignore:AWSAT003
+				names.AttrName:   "my-log-group",
+			},
+			expectedID:     "my-log-group",
+			expectedRegion: "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:               "missing natural key attribute",
+			servicePackageName: names.Logs,
+			identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+			identityValues: map[string]string{
+				names.AttrAccountID: accountID,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), testCase.servicePackageName, "Test")
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{
+				names.AttrRegion: regionResourceSchema(testCase.servicePackageName),
+			}, identityResourceSchema(testCase.servicePackageName, testCase.identity).SchemaMap(), testCase.identityValues)
+			meta := &conns.AWSClient{
+				AccountID: accountID,
+				Region:    region,
+			}
+
+			ctx, err := identityImportState(ctx, d, meta, testCase.identity, true)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError %t", err, want)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+
+			inContext, _ := conns.FromContext(ctx)
+			if got, want := inContext.OverrideRegion, testCase.expectedRegion; got != want {
+				t.Errorf("OverrideRegion = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIdentityResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	identity := &types.ServicePackageResourceIdentity{
+		Attributes: []string{names.AttrName},
+	}
+
+	if err := identityResourceSchema(names.Logs, identity).InternalIdentityValidate(); err != nil {
+		t.Errorf("regional identity schema: %s", err)
+	}
+
+	m := identityResourceSchema(names.IAM, identity).SchemaMap()
+	if _, ok := m[names.AttrRegion]; ok {
+		t.Errorf("global identity schema contains %q", names.AttrRegion)
+	}
+	if v, ok := m[names.AttrName]; !ok || !v.RequiredForImport {
+		t.Errorf("global identity schema %q not RequiredForImport", names.AttrName)
+	}
+}
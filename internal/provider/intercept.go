@@ -31,6 +31,7 @@ type schemaResourceData interface {
 	GetRawState() cty.Value
 	HasChange(key string) bool
 	Id() string
+	Identity() (*schema.IdentityData, error)
 	Set(string, any) error
 }
 
@@ -153,6 +154,14 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// regionOverride is set if the resource supports a per-resource Region override.
+	regionOverride bool
+	// identity is set if the resource supports import by resource identity.
+	identity *types.ServicePackageResourceIdentity
+	// iamPolicyAttributes are the paths of the resource's IAM policy attributes.
+	iamPolicyAttributes [][]string
+	// tags is set if the resource supports transparent tagging.
+	tags *types.ServicePackageResourceTags
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,6 +184,22 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.identity != nil {
+			var err error
+			ctx, err = identityImportState(ctx, d, meta, r.identity, r.regionOverride)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if r.regionOverride {
+			var err error
+			ctx, err = regionImportState(ctx, d, meta)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
@@ -183,10 +208,37 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.regionOverride {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				if v, ok := d.Get(names.AttrRegion).(string); ok {
+					inContext.OverrideRegion = v
+				}
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
 
+// ValidateRawResourceConfig returns a function that validates the resource's configuration against any provider configured policies,
+// reporting violations as error or warning diagnostics.
+// Terraform validates a resource's configuration both before the provider is configured, when there is nothing to validate against,
+// and again during planning.
+func (r *wrappedResource) ValidateRawResourceConfig(meta func() any) schema.ValidateRawResourceConfigFunc {
+	return func(ctx context.Context, request schema.ValidateResourceConfigFuncRequest, response *schema.ValidateResourceConfigFuncResponse) {
+		meta := meta()
+		ctx = r.bootstrapContext(ctx, meta)
+
+		if len(r.iamPolicyAttributes) > 0 {
+			response.Diagnostics = append(response.Diagnostics, iamPolicyLintValidateRawResourceConfig(ctx, request.RawConfig, meta, r.iamPolicyAttributes)...)
+		}
+
+		if r.tags != nil {
+			response.Diagnostics = append(response.Diagnostics, tagPolicyValidateRawResourceConfig(ctx, request.RawConfig, meta)...)
+		}
+	}
+}
+
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
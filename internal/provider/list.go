@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ListResourceTypes returns the sorted names of all resource types whose existing remote objects can be enumerated.
+func ListResourceTypes(ctx context.Context, meta *conns.AWSClient) []string {
+	var typeNames []string
+
+	for _, sp := range meta.ServicePackages {
+		if v, ok := sp.(conns.ServicePackageWithListResources); ok {
+			for _, r := range v.ListResources(ctx) {
+				typeNames = append(typeNames, r.TypeName)
+			}
+		}
+	}
+
+	slices.Sort(typeNames)
+
+	return typeNames
+}
+
+// ListResourceIdentities enumerates the existing remote objects of the specified resource type,
+// yielding each object's resource identity.
+// Objects are enumerated in the specified Region, or the Region set in the provider configuration if empty.
+func ListResourceIdentities(ctx context.Context, meta *conns.AWSClient, typeName, region string) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		sp, listResource, identity, err := findListResource(ctx, meta, typeName)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		servicePackageName := sp.ServicePackageName()
+		global := names.IsGlobalService(servicePackageName)
+		if region != "" && global {
+			yield(nil, fmt.Errorf("listing %s: a Region cannot be specified for a global service", typeName))
+			return
+		}
+
+		ctx = conns.NewResourceContext(ctx, servicePackageName, listResource.Name)
+		if region != "" {
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.OverrideRegion = region
+			}
+		}
+		ctx = meta.RegisterLogger(ctx)
+
+		for values, err := range listResource.List(ctx, meta) {
+			if err != nil {
+				yield(nil, fmt.Errorf("listing %s: %w", typeName, err))
+				return
+			}
+
+			v := map[string]string{
+				names.AttrAccountID: meta.AccountID,
+			}
+			if !global {
+				v[names.AttrRegion] = meta.EffectiveRegion(ctx)
+			}
+			for _, attr := range identity.IdentityAttributes() {
+				v[attr] = values[attr]
+			}
+
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// findListResource returns the service package, list capability and resource identity for the specified resource type.
+func findListResource(ctx context.Context, meta *conns.AWSClient, typeName string) (conns.ServicePackage, *types.ServicePackageListResource, *types.ServicePackageResourceIdentity, error) {
+	for _, sp := range meta.ServicePackages {
+		v, ok := sp.(conns.ServicePackageWithListResources)
+		if !ok {
+			continue
+		}
+
+		for _, listResource := range v.ListResources(ctx) {
+			if listResource.TypeName != typeName {
+				continue
+			}
+
+			identity := resourceIdentity(ctx, sp, typeName)
+			if identity == nil {
+				return nil, nil, nil, fmt.Errorf("listing %s: resource type has no resource identity", typeName)
+			}
+
+			return sp, listResource, identity, nil
+		}
+	}
+
+	return nil, nil, nil, fmt.Errorf("listing %s: resource type does not support listing", typeName)
+}
+
+// resourceIdentity returns the resource identity declared by the specified resource type, if any.
+func resourceIdentity(ctx context.Context, sp conns.ServicePackage, typeName string) *types.ServicePackageResourceIdentity {
+	for _, r := range sp.SDKResources(ctx) {
+		if r.TypeName == typeName {
+			return r.Identity
+		}
+	}
+
+	for _, r := range sp.FrameworkResources(ctx) {
+		inner, err := r.Factory(ctx)
+		if err != nil {
+			continue
+		}
+
+		var metadata resource.MetadataResponse
+		inner.Metadata(ctx, resource.MetadataRequest{}, &metadata)
+		if metadata.TypeName == typeName {
+			return r.Identity
+		}
+	}
+
+	return nil
+}
+
+// ImportBlock returns an `import` block that imports a resource by its identity into the specified resource address.
+func ImportBlock(typeName, name string, identity map[string]string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "import {\n")
+	fmt.Fprintf(&b, "  to = %s.%s\n", typeName, name)
+	fmt.Fprintf(&b, "  identity = {\n")
+
+	keys := slices.Sorted(maps.Keys(identity))
+	width := 0
+	for _, k := range keys {
+		width = max(width, len(k))
+	}
+	for _, k := range keys {
+		fmt.Fprintf(&b, "    %-*s = %s\n", width, k, hclQuote(identity[k]))
+	}
+
+	fmt.Fprintf(&b, "  }\n")
+	fmt.Fprintf(&b, "}\n")
+
+	return b.String()
+}
+
+// hclQuote returns a quoted HCL string literal, escaping any template sequences.
+func hclQuote(s string) string {
+	s = strconv.Quote(s)
+	s = strings.ReplaceAll(s, "${", "$${")
+	s = strings.ReplaceAll(s, "%{", "%%{")
+
+	return s
+}
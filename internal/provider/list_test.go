@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"iter"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockListServicePackage struct {
+	servicePackageName string
+	listErr            error
+}
+
+func (sp *mockListServicePackage) FrameworkDataSources(context.Context) []*types.ServicePackageFrameworkDataSource {
+	return []*types.ServicePackageFrameworkDataSource{}
+}
+
+func (sp *mockListServicePackage) FrameworkResources(context.Context) []*types.ServicePackageFrameworkResource {
+	return []*types.ServicePackageFrameworkResource{}
+}
+
+func (sp *mockListServicePackage) SDKDataSources(context.Context) []*types.ServicePackageSDKDataSource {
+	return []*types.ServicePackageSDKDataSource{}
+}
+
+func (sp *mockListServicePackage) SDKResources(context.Context) []*types.ServicePackageSDKResource {
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  func() *schema.Resource { return &schema.Resource{} },
+			TypeName: "aws_test_thing",
+			Name:     "Thing",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
+		},
+		{
+			Factory:  func() *schema.Resource { return &schema.Resource{} },
+			TypeName: "aws_test_other",
+			Name:     "Other",
+		},
+	}
+}
+
+func (sp *mockListServicePackage) ListResources(context.Context) []*types.ServicePackageListResource {
+	list := func(_ context.Context, _ any) iter.Seq2[map[string]string, error] {
+		return func(yield func(map[string]string, error) bool) {
+			for _, v := range []string{"one", "two"} {
+				if !yield(map[string]string{names.AttrName: v}, nil) {
+					return
+				}
+			}
+			if sp.listErr != nil {
+				yield(nil, sp.listErr)
+			}
+		}
+	}
+
+	return []*types.ServicePackageListResource{
+		{
+			List:     list,
+			TypeName: "aws_test_thing",
+			Name:     "Thing",
+		},
+		{
+			List:     list,
+			TypeName: "aws_test_other",
+			Name:     "Other",
+		},
+	}
+}
+
+func (sp *mockListServicePackage) ServicePackageName() string {
+	return sp.servicePackageName
+}
+
+func TestListResourceIdentities(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID = "123456789012"
+		region    = "us-west-2" //lintignore:AWSAT003
+	)
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		typeName           string
+		region             string
+		listErr            error
+		expected           []map[string]string
+		expectError        bool
+	}{
+		{
+			name:               "regional",
+			servicePackageName: names.Logs,
+			typeName:           "aws_test_thing",
+			expected: []map[string]string{
+				{names.AttrAccountID: accountID, names.AttrRegion: region, names.AttrName: "one"},
+				{names.AttrAccountID: accountID, names.AttrRegion: region, names.AttrName: "two"},
+			},
+		},
+		{
+			name:               "Region override",
+			servicePackageName: names.Logs,
+			typeName:           "aws_test_thing",
+			region:             "eu-west-1", //lintignore:AWSAT003
+			expected: []map[string]string{
+				{names.AttrAccountID: accountID, names.AttrRegion: "eu-west-1", names.AttrName: "one"}, //lintignore:AWSAT003
+				{names.AttrAccountID: accountID, names.AttrRegion: "eu-west-1", names.AttrName: "two"}, //lintignore:AWSAT003
+			},
+		},
+		{
+			name:               "global",
+			servicePackageName: names.IAM,
+			typeName:           "aws_test_thing",
+			expected: []map[string]string{
+				{names.AttrAccountID: accountID, names.AttrName: "one"},
+				{names.AttrAccountID: accountID, names.AttrName: "two"},
+			},
+		},
+		{
+			name:               "global with Region",
+			servicePackageName: names.IAM,
+			typeName:           "aws_test_thing",
+			region:             "eu-west-1", //lintignore:AWSAT003
+			expectError:        true,
+		},
+		{
+			name:               "no identity",
+			servicePackageName: names.Logs,
+			typeName:           "aws_test_other",
+			expectError:        true,
+		},
+		{
+			name:               "not listable",
+			servicePackageName: names.Logs,
+			typeName:           "aws_test_unknown",
+			expectError:        true,
+		},
+		{
+			name:               "list error",
+			servicePackageName: names.Logs,
+			typeName:           "aws_test_thing",
+			listErr:            errors.New("test error"),
+			expected: []map[string]string{
+				{names.AttrAccountID: accountID, names.AttrRegion: region, names.AttrName: "one"},
+				{names.AttrAccountID: accountID, names.AttrRegion: region, names.AttrName: "two"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			meta := &conns.AWSClient{
+				AccountID: accountID,
+				Region:    region,
+				ServicePackages: map[string]conns.ServicePackage{
+					testCase.servicePackageName: &mockListServicePackage{
+						servicePackageName: testCase.servicePackageName,
+						listErr:            testCase.listErr,
+					},
+				},
+			}
+
+			var got []map[string]string
+			var err error
+			for v, e := range ListResourceIdentities(ctx, meta, testCase.typeName, testCase.region) {
+				if e != nil {
+					err = e
+					break
+				}
+				got = append(got, v)
+			}
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestListResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	meta := &conns.AWSClient{
+		ServicePackages: map[string]conns.ServicePackage{
+			names.Logs: &mockListServicePackage{servicePackageName: names.Logs},
+		},
+	}
+
+	if diff := cmp.Diff(ListResourceTypes(context.Background(), meta), []string{"aws_test_other", "aws_test_thing"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestImportBlock(t *testing.T) {
+	t.Parallel()
+
+	got := ImportBlock("aws_cloudwatch_log_group", "example", map[string]string{
+		names.AttrAccountID: "123456789012",
+		names.AttrName:      "/aws/lambda/${fn}",
+		names.AttrRegion:    "us-west-2", //lintignore:AWSAT003
+	})
+	want := `import {
+  to = aws_cloudwatch_log_group.example
+  identity = {
+    account_id = "123456789012"
+    name       = "/aws/lambda/$${fn}"
+    region     = "us-west-2"
+  }
+}
+`
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,10 +22,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -49,6 +52,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a local file to which every AWS API operation is appended as a line of JSON, " +
+					"recording the service, operation, Region, resource identifiers, duration, retry count and error code. " +
+					"Sensitive values are redacted. " +
+					"Can also be set using the `TF_AWS_API_AUDIT_LOG_FILE` environment variable.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -106,6 +117,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_lint": iamPolicyLintSchema(),
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -155,6 +167,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limit": rateLimitSchema(),
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -231,6 +244,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -300,6 +314,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the top-level `region` argument unless the data source already defines one.
+			if _, ok := r.SchemaMap()[names.AttrRegion]; !ok {
+				injectRegionSchema(r, regionDataSourceSchema(servicePackageName))
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -376,6 +401,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Inject the top-level `region` argument unless the resource already defines one.
+			_, regionDefined := r.SchemaMap()[names.AttrRegion]
+			if !regionDefined {
+				injectRegionSchema(r, regionResourceSchema(servicePackageName))
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -411,9 +448,29 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if v.Identity != nil {
+				// The resource has opted in to import by resource identity.
+				if r.Importer == nil || r.Importer.StateContext == nil {
+					errs = append(errs, fmt.Errorf("resource identity requires an importer: %s", typeName))
+					continue
+				}
+
+				r.Identity = identityResourceSchema(servicePackageName, v.Identity)
+
+				interceptors = append(interceptors, interceptorItem{
+					when:        After,
+					why:         Create | Read | Update,
+					interceptor: identityInterceptor{identity: v.Identity},
+				})
+			}
+
 			rs := &wrappedResource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
+				bootstrapContext:    bootstrapContext,
+				interceptors:        interceptors,
+				regionOverride:      !regionDefined,
+				identity:            v.Identity,
+				iamPolicyAttributes: iamPolicyAttributes(r.SchemaMap()),
+				tags:                v.Tags,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -436,6 +493,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			if v := r.CustomizeDiff; v != nil {
 				r.CustomizeDiff = rs.CustomizeDiff(v)
 			}
+			// Resources with transparent tagging validate their tags against any provider configured tag_policy,
+			// and resources with IAM policy attributes lint them if the provider's iam_policy_lint is configured.
+			if rs.tags != nil || len(rs.iamPolicyAttributes) > 0 {
+				r.ValidateRawResourceConfigFuncs = append(r.ValidateRawResourceConfigFuncs, rs.ValidateRawResourceConfig(provider.Meta))
+			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
 					stateUpgrader.Upgrade = rs.StateUpgrade(v)
@@ -478,6 +540,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APIAuditLogFile:                d.Get("api_audit_log_file").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -499,6 +562,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if config.APIAuditLogFile == "" {
+		config.APIAuditLogFile = os.Getenv("TF_AWS_API_AUDIT_LOG_FILE")
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -570,6 +637,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.GetOk("rate_limit"); ok {
+		rateLimits, dx := expandRateLimits(ctx, v.([]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.APIRateLimits = rateLimits
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -595,6 +671,19 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("iam_policy_lint"); ok && len(v.([]any)) > 0 {
+		config.IAMPolicyLintConfig = expandIAMPolicyLint(ctx, v.([]any)[0])
+	}
+
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicyConfig, dx := expandTagPolicy(ctx, v.([]any)[0].(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TagPolicyConfig = tagPolicyConfig
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -758,6 +847,125 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings for adaptive client-side rate limiting of AWS API requests.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_requests_per_second": {
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "The maximum rate, in requests per second, of each API operation.",
+				},
+				"min_requests_per_second": {
+					Type:        schema.TypeFloat,
+					Optional:    true,
+					Description: "The minimum rate, in requests per second, to which each API operation backs off when throttled. Defaults to a tenth of `max_requests_per_second`.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The service to rate limit. Valid values are the keys of the `endpoints` configuration block.",
+				},
+			},
+		},
+	}
+}
+
+func iamPolicyLintSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to lint IAM policy documents across all resources at plan time.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"error_finding_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[tfiam.PolicyFindingType](),
+					},
+					Description: "Finding types that are reported as errors. Findings of other types are reported as warnings. Valid values are `ERROR`, `SECURITY_WARNING`, `SUGGESTION` and `WARNING`. Defaults to `[\"ERROR\"]`.",
+				},
+				"ignore_issue_codes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(tfiam.PolicyIssueCodes(), false),
+					},
+					Description: "Issue codes of findings that are not reported.",
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to validate resource tags across all resources at plan time.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enforcement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Whether tag policy violations are reported as errors or as warnings. Valid values are `error` and `warning`. Defaults to `error`.",
+					ValidateFunc: validation.StringInSlice(enum.Slice(tftags.PolicyEnforcementError, tftags.PolicyEnforcementWarning), false),
+				},
+				"organizations_tag_policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "AWS Organizations tag policy JSON document whose tag key casing and allowed values are enforced.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"rule": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with rules for individual tag keys.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_value_pattern": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "Regular expression that the tag's value must match.",
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "The tag's allowed values. A value ending in `*` allows any value with that prefix.",
+							},
+							"ignore_key_case": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether tag keys that differ from `key` only in casing satisfy the rule.",
+							},
+							names.AttrKey: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The tag key, with its required casing.",
+							},
+							"required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether resources must have the tag.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -939,6 +1147,132 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRateLimits(_ context.Context, tfList []any) (map[string]conns.APIRateLimit, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rateLimitPath := cty.GetAttrPath("rate_limit")
+	rateLimits := make(map[string]conns.APIRateLimit)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		elementPath := rateLimitPath.IndexInt(i)
+
+		service := tfMap["service"].(string)
+		servicePackageName, err := names.ProviderPackageForAlias(service)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", err.Error()))
+			continue
+		}
+		if _, ok := rateLimits[servicePackageName]; ok {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("service"), "Invalid Attribute Value", fmt.Sprintf("duplicate rate limit for service %s", service)))
+			continue
+		}
+
+		rateLimit := conns.APIRateLimit{
+			MaxRequestsPerSecond: tfMap["max_requests_per_second"].(float64),
+		}
+		if v, ok := tfMap["min_requests_per_second"].(float64); ok {
+			rateLimit.MinRequestsPerSecond = v
+		}
+
+		if rateLimit.MaxRequestsPerSecond <= 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("max_requests_per_second"), "Invalid Attribute Value", "must be greater than 0"))
+			continue
+		}
+		if rateLimit.MinRequestsPerSecond < 0 || rateLimit.MinRequestsPerSecond > rateLimit.MaxRequestsPerSecond {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(elementPath.GetAttr("min_requests_per_second"), "Invalid Attribute Value", "must be between 0 and max_requests_per_second"))
+			continue
+		}
+
+		rateLimits[servicePackageName] = rateLimit
+	}
+
+	return rateLimits, diags
+}
+
+// expandIAMPolicyLint expands the `iam_policy_lint` configuration block.
+// An empty block enables linting with the default settings.
+func expandIAMPolicyLint(_ context.Context, tfMapRaw any) *conns.IAMPolicyLintConfig {
+	iamPolicyLintConfig := &conns.IAMPolicyLintConfig{
+		ErrorFindingTypes: enum.Slice(tfiam.PolicyFindingTypeError),
+	}
+
+	tfMap, ok := tfMapRaw.(map[string]any)
+	if !ok {
+		return iamPolicyLintConfig
+	}
+
+	if v, ok := tfMap["error_finding_types"].(*schema.Set); ok && v.Len() > 0 {
+		iamPolicyLintConfig.ErrorFindingTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["ignore_issue_codes"].(*schema.Set); ok && v.Len() > 0 {
+		iamPolicyLintConfig.IgnoreIssueCodes = flex.ExpandStringValueSet(v)
+	}
+
+	return iamPolicyLintConfig
+}
+
+func expandTagPolicy(_ context.Context, tfMap map[string]any) (*tftags.PolicyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tagPolicyPath := cty.GetAttrPath("tag_policy").IndexInt(0)
+	tagPolicyConfig := &tftags.PolicyConfig{
+		Enforcement: tftags.PolicyEnforcementError,
+	}
+
+	if v, ok := tfMap["enforcement"].(string); ok && v != "" {
+		tagPolicyConfig.Enforcement = tftags.PolicyEnforcement(v)
+	}
+
+	if v, ok := tfMap["rule"].([]any); ok {
+		for i, tfMapRaw := range v {
+			tfRule, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfRule[names.AttrKey].(string),
+			}
+
+			if v, ok := tfRule["allowed_value_pattern"].(string); ok && v != "" {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					diags = append(diags, errs.NewAttributeErrorDiagnostic(tagPolicyPath.GetAttr("rule").IndexInt(i).GetAttr("allowed_value_pattern"), "Invalid Attribute Value", err.Error()))
+					continue
+				}
+				rule.AllowedValuePattern = re
+			}
+			if v, ok := tfRule["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+			if v, ok := tfRule["ignore_key_case"].(bool); ok {
+				rule.IgnoreKeyCase = v
+			}
+			if v, ok := tfRule["required"].(bool); ok {
+				rule.Required = v
+			}
+
+			tagPolicyConfig.Rules = append(tagPolicyConfig.Rules, rule)
+		}
+	}
+
+	if v, ok := tfMap["organizations_tag_policy"].(string); ok && v != "" {
+		rules, err := tftags.PolicyRulesFromOrganizationsTagPolicy(v)
+		if err != nil {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(tagPolicyPath.GetAttr("organizations_tag_policy"), "Invalid Attribute Value", err.Error()))
+		} else {
+			tagPolicyConfig.Rules = append(tagPolicyConfig.Rules, rules...)
+		}
+	}
+
+	return tagPolicyConfig, diags
+}
+
 func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	return errs.NewWarningDiagnostic(
 		"Deprecated Environment Variable",
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -412,6 +413,160 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap             map[string]any
+		expectedConfig    *tftags.PolicyConfig
+		expectedPatterns  []string
+		expectedErrorPath cty.Path
+	}{
+		"empty": {
+			tfMap: map[string]any{},
+			expectedConfig: &tftags.PolicyConfig{
+				Enforcement: tftags.PolicyEnforcementError,
+			},
+		},
+		"rules": {
+			tfMap: map[string]any{
+				"enforcement": "warning",
+				"rule": []any{
+					map[string]any{
+						names.AttrKey:           "CostCenter",
+						"allowed_value_pattern": "",
+						"allowed_values":        schema.NewSet(schema.HashString, []any{"100"}),
+						"ignore_key_case":       false,
+						"required":              true,
+					},
+					map[string]any{
+						names.AttrKey:           "Owner",
+						"allowed_value_pattern": `^[a-z]+$`,
+						"allowed_values":        schema.NewSet(schema.HashString, []any{}),
+						"ignore_key_case":       true,
+						"required":              false,
+					},
+				},
+				"organizations_tag_policy": `{"tags": {"project": {"tag_key": {"@@assign": "Project"}}}}`,
+			},
+			expectedConfig: &tftags.PolicyConfig{
+				Enforcement: tftags.PolicyEnforcementWarning,
+				Rules: []tftags.PolicyRule{
+					{Key: "CostCenter", Required: true, AllowedValues: []string{"100"}},
+					{Key: "Owner", IgnoreKeyCase: true},
+					{Key: "Project"},
+				},
+			},
+			expectedPatterns: []string{"", `^[a-z]+$`, ""},
+		},
+		"invalid pattern": {
+			tfMap: map[string]any{
+				"rule": []any{
+					map[string]any{
+						names.AttrKey:           "CostCenter",
+						"allowed_value_pattern": `[`,
+					},
+				},
+			},
+			expectedErrorPath: cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("rule").IndexInt(0).GetAttr("allowed_value_pattern"),
+		},
+		"invalid organizations tag policy": {
+			tfMap: map[string]any{
+				"organizations_tag_policy": `{"tags": []}`,
+			},
+			expectedErrorPath: cty.GetAttrPath("tag_policy").IndexInt(0).GetAttr("organizations_tag_policy"),
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, diags := expandTagPolicy(ctx, testcase.tfMap)
+
+			if testcase.expectedErrorPath != nil {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+				if got, want := diags[0].AttributePath, testcase.expectedErrorPath; !got.Equals(want) {
+					t.Errorf("unexpected error path: got %#v, want %#v", got, want)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			for i, rule := range result.Rules {
+				var pattern string
+				if rule.AllowedValuePattern != nil {
+					pattern = rule.AllowedValuePattern.String()
+				}
+				if i < len(testcase.expectedPatterns) {
+					if got, want := pattern, testcase.expectedPatterns[i]; got != want {
+						t.Errorf("rule %d: unexpected allowed value pattern: got %q, want %q", i, got, want)
+					}
+				}
+				result.Rules[i].AllowedValuePattern = nil
+			}
+
+			if diff := cmp.Diff(testcase.expectedConfig, result); diff != "" {
+				t.Errorf("Unexpected tag_policy diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandIAMPolicyLint(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMapRaw       any
+		expectedConfig *conns.IAMPolicyLintConfig
+	}{
+		"empty block": {
+			tfMapRaw: nil,
+			expectedConfig: &conns.IAMPolicyLintConfig{
+				ErrorFindingTypes: []string{"ERROR"},
+			},
+		},
+		"defaults": {
+			tfMapRaw: map[string]any{
+				"error_finding_types": schema.NewSet(schema.HashString, []any{}),
+				"ignore_issue_codes":  schema.NewSet(schema.HashString, []any{}),
+			},
+			expectedConfig: &conns.IAMPolicyLintConfig{
+				ErrorFindingTypes: []string{"ERROR"},
+			},
+		},
+		"configured": {
+			tfMapRaw: map[string]any{
+				"error_finding_types": schema.NewSet(schema.HashString, []any{"SECURITY_WARNING"}),
+				"ignore_issue_codes":  schema.NewSet(schema.HashString, []any{"MISSING_VERSION"}),
+			},
+			expectedConfig: &conns.IAMPolicyLintConfig{
+				ErrorFindingTypes: []string{"SECURITY_WARNING"},
+				IgnoreIssueCodes:  []string{"MISSING_VERSION"},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result := expandIAMPolicyLint(ctx, testcase.tfMapRaw)
+
+			if diff := cmp.Diff(testcase.expectedConfig, result); diff != "" {
+				t.Errorf("Unexpected iam_policy_lint diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
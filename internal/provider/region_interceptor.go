@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// regionImportIDSeparator separates a resource's import ID from any per-resource Region override,
+	// e.g. `terraform import aws_vpc.example vpc-12345678@eu-west-1`.
+	regionImportIDSeparator = "@"
+)
+
+var (
+	// Some import IDs legitimately contain the separator, e.g. email addresses,
+	// so only treat a trailing value that looks like a Region as an override.
+	regionImportIDRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d+$`)
+)
+
+// regionResourceSchema returns the schema for the top-level `region` argument injected into resources.
+func regionResourceSchema(servicePackageName string) *schema.Schema {
+	if names.IsGlobalService(servicePackageName) {
+		v := regionGlobalSchema(servicePackageName)
+		// Resources without an Update handler require all configurable arguments to be ForceNew.
+		v.ForceNew = true
+
+		return v
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The AWS Region in which this resource is managed. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionDataSourceSchema returns the schema for the top-level `region` argument injected into data sources.
+func regionDataSourceSchema(servicePackageName string) *schema.Schema {
+	if names.IsGlobalService(servicePackageName) {
+		return regionGlobalSchema(servicePackageName)
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which this data source is read. Defaults to the Region set in the provider configuration.",
+	}
+}
+
+// regionGlobalSchema returns a `region` argument schema that refuses any configured value.
+func regionGlobalSchema(servicePackageName string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateDiagFunc: func(v any, path cty.Path) diag.Diagnostics {
+			if v, ok := v.(string); !ok || v == "" {
+				return nil
+			}
+
+			return diag.Diagnostics{errGlobalServiceRegionDiag(servicePackageName, path)}
+		},
+		Description: "Not supported. Resources in this service are global.",
+	}
+}
+
+// injectRegionSchema adds the `region` argument to a resource's or data source's schema.
+func injectRegionSchema(r *schema.Resource, v *schema.Schema) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = v
+
+			return m
+		}
+	} else {
+		r.Schema[names.AttrRegion] = v
+	}
+}
+
+func errGlobalServiceRegionDiag(servicePackageName string, path cty.Path) diag.Diagnostic {
+	serviceName, err := names.HumanFriendly(servicePackageName)
+	if err != nil {
+		serviceName = servicePackageName
+	}
+
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       "Invalid Attribute Value",
+		Detail:        fmt.Sprintf("The %q argument cannot be configured for %s resources or data sources as the service is global.", names.AttrRegion, serviceName),
+		AttributePath: path,
+	}
+}
+
+// regionInterceptor implements per-resource Region override for resources and data sources.
+// The configured `region` value is placed into Context so that AWS API clients are created for that Region.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		region, _ := d.Get(names.AttrRegion).(string)
+		if region == "" {
+			return ctx, diags
+		}
+
+		if names.IsGlobalService(inContext.ServicePackageName) {
+			return ctx, append(diags, errGlobalServiceRegionDiag(inContext.ServicePackageName, cty.GetAttrPath(names.AttrRegion)))
+		}
+
+		inContext.OverrideRegion = region
+	case After:
+		// Set region in state after CRU.
+		switch why {
+		case Create, Read, Update:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			if names.IsGlobalService(inContext.ServicePackageName) {
+				return ctx, diags
+			}
+
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).EffectiveRegion(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// regionImportState handles any per-resource Region override appended to an import ID.
+// The Region is stripped from the ID and set in state so that it is available to the subsequent Read.
+func regionImportState(ctx context.Context, d *schema.ResourceData, meta any) (context.Context, error) {
+	i := strings.LastIndex(d.Id(), regionImportIDSeparator)
+	if i < 0 {
+		return ctx, nil
+	}
+
+	id, region := d.Id()[:i], d.Id()[i+len(regionImportIDSeparator):]
+	if !regionImportIDRegexp.MatchString(region) {
+		return ctx, nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	if names.IsGlobalService(inContext.ServicePackageName) {
+		return ctx, fmt.Errorf("importing %s: a Region cannot be specified for a global service", d.Id())
+	}
+
+	d.SetId(id)
+	if err := d.Set(names.AttrRegion, region); err != nil {
+		return ctx, fmt.Errorf("setting %s: %w", names.AttrRegion, err)
+	}
+
+	inContext.OverrideRegion = region
+
+	return ctx, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRegionImportState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		servicePackageName string
+		importID           string
+		expectedID         string
+		expectedRegion     string
+		expectError        bool
+	}{
+		{
+			name:               "no Region",
+			servicePackageName: names.EC2,
+			importID:           "vpc-12345678",
+			expectedID:         "vpc-12345678",
+		},
+		{
+			name:               "Region",
+			servicePackageName: names.EC2,
+			importID:           "vpc-12345678@eu-west-1", //lintignore:AWSAT003
+			expectedID:         "vpc-12345678",
+			expectedRegion:     "eu-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:               "email address",
+			servicePackageName: names.SES,
+			importID:           "user@example.com",
+			expectedID:         "user@example.com",
+		},
+		{
+			name:               "email address and Region",
+			servicePackageName: names.SES,
+			importID:           "user@example.com@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:         "user@example.com",
+			expectedRegion:     "us-gov-west-1", //lintignore:AWSAT003
+		},
+		{
+			name:               "global service",
+			servicePackageName: names.IAM,
+			importID:           "my-role@eu-west-1", //lintignore:AWSAT003
+			expectError:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(context.Background(), testCase.servicePackageName, "Test")
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				names.AttrRegion: regionResourceSchema(testCase.servicePackageName),
+			}, map[string]any{})
+			d.SetId(testCase.importID)
+
+			ctx, err := regionImportState(ctx, d, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expectError %t", err, want)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("ID = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get(names.AttrRegion).(string), testCase.expectedRegion; got != want {
+				t.Errorf("region = %q, want %q", got, want)
+			}
+
+			inContext, _ := conns.FromContext(ctx)
+			if got, want := inContext.OverrideRegion, testCase.expectedRegion; got != want {
+				t.Errorf("OverrideRegion = %q, want %q", got, want)
+			}
+		})
+	}
+}
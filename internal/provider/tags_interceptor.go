@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -172,3 +173,58 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagPolicyValidateRawResourceConfig validates a resource's configured tags, merged with any provider configured default_tags, against any provider configured tag_policy.
+// Violations are returned as errors unless the tag policy's enforcement is `warning`, in which case they are returned as warnings.
+func tagPolicyValidateRawResourceConfig(ctx context.Context, config cty.Value, meta any) diag.Diagnostics {
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || c.TagPolicyConfig == nil {
+		return nil
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(names.AttrTags) {
+		return nil
+	}
+
+	// Tags that are not known until apply can't be validated.
+	v := config.GetAttr(names.AttrTags)
+	if !v.IsWhollyKnown() {
+		return nil
+	}
+
+	configTags := make(map[string]string)
+	if !v.IsNull() {
+		for k, v := range v.AsValueMap() {
+			if !v.IsNull() {
+				configTags[k] = v.AsString()
+			}
+		}
+	}
+
+	// Merge the resource's configured tags with any provider configured default_tags.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, configTags))
+	// Remove system tags.
+	tags = tags.IgnoreSystem(inContext.ServicePackageName)
+
+	var diags diag.Diagnostics
+
+	for _, v := range c.TagPolicyConfig.Violations(tags) {
+		if c.TagPolicyConfig.IsWarning() {
+			diags = sdkdiag.AppendWarningf(diags, "tag policy violation: %s", v)
+		} else {
+			diags = sdkdiag.AppendErrorf(diags, "tag policy violation: %s", v)
+		}
+	}
+
+	return diags
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -137,6 +138,10 @@ func (d *resourceData) Id() string {
 	return "id"
 }
 
+func (d *resourceData) Identity() (*schema.IdentityData, error) {
+	return nil, nil
+}
+
 func (d *resourceData) Set(string, any) error {
 	return nil
 }
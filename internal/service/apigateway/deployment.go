@@ -157,7 +157,7 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 	executionARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "execute-api",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("%s/%s", restAPIID, stageName),
 	}.String()
@@ -253,7 +253,7 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading API Gateway Domain Name (%s): %s", d.Id(), err)
 	}
 
-	d.Set(names.AttrARN, domainNameARN(meta.(*conns.AWSClient), d.Id()))
+	d.Set(names.AttrARN, domainNameARN(ctx, meta.(*conns.AWSClient), d.Id()))
 	d.Set(names.AttrCertificateARN, domainName.CertificateArn)
 	d.Set("certificate_name", domainName.CertificateName)
 	if domainName.CertificateUploadDate != nil {
@@ -519,11 +519,11 @@ func flattenMutualTLSAuthentication(apiObject *types.MutualTlsAuthentication) []
 	return []interface{}{tfMap}
 }
 
-func domainNameARN(c *conns.AWSClient, domainName string) string {
+func domainNameARN(ctx context.Context, c *conns.AWSClient, domainName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		Resource:  fmt.Sprintf("/domainnames/%s", domainName),
 	}.String()
 }
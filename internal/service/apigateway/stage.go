@@ -254,7 +254,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("access_log_settings", flattenAccessLogSettings(stage.AccessLogSettings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting access_log_settings: %s", err)
 	}
-	d.Set(names.AttrARN, stageARN(meta.(*conns.AWSClient), apiID, stageName))
+	d.Set(names.AttrARN, stageARN(ctx, meta.(*conns.AWSClient), apiID, stageName))
 	if stage.CacheClusterStatus == types.CacheClusterStatusDeleteInProgress {
 		d.Set("cache_cluster_enabled", false)
 		d.Set("cache_cluster_size", d.Get("cache_cluster_size"))
@@ -274,7 +274,7 @@ func resourceStageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("deployment_id", stage.DeploymentId)
 	d.Set(names.AttrDescription, stage.Description)
 	d.Set("documentation_version", stage.DocumentationVersion)
-	d.Set("execution_arn", stageInvokeARN(meta.(*conns.AWSClient), apiID, stageName))
+	d.Set("execution_arn", stageInvokeARN(ctx, meta.(*conns.AWSClient), apiID, stageName))
 	d.Set("invoke_url", meta.(*conns.AWSClient).APIGatewayInvokeURL(ctx, apiID, stageName))
 	if err := d.Set("variables", stage.Variables); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting variables: %s", err)
@@ -652,20 +652,20 @@ func appendCanarySettingsPatchOperations(operations []types.PatchOperation, oldC
 	return operations
 }
 
-func stageARN(c *conns.AWSClient, apiID, stageName string) string {
+func stageARN(ctx context.Context, c *conns.AWSClient, apiID, stageName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		Service:   "apigateway",
 		Resource:  fmt.Sprintf("/restapis/%s/stages/%s", apiID, stageName),
 	}.String()
 }
 
-func stageInvokeARN(c *conns.AWSClient, apiID, stageName string) string {
+func stageInvokeARN(ctx context.Context, c *conns.AWSClient, apiID, stageName string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "execute-api",
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		AccountID: c.AccountID,
 		Resource:  fmt.Sprintf("%s/%s", apiID, stageName),
 	}.String()
@@ -175,7 +175,7 @@ func resourceDomainNameRead(ctx context.Context, d *schema.ResourceData, meta in
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "apigateway",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		Resource:  "/domainnames/" + d.Id(),
 	}.String()
 	d.Set(names.AttrARN, arn)
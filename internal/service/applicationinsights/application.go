@@ -141,7 +141,7 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "applicationinsights",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  "application/resource-group/" + rgName,
 	}.String()
@@ -113,7 +113,7 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, meta inte
 	sort.Strings(arns)
 	sort.Strings(nms)
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set(names.AttrNames, nms)
 
@@ -105,7 +105,7 @@ func (d *foundationModelsDataSource) Read(ctx context.Context, request datasourc
 		return
 	}
 
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().EffectiveRegion(ctx))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
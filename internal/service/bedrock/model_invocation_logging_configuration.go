@@ -118,7 +118,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Create(ctx context.Context
 	}
 
 	// Set values for unknowns.
-	data.ID = types.StringValue(r.Meta().Region)
+	data.ID = types.StringValue(r.Meta().EffectiveRegion(ctx))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_chime_voice_connector", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_chime_voice_connector", &resource.Sweeper{
 		Name: "aws_chime_voice_connector",
 		F:    sweepVoiceConnectors,
 	})
@@ -10,9 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/cloudfrontfunction"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -41,8 +43,9 @@ func resourceFunction() *schema.Resource {
 				Computed: true,
 			},
 			"code": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: functionCodeWarnings,
 			},
 			names.AttrComment: {
 				Type:     schema.TypeString,
@@ -271,6 +274,24 @@ func findFunctionByTwoPartKey(ctx context.Context, conn *cloudfront.Client, name
 	return output, nil
 }
 
+// functionCodeWarnings reports errors found by compiling the function code locally, such as syntax errors, as warnings.
+// The code is compiled for the cloudfront-js-2.0 runtime, whose syntax is a superset of cloudfront-js-1.0's.
+// The code is validated by AWS when the function is created or updated.
+func functionCodeWarnings(v any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := cloudfrontfunction.Compile(cloudfrontfunction.Runtime2_0, v.(string)); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "CloudFront Function code may be invalid",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 func expandKeyValueStoreAssociations(tfList []interface{}) *awstypes.KeyValueStoreAssociations {
 	if len(tfList) == 0 {
 		return nil
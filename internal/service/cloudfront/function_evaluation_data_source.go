@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"strconv"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/cloudfrontfunction"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// @SDKDataSource("aws_cloudfront_function_evaluation", name="Function Evaluation")
+func dataSourceFunctionEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFunctionEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			"code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The function code.`,
+			},
+			"event_object": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  `The viewer request or viewer response event object, in JSON format.`,
+			},
+			"key_value_store": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `The keys and values of the key value store associated with the function.`,
+			},
+			"runtime": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FunctionRuntime](),
+				Description:      `The function's runtime.`,
+			},
+
+			// Result Attributes
+			"compute_utilization": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `An estimate, from 0 to 100, of the function's compute utilization, based on the number of statements executed.`,
+			},
+			"function_error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The error thrown by the function, if any.`,
+			},
+			"function_execution_logs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: `The lines logged by the function using console.log().`,
+			},
+			"function_output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The request or response returned by the function, in JSON format.`,
+			},
+		},
+	}
+}
+
+func dataSourceFunctionEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	code := d.Get("code").(string)
+	fn, err := cloudfrontfunction.Compile(d.Get("runtime").(string), code)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "compiling CloudFront Function code: %s", err)
+	}
+
+	input := &cloudfrontfunction.Input{
+		Event: d.Get("event_object").(string),
+	}
+
+	if v, ok := d.GetOk("key_value_store"); ok {
+		input.KeyValueStore = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	output, err := fn.Evaluate(ctx, input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating CloudFront Function: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(code)))
+	d.Set("compute_utilization", output.ComputeUtilization)
+	d.Set("function_error_message", output.ErrorMessage)
+	d.Set("function_execution_logs", output.ExecutionLogs)
+	d.Set("function_output", output.Output)
+
+	return diags
+}
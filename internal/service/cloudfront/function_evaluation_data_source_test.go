@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontFunctionEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudfront_function_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionEvaluationDataSourceConfig_basic("/index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_utilization"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_error_message", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "function_execution_logs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_execution_logs.0", "rewriting /index.html"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_output", `{"request":{"cookies":{},"headers":{},"method":"GET","querystring":{},"uri":"/v2/index.html"}}`),
+				),
+			},
+			{
+				Config: testAccFunctionEvaluationDataSourceConfig_basic("/admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_error_message", "Error: forbidden: /admin"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_execution_logs.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_output", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontFunctionEvaluationDataSource_invalidCode(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionEvaluationDataSourceConfig_invalidCode(),
+				ExpectError: regexache.MustCompile(`compiling CloudFront Function code: SyntaxError`),
+			},
+		},
+	})
+}
+
+func testAccFunctionEvaluationDataSourceConfig_basic(uri string) string {
+	return fmt.Sprintf(`
+data "aws_cloudfront_function_evaluation" "test" {
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+import cf from 'cloudfront';
+
+const kvsHandle = cf.kvs();
+
+async function handler(event) {
+  const request = event.request;
+  if (request.uri.startsWith('/admin')) {
+    throw new Error('forbidden: ' + request.uri);
+  }
+  console.log('rewriting', request.uri);
+  request.uri = '/' + await kvsHandle.get('version') + request.uri;
+  return request;
+}
+EOT
+
+  event_object = jsonencode({
+    version = "1.0"
+    context = { eventType = "viewer-request" }
+    viewer  = { ip = "198.51.100.11" }
+    request = {
+      method      = "GET"
+      uri         = %[1]q
+      querystring = {}
+      headers     = {}
+      cookies     = {}
+    }
+  })
+
+  key_value_store = {
+    version = "v2"
+  }
+}
+`, uri)
+}
+
+func testAccFunctionEvaluationDataSourceConfig_invalidCode() string {
+	return `
+data "aws_cloudfront_function_evaluation" "test" {
+  runtime = "cloudfront-js-1.0"
+  code    = "function handler(event) {"
+
+  event_object = jsonencode({
+    version = "1.0"
+    context = { eventType = "viewer-request" }
+    viewer  = { ip = "198.51.100.11" }
+    request = {
+      method      = "GET"
+      uri         = "/"
+      querystring = {}
+      headers     = {}
+      cookies     = {}
+    }
+  })
+}
+`
+}
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/cloudfrontfunction"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -54,6 +56,7 @@ func TestAccCloudFrontFunction_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "publish", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "runtime", "cloudfront-js-1.0"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "UNASSOCIATED"),
+					testAccCheckFunctionEvaluation(resourceName, testAccFunctionViewerRequestEvent, `{"response":{"statusCode":302,"statusDescription":"Found","headers":{"cloudfront-functions":{"value":"generated-by-CloudFront-Functions"},"location":{"value":"https://aws.amazon.com/cloudfront/"}}}}`),
 				),
 			},
 			{
@@ -66,6 +69,24 @@ func TestAccCloudFrontFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontFunction_invalidCode(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_invalidCode(rName),
+				ExpectError: regexache.MustCompile(`creating CloudFront Function`),
+			},
+		},
+	})
+}
+
 func TestAccCloudFrontFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf cloudfront.DescribeFunctionOutput
@@ -381,6 +402,48 @@ func testAccCheckFunctionExists(ctx context.Context, n string, v *cloudfront.Des
 	}
 }
 
+// testAccFunctionViewerRequestEvent is a viewer request event object for evaluating functions locally.
+const testAccFunctionViewerRequestEvent = `{
+  "version": "1.0",
+  "context": {"eventType": "viewer-request"},
+  "viewer": {"ip": "198.51.100.11"},
+  "request": {"method": "GET", "uri": "/index.html", "querystring": {}, "headers": {}, "cookies": {}}
+}`
+
+// testAccCheckFunctionEvaluation evaluates the function's code locally and checks the function's output.
+func testAccCheckFunctionEvaluation(n, event, expectedOutput string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		fn, err := cloudfrontfunction.Compile(rs.Primary.Attributes["runtime"], rs.Primary.Attributes["code"])
+
+		if err != nil {
+			return err
+		}
+
+		output, err := fn.Evaluate(context.Background(), &cloudfrontfunction.Input{
+			Event: event,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output.ErrorMessage != "" {
+			return fmt.Errorf("CloudFront Function %s error: %s", rs.Primary.ID, output.ErrorMessage)
+		}
+
+		if got, want := output.Output, expectedOutput; got != want {
+			return fmt.Errorf("CloudFront Function %s output = %s, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccFunctionConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_function" "test" {
@@ -403,6 +466,19 @@ EOT
 `, rName)
 }
 
+func testAccFunctionConfig_invalidCode(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-1.0"
+  code    = <<-EOT
+function handler(event) {
+	return event.request;
+EOT
+}
+`, rName)
+}
+
 func testAccFunctionConfig_publish(rName string, publish bool) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_function" "test" {
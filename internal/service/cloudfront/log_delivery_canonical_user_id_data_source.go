@@ -38,7 +38,7 @@ func dataSourceLogDeliveryCanonicalUserIDRead(ctx context.Context, d *schema.Res
 	var diags diag.Diagnostics
 	canonicalId := defaultLogDeliveryCanonicalUserID
 
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 	if v, ok := d.GetOk(names.AttrRegion); ok {
 		region = v.(string)
 	}
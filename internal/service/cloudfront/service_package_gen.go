@@ -56,6 +56,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudfront_function",
 			Name:     "Function",
 		},
+		{
+			Factory:  dataSourceFunctionEvaluation,
+			TypeName: "aws_cloudfront_function_evaluation",
+			Name:     "Function Evaluation",
+		},
 		{
 			Factory:  dataSourceLogDeliveryCanonicalUserID,
 			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
@@ -100,7 +100,7 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	mainRegion, err := regionFromARN(d.Get("global_table_arn").(string))
 	if err != nil {
@@ -166,7 +166,7 @@ func resourceTableReplicaCreate(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTableReplica, d.Get("global_table_arn").(string), err)
 	}
 
-	if _, err := waitReplicaActive(ctx, conn, tableName, meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutCreate), optFn); err != nil {
+	if _, err := waitReplicaActive(ctx, conn, tableName, meta.(*conns.AWSClient).EffectiveRegion(ctx), d.Timeout(schema.TimeoutCreate), optFn); err != nil {
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTableReplica, d.Get("global_table_arn").(string), err)
 	}
 
@@ -192,7 +192,7 @@ func resourceTableReplicaRead(ctx context.Context, d *schema.ResourceData, meta
 	diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	tableName, mainRegion, err := tableReplicaParseResourceID(d.Id())
 	if err != nil {
@@ -333,7 +333,7 @@ func resourceTableReplicaUpdate(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), err)
 	}
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	if mainRegion == replicaRegion {
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTableReplica, d.Id(), errors.New("replica cannot be in same region as main table"))
@@ -434,7 +434,7 @@ func resourceTableReplicaDelete(ctx context.Context, d *schema.ResourceData, met
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionDeleting, resNameTableReplica, d.Id(), err)
 	}
 
-	replicaRegion := meta.(*conns.AWSClient).Region
+	replicaRegion := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	// now main table region.
 	optFn := func(o *dynamodb.Options) {
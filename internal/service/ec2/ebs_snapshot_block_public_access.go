@@ -54,7 +54,7 @@ func resourceEBSSnapshotBlockPublicAccessPut(ctx context.Context, d *schema.Reso
 	}
 
 	if d.IsNewResource() {
-		d.SetId(meta.(*conns.AWSClient).Region)
+		d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	}
 
 	return append(diags, resourceEBSSnapshotBlockPublicAccessRead(ctx, d, meta)...)
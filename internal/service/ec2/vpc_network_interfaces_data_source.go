@@ -68,7 +68,7 @@ func dataSourceNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData
 		networkInterfaceIDs = append(networkInterfaceIDs, aws.ToString(v.NetworkInterfaceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrIDs, networkInterfaceIDs)
 
 	return diags
@@ -124,6 +124,41 @@ func TestAccVPCSecurityGroupIngressRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroupIngressRule_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_ingress_rule.test"
+	regionCtx := acctest.RegionContext(ctx, names.EC2, acctest.AlternateRegion())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupIngressRuleDestroy(regionCtx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupIngressRuleConfig_regionOverride(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupIngressRuleExists(regionCtx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARNRegion(resourceName, names.AttrARN, "ec2", acctest.AlternateRegion(), regexache.MustCompile(`security-group-rule/sgr-.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.RegionImportStateIDFunc(resourceName, acctest.AlternateRegion()),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroupIngressRule_tags_defaultAndIgnoreTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.SecurityGroupRule
@@ -674,6 +709,42 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 `)
 }
 
+func testAccVPCSecurityGroupIngressRuleConfig_regionOverride(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  region = %[2]q
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  region = %[2]q
+
+  vpc_id = aws_vpc.test.id
+  name   = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "test" {
+  region = %[2]q
+
+  security_group_id = aws_security_group.test.id
+
+  cidr_ipv4   = "10.0.0.0/8"
+  from_port   = 80
+  ip_protocol = "tcp"
+  to_port     = 8080
+}
+`, rName, acctest.AlternateRegion())
+}
+
 func testAccVPCSecurityGroupIngressRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
 resource "aws_vpc_security_group_ingress_rule" "test" {
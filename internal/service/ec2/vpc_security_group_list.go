@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_security_group", name="Security Group")
+func listSecurityGroups(ctx context.Context, meta any) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+		for v, err := range listAllSecurityGroups(ctx, conn) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			// Default security groups are managed by aws_default_security_group.
+			if aws.ToString(v.GroupName) == defaultSecurityGroupName {
+				continue
+			}
+
+			if !yield(map[string]string{names.AttrID: aws.ToString(v.GroupId)}, nil) {
+				return
+			}
+		}
+	}
+}
@@ -97,6 +97,39 @@ func TestAccVPC_disappears(t *testing.T) {
 // is left unused and resource tags (merged with local.tags) are only known at apply time,
 // with additional lifecycle ignore_changes attributes, thereby eliminating "Inconsistent final plan" errors
 // Reference: https://github.com/hashicorp/terraform-provider-aws/issues/18366
+func TestAccVPC_regionOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vpc awstypes.Vpc
+	resourceName := "aws_vpc.test"
+	regionCtx := acctest.RegionContext(ctx, names.EC2, acctest.AlternateRegion())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCDestroy(regionCtx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig_regionOverride(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckVPCExists(regionCtx, resourceName, &vpc),
+					acctest.MatchResourceAttrRegionalARNRegion(resourceName, names.AttrARN, "ec2", acctest.AlternateRegion(), regexache.MustCompile(`vpc/vpc-.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRegion, acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.RegionImportStateIDFunc(resourceName, acctest.AlternateRegion()),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccVPC_DynamicResourceTagsMergedWithLocals_ignoreChanges(t *testing.T) {
 	ctx := acctest.Context(t)
 	var vpc awstypes.Vpc
@@ -695,6 +728,16 @@ resource "aws_vpc" "test" {
 }
 `
 
+func testAccVPCConfig_regionOverride() string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  region = %[1]q
+
+  cidr_block = "10.1.0.0/16"
+}
+`, acctest.AlternateRegion())
+}
+
 func testAccVPCConfig_tags1(tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
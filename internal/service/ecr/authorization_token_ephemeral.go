@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ecr_authorization_token", name="Authorization Token")
+func newEphemeralAuthorizationToken(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+const (
+	ERNameAuthorizationToken = "Authorization Token Ephemeral Resource"
+)
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthorizationToken) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ecr_authorization_token"
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().ECRClient(ctx)
+
+	var data epAuthorizationTokenData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+
+	if err == nil && len(output.AuthorizationData) == 0 {
+		err = errors.New("empty result")
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionReading, ERNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	authBytes, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError("decoding ECR authorization token", err.Error())
+		return
+	}
+
+	basicAuthorization := strings.Split(string(authBytes), ":")
+	if len(basicAuthorization) != 2 {
+		response.Diagnostics.AddError("decoding ECR authorization token", "unknown ECR authorization token format")
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = timetypes.NewRFC3339TimePointerValue(authorizationData.ExpiresAt)
+	data.Password = types.StringValue(basicAuthorization[1])
+	data.ProxyEndpoint = fwflex.StringToFramework(ctx, authorizationData.ProxyEndpoint)
+	data.UserName = types.StringValue(basicAuthorization[0])
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
@@ -73,7 +73,7 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 
 	userName := basicAuthorization[0]
 	password := basicAuthorization[1]
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set("authorization_token", authorizationToken)
 	d.Set("expires_at", expiresAt)
 	d.Set(names.AttrUserName, userName)
@@ -140,7 +140,7 @@ func resourceAccountSettingDefaultImport(ctx context.Context, d *schema.Resource
 	d.Set(names.AttrName, d.Id())
 	d.SetId(arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Service:   names.ECSEndpointID,
 		Resource:  "cluster/" + d.Id(),
@@ -1633,7 +1633,7 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	d.SetId(name)
 	clusterArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		Service:   "ecs",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterAuthEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthEphemeralConfig_basic,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey(names.AttrName), knownvalue.StringExact("foobar")),
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+var testAccClusterAuthEphemeralConfig_basic = acctest.ConfigCompose(
+	acctest.ConfigWithEchoProvider("ephemeral.aws_eks_cluster_auth.test"),
+	`
+ephemeral "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+`)
@@ -15,7 +15,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_elb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elb", &resource.Sweeper{
 		Name: "aws_elb",
 		F:    sweepLoadBalancers,
 	})
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -480,6 +481,11 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJSONLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJSONLength, json))
 		}
+
+		// The local grammar may lag behind the service's, so report its findings as warnings.
+		if _, err := eventpattern.Parse(json); err != nil {
+			ws = append(ws, fmt.Sprintf("%q may not be a valid event pattern: %s", k, err))
+		}
 		return
 	}
 }
@@ -148,7 +148,7 @@ func resourceEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
 
 	input := &globalaccelerator.CreateEndpointGroupInput{
-		EndpointGroupRegion: aws.String(meta.(*conns.AWSClient).Region),
+		EndpointGroupRegion: aws.String(meta.(*conns.AWSClient).EffectiveRegion(ctx)),
 		IdempotencyToken:    aws.String(id.UniqueId()),
 		ListenerArn:         aws.String(d.Get("listener_arn").(string)),
 	}
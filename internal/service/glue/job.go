@@ -303,7 +303,7 @@ func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface
 	jobARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("job/%s", d.Id()),
 	}.String()
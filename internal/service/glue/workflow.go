@@ -129,7 +129,7 @@ func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta inte
 	workFlowArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "glue",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("workflow/%s", d.Id()),
 	}.String()
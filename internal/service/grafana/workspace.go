@@ -287,7 +287,7 @@ func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	workspaceARN := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "grafana",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("/workspaces/%s", d.Id()),
 	}.String()
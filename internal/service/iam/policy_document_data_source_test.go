@@ -114,6 +114,25 @@ func TestAccIAMPolicyDocumentDataSource_conditionWithBoolValue(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_conditionWithNumberAndBoolListValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentConfig_conditionWithNumberAndBoolListValues,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON("data.aws_iam_policy_document.test", names.AttrJSON,
+						testAccPolicyDocumentConditionWithNumberAndBoolListValuesExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_source(t *testing.T) {
 	// This really ought to be able to be a unit test rather than an
 	// acceptance test, but just instantiating the AWS provider requires
@@ -1497,6 +1516,62 @@ func testAccPolicyDocumentConditionWithBoolValueExpectedJSON() string {
   }`, acctest.Partition())
 }
 
+const testAccPolicyDocumentConfig_conditionWithNumberAndBoolListValues = `
+data "aws_iam_policy_document" "test" {
+  source_policy_documents = [<<EOF
+{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Sid": "ListWithLimits",
+            "Effect": "Allow",
+            "Action": "s3:ListBucket",
+            "Resource": "*",
+            "Condition": {
+                "Bool": {
+                    "aws:SecureTransport": [true]
+                },
+                "NumericLessThanEquals": {
+                    "s3:max-keys": 10
+                },
+                "NumericGreaterThan": {
+                    "aws:MultiFactorAuthAge": [3600, 7200.5]
+                }
+            }
+        }
+    ]
+}
+EOF
+  ]
+}
+`
+
+const testAccPolicyDocumentConditionWithNumberAndBoolListValuesExpectedJSON = `{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Sid": "ListWithLimits",
+            "Effect": "Allow",
+            "Action": "s3:ListBucket",
+            "Resource": "*",
+            "Condition": {
+                "Bool": {
+                    "aws:SecureTransport": "true"
+                },
+                "NumericLessThanEquals": {
+                    "s3:max-keys": "10"
+                },
+                "NumericGreaterThan": {
+                    "aws:MultiFactorAuthAge": [
+                        "3600",
+                        "7200.5"
+                    ]
+                }
+            }
+        }
+    ]
+}`
+
 func testAccPolicyDocumentExpectedJSONStatementPrincipalIdentifiersStringAndSlice() string {
 	return fmt.Sprintf(`{
   "Version": "2012-10-17",
@@ -0,0 +1,521 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// PolicyEvaluationDecision is the result of evaluating a request against a set of policies.
+// The values match the decisions returned by the IAM policy simulator.
+type PolicyEvaluationDecision string
+
+const (
+	PolicyEvaluationDecisionAllowed      PolicyEvaluationDecision = "allowed"
+	PolicyEvaluationDecisionExplicitDeny PolicyEvaluationDecision = "explicitDeny"
+	PolicyEvaluationDecisionImplicitDeny PolicyEvaluationDecision = "implicitDeny"
+)
+
+// PolicyEvaluationPolicyType is the type of policy that decided the result of an evaluation.
+type PolicyEvaluationPolicyType string
+
+const (
+	PolicyEvaluationPolicyTypeIdentity            PolicyEvaluationPolicyType = "identity"
+	PolicyEvaluationPolicyTypePermissionsBoundary PolicyEvaluationPolicyType = "permissions_boundary"
+	PolicyEvaluationPolicyTypeResource            PolicyEvaluationPolicyType = "resource"
+	PolicyEvaluationPolicyTypeServiceControl      PolicyEvaluationPolicyType = "service_control"
+)
+
+// PolicyEvaluationInput is a request and the policies that it is evaluated against.
+type PolicyEvaluationInput struct {
+	IdentityPolicies       []*IAMPolicyDoc
+	ResourcePolicies       []*IAMPolicyDoc
+	PermissionsBoundaries  []*IAMPolicyDoc
+	ServiceControlPolicies []*IAMPolicyDoc
+
+	// Action is the action being requested, for example "s3:GetObject".
+	Action string
+	// Resource is the ARN of the resource being accessed. Defaults to "*".
+	Resource string
+	// Principal is the ARN of the principal making the request.
+	// If empty, the Principal and NotPrincipal elements of statements are not evaluated.
+	Principal string
+	// Context contains the values of condition keys, for example "aws:SourceIp".
+	Context map[string][]string
+}
+
+// PolicyEvaluationResult is the result of an evaluation.
+type PolicyEvaluationResult struct {
+	Decision PolicyEvaluationDecision
+	// PolicyType is the type of the policy that decided the result.
+	// Empty if no policy applies to the request.
+	PolicyType PolicyEvaluationPolicyType
+	// MatchedStatementSid is the Sid of the statement that decided the result, if any.
+	MatchedStatementSid string
+}
+
+// Allowed returns whether the request is allowed.
+func (r *PolicyEvaluationResult) Allowed() bool {
+	return r.Decision == PolicyEvaluationDecisionAllowed
+}
+
+// EvaluatePolicies evaluates a request against identity policies, resource policies, permissions boundaries and service control policies
+// using the AWS policy evaluation logic for a request within a single account.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_evaluation-logic.html.
+// Policy variables are not substituted.
+func EvaluatePolicies(input *PolicyEvaluationInput) (*PolicyEvaluationResult, error) {
+	e := &policyEvaluator{
+		action:    input.Action,
+		resource:  input.Resource,
+		principal: input.Principal,
+		context:   make(map[string][]string, len(input.Context)),
+	}
+	if e.resource == "" {
+		e.resource = "*"
+	}
+	// Condition keys are case-insensitive.
+	for k, v := range input.Context {
+		e.context[strings.ToLower(k)] = v
+	}
+
+	policySets := []struct {
+		policyType PolicyEvaluationPolicyType
+		policies   []*IAMPolicyDoc
+	}{
+		{PolicyEvaluationPolicyTypeServiceControl, input.ServiceControlPolicies},
+		{PolicyEvaluationPolicyTypeResource, input.ResourcePolicies},
+		{PolicyEvaluationPolicyTypePermissionsBoundary, input.PermissionsBoundaries},
+		{PolicyEvaluationPolicyTypeIdentity, input.IdentityPolicies},
+	}
+
+	// An explicit deny in any policy overrides any allow.
+	for _, policySet := range policySets {
+		statement, err := e.matchingStatement(policySet.policies, "Deny")
+		if err != nil {
+			return nil, fmt.Errorf("evaluating %s policies: %w", policySet.policyType, err)
+		}
+
+		if statement != nil {
+			return &PolicyEvaluationResult{
+				Decision:            PolicyEvaluationDecisionExplicitDeny,
+				PolicyType:          policySet.policyType,
+				MatchedStatementSid: statement.Sid,
+			}, nil
+		}
+	}
+
+	allow := func(policyType PolicyEvaluationPolicyType, policies []*IAMPolicyDoc) (*IAMPolicyStatement, error) {
+		statement, err := e.matchingStatement(policies, "Allow")
+		if err != nil {
+			return nil, fmt.Errorf("evaluating %s policies: %w", policyType, err)
+		}
+
+		return statement, nil
+	}
+
+	// Service control policies must allow the request.
+	if len(input.ServiceControlPolicies) > 0 {
+		statement, err := allow(PolicyEvaluationPolicyTypeServiceControl, input.ServiceControlPolicies)
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			return &PolicyEvaluationResult{
+				Decision:   PolicyEvaluationDecisionImplicitDeny,
+				PolicyType: PolicyEvaluationPolicyTypeServiceControl,
+			}, nil
+		}
+	}
+
+	// Within an account, an allow in a resource policy is sufficient.
+	statement, err := allow(PolicyEvaluationPolicyTypeResource, input.ResourcePolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	if statement != nil {
+		return &PolicyEvaluationResult{
+			Decision:            PolicyEvaluationDecisionAllowed,
+			PolicyType:          PolicyEvaluationPolicyTypeResource,
+			MatchedStatementSid: statement.Sid,
+		}, nil
+	}
+
+	// Permissions boundaries must allow the request.
+	if len(input.PermissionsBoundaries) > 0 {
+		statement, err := allow(PolicyEvaluationPolicyTypePermissionsBoundary, input.PermissionsBoundaries)
+		if err != nil {
+			return nil, err
+		}
+
+		if statement == nil {
+			return &PolicyEvaluationResult{
+				Decision:   PolicyEvaluationDecisionImplicitDeny,
+				PolicyType: PolicyEvaluationPolicyTypePermissionsBoundary,
+			}, nil
+		}
+	}
+
+	statement, err = allow(PolicyEvaluationPolicyTypeIdentity, input.IdentityPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	if statement != nil {
+		return &PolicyEvaluationResult{
+			Decision:            PolicyEvaluationDecisionAllowed,
+			PolicyType:          PolicyEvaluationPolicyTypeIdentity,
+			MatchedStatementSid: statement.Sid,
+		}, nil
+	}
+
+	return &PolicyEvaluationResult{
+		Decision: PolicyEvaluationDecisionImplicitDeny,
+	}, nil
+}
+
+type policyEvaluator struct {
+	action    string
+	resource  string
+	principal string
+	context   map[string][]string
+}
+
+// matchingStatement returns the first statement with the specified effect that applies to the request.
+func (e *policyEvaluator) matchingStatement(policies []*IAMPolicyDoc, effect string) (*IAMPolicyStatement, error) {
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		for _, statement := range policy.Statements {
+			if statement == nil || statement.Effect != effect {
+				continue
+			}
+
+			ok, err := e.statementApplies(statement)
+			if err != nil {
+				if statement.Sid != "" {
+					return nil, fmt.Errorf("statement (%s): %w", statement.Sid, err)
+				}
+				return nil, err
+			}
+
+			if ok {
+				return statement, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (e *policyEvaluator) statementApplies(statement *IAMPolicyStatement) (bool, error) {
+	actionMatches := func(pattern string) bool {
+		return policyWildcardMatch(strings.ToLower(pattern), strings.ToLower(e.action))
+	}
+	if !policyElementMatches(statement.Actions, statement.NotActions, actionMatches) {
+		return false, nil
+	}
+
+	resourceMatches := func(pattern string) bool {
+		return pattern == "*" || policyWildcardMatch(pattern, e.resource)
+	}
+	if !policyElementMatches(statement.Resources, statement.NotResources, resourceMatches) {
+		return false, nil
+	}
+
+	if e.principal != "" {
+		if len(statement.Principals) > 0 && !e.principalSetMatches(statement.Principals) {
+			return false, nil
+		}
+		if len(statement.NotPrincipals) > 0 && e.principalSetMatches(statement.NotPrincipals) {
+			return false, nil
+		}
+	}
+
+	for _, condition := range statement.Conditions {
+		ok, err := e.conditionMatches(condition)
+		if err != nil {
+			return false, err
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// policyElementMatches returns whether an element (e.g. Action) or its negation (e.g. NotAction) matches.
+// A statement that has neither element matches.
+func policyElementMatches(element, notElement any, matches func(string) bool) bool {
+	if values := policyValues(element); len(values) > 0 {
+		for _, v := range values {
+			if matches(v) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, v := range policyValues(notElement) {
+		if matches(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *policyEvaluator) principalSetMatches(principals IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		for _, identifier := range policyValues(principal.Identifiers) {
+			switch principal.Type {
+			case "*":
+				return true
+			case "AWS":
+				if identifier == "*" || policyWildcardMatch(identifier, e.principal) {
+					return true
+				}
+				// An account ID or account root principal matches any principal in the account.
+				if v, err := arn.Parse(e.principal); err == nil {
+					if identifier == v.AccountID || identifier == fmt.Sprintf("arn:%s:iam::%s:root", v.Partition, v.AccountID) {
+						return true
+					}
+				}
+			default:
+				if identifier == e.principal {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+type policyConditionOperator struct {
+	// matches returns whether a request context value matches a value in the policy.
+	matches func(policyValue, contextValue string) bool
+	// negated is set for operators such as StringNotEquals.
+	negated bool
+}
+
+var policyConditionOperators = func() map[string]policyConditionOperator {
+	operators := make(map[string]policyConditionOperator)
+
+	add := func(name, negatedName string, matches func(policyValue, contextValue string) bool) {
+		operators[name] = policyConditionOperator{matches: matches}
+		if negatedName != "" {
+			operators[negatedName] = policyConditionOperator{matches: matches, negated: true}
+		}
+	}
+
+	add("StringEquals", "StringNotEquals", func(p, c string) bool { return p == c })
+	add("StringEqualsIgnoreCase", "StringNotEqualsIgnoreCase", strings.EqualFold)
+	add("StringLike", "StringNotLike", policyWildcardMatch)
+	add("ArnEquals", "ArnNotEquals", policyWildcardMatch)
+	add("ArnLike", "ArnNotLike", policyWildcardMatch)
+	add("BinaryEquals", "", func(p, c string) bool { return p == c })
+	add("Bool", "", strings.EqualFold)
+	add("IpAddress", "NotIpAddress", func(p, c string) bool {
+		addr, err := netip.ParseAddr(c)
+		if err != nil {
+			return false
+		}
+
+		if prefix, err := netip.ParsePrefix(p); err == nil {
+			return prefix.Contains(addr)
+		}
+
+		if v, err := netip.ParseAddr(p); err == nil {
+			return v == addr
+		}
+
+		return false
+	})
+
+	numeric := func(compare func(p, c float64) bool) func(string, string) bool {
+		return func(p, c string) bool {
+			pv, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return false
+			}
+
+			cv, err := strconv.ParseFloat(c, 64)
+			if err != nil {
+				return false
+			}
+
+			return compare(pv, cv)
+		}
+	}
+	add("NumericEquals", "NumericNotEquals", numeric(func(p, c float64) bool { return c == p }))
+	add("NumericLessThan", "", numeric(func(p, c float64) bool { return c < p }))
+	add("NumericLessThanEquals", "", numeric(func(p, c float64) bool { return c <= p }))
+	add("NumericGreaterThan", "", numeric(func(p, c float64) bool { return c > p }))
+	add("NumericGreaterThanEquals", "", numeric(func(p, c float64) bool { return c >= p }))
+
+	date := func(compare func(p, c time.Time) bool) func(string, string) bool {
+		return func(p, c string) bool {
+			pv, ok := parsePolicyDate(p)
+			if !ok {
+				return false
+			}
+
+			cv, ok := parsePolicyDate(c)
+			if !ok {
+				return false
+			}
+
+			return compare(pv, cv)
+		}
+	}
+	add("DateEquals", "DateNotEquals", date(func(p, c time.Time) bool { return c.Equal(p) }))
+	add("DateLessThan", "", date(func(p, c time.Time) bool { return c.Before(p) }))
+	add("DateLessThanEquals", "", date(func(p, c time.Time) bool { return !c.After(p) }))
+	add("DateGreaterThan", "", date(func(p, c time.Time) bool { return c.After(p) }))
+	add("DateGreaterThanEquals", "", date(func(p, c time.Time) bool { return !c.Before(p) }))
+
+	return operators
+}()
+
+// conditionMatches returns whether a condition is satisfied by the request context.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+func (e *policyEvaluator) conditionMatches(condition IAMPolicyStatementCondition) (bool, error) {
+	values := policyValues(condition.Values)
+	contextValues, present := e.context[strings.ToLower(condition.Variable)]
+
+	if condition.Test == "Null" {
+		for _, v := range values {
+			if strings.EqualFold(v, "true") != present {
+				return true, nil
+			}
+		}
+
+		return false, nil
+	}
+
+	test := condition.Test
+	forAllValues, forAnyValue := false, false
+	if v, ok := strings.CutPrefix(test, "ForAllValues:"); ok {
+		test, forAllValues = v, true
+	} else if v, ok := strings.CutPrefix(test, "ForAnyValue:"); ok {
+		test, forAnyValue = v, true
+	}
+	test, ifExists := strings.CutSuffix(test, "IfExists")
+
+	operator, ok := policyConditionOperators[test]
+	if !ok {
+		return false, fmt.Errorf("unsupported condition operator (%s)", condition.Test)
+	}
+
+	if !present {
+		return ifExists || forAllValues || (operator.negated && !forAnyValue), nil
+	}
+
+	satisfies := func(contextValue string) bool {
+		for _, policyValue := range values {
+			if operator.matches(policyValue, contextValue) {
+				return !operator.negated
+			}
+		}
+
+		return operator.negated
+	}
+
+	if forAllValues {
+		for _, v := range contextValues {
+			if !satisfies(v) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+
+	for _, v := range contextValues {
+		if satisfies(v) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parsePolicyDate parses a date condition value, either in ISO 8601 format or as seconds since the epoch.
+func parsePolicyDate(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(v, 0), true
+	}
+
+	return time.Time{}, false
+}
+
+// policyValues returns an element's values as a list of strings.
+func policyValues(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// policyWildcardMatch returns whether a value matches a pattern in which `*` matches any sequence of characters
+// and `?` matches any single character.
+func policyWildcardMatch(pattern, value string) bool {
+	p, v := []rune(pattern), []rune(value)
+	pi, vi := 0, 0
+	star, match := -1, 0
+
+	for vi < len(v) {
+		switch {
+		case pi < len(p) && (p[pi] == '?' || p[pi] == v[vi]):
+			pi++
+			vi++
+		case pi < len(p) && p[pi] == '*':
+			star, match = pi, vi
+			pi++
+		case star >= 0:
+			pi = star + 1
+			match++
+			vi = match
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '*' {
+		pi++
+	}
+
+	return pi == len(p)
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_policy_evaluation", name="Policy Evaluation")
+func dataSourcePolicyEvaluation() *schema.Resource {
+	policiesSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			names.AttrAction: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Name of the action to evaluate, like "s3:GetObject".`,
+			},
+			"context": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The key name of the context entry, such as "aws:SourceIp".`,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Description: `One or more values of the context key.`,
+						},
+					},
+				},
+				Description: `Each block specifies the values of a condition key used in the 'Condition' element of the policies.`,
+			},
+			"identity_policies_json":             policiesSchema(`Identity-based policies attached to the principal.`),
+			"permissions_boundary_policies_json": policiesSchema(`Permissions boundary policies of the principal.`),
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				Description:  `ARN of the principal making the request. If not specified, the Principal and NotPrincipal elements of policies are not evaluated.`,
+			},
+			names.AttrResourceARN: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: `ARN of the resource that the action is performed on.`,
+			},
+			"resource_policies_json":        policiesSchema(`Resource-based policies attached to the resource.`),
+			"service_control_policies_json": policiesSchema(`AWS Organizations service control policies that apply to the principal's account.`),
+
+			// Result Attributes
+			"allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Whether the request is allowed.`,
+			},
+			"decision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The decision: "allowed", "explicitDeny", or "implicitDeny".`,
+			},
+			"decision_policy_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of policy that decided the result: "identity", "permissions_boundary", "resource", or "service_control".`,
+			},
+			"matched_statement_sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The Sid of the statement that decided the result.`,
+			},
+		},
+	}
+}
+
+func dataSourcePolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	parsePolicies := func(k string) ([]*IAMPolicyDoc, error) {
+		var docs []*IAMPolicyDoc
+
+		for _, document := range flex.ExpandStringValueList(d.Get(k).([]interface{})) {
+			doc := &IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(document), doc); err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc)
+		}
+
+		return docs, nil
+	}
+
+	input := &PolicyEvaluationInput{
+		Action:    d.Get(names.AttrAction).(string),
+		Resource:  d.Get(names.AttrResourceARN).(string),
+		Principal: d.Get("principal_arn").(string),
+		Context:   make(map[string][]string),
+	}
+
+	for _, v := range []struct {
+		key      string
+		policies *[]*IAMPolicyDoc
+	}{
+		{"identity_policies_json", &input.IdentityPolicies},
+		{"permissions_boundary_policies_json", &input.PermissionsBoundaries},
+		{"resource_policies_json", &input.ResourcePolicies},
+		{"service_control_policies_json", &input.ServiceControlPolicies},
+	} {
+		policies, err := parsePolicies(v.key)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "parsing %s: %s", v.key, err)
+		}
+		*v.policies = policies
+	}
+
+	for _, tfMapRaw := range d.Get("context").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		k := tfMap[names.AttrKey].(string)
+		input.Context[k] = append(input.Context[k], flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))...)
+	}
+
+	result, err := EvaluatePolicies(input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "evaluating IAM policies: %s", err)
+	}
+
+	b, err := json.Marshal(input)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(string(b))))
+	d.Set("allowed", result.Allowed())
+	d.Set("decision", string(result.Decision))
+	d.Set("decision_policy_type", string(result.PolicyType))
+	d.Set("matched_statement_sid", result.MatchedStatementSid)
+
+	return diags
+}
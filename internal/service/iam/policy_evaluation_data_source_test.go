@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_evaluation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic("s3:GetObject", "10.1.2.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "allowed"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision_policy_type", "identity"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_sid", "Read"),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic("s3:GetObject", "192.168.0.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "implicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision_policy_type", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_sid", ""),
+				),
+			},
+			{
+				Config: testAccPolicyEvaluationDataSourceConfig_basic("s3:DeleteObject", "10.1.2.3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allowed", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "decision", "explicitDeny"),
+					resource.TestCheckResourceAttr(dataSourceName, "decision_policy_type", "service_control"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_statement_sid", "DenyDelete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPolicyEvaluationDataSourceConfig_basic(action, sourceIP string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "identity" {
+  statement {
+    sid       = "Read"
+    actions   = ["s3:Get*", "s3:DeleteObject"]
+    resources = ["arn:aws:s3:::example/*"]
+
+    condition {
+      test     = "IpAddress"
+      variable = "aws:SourceIp"
+      values   = ["10.0.0.0/8"]
+    }
+  }
+}
+
+data "aws_iam_policy_document" "scp" {
+  statement {
+    actions   = ["*"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "DenyDelete"
+    effect    = "Deny"
+    actions   = ["s3:DeleteObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_evaluation" "test" {
+  action       = %[1]q
+  resource_arn = "arn:aws:s3:::example/key"
+
+  identity_policies_json        = [data.aws_iam_policy_document.identity.json]
+  service_control_policies_json = [data.aws_iam_policy_document.scp.json]
+
+  context {
+    key    = "aws:SourceIp"
+    values = [%[2]q]
+  }
+}
+`, action, sourceIP)
+}
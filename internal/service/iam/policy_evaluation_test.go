@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		readObjects = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "ReadObjects",
+    "Effect": "Allow",
+    "Action": ["s3:Get*", "s3:List*"],
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`
+		denyDelete = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "DenyDelete",
+    "Effect": "Deny",
+    "Action": "s3:DeleteObject",
+    "Resource": "*"
+  }]
+}`
+		allowAll = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowAll",
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*"
+  }]
+}`
+		allowAllExceptIAM = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowAllExceptIAM",
+    "Effect": "Allow",
+    "NotAction": "iam:*",
+    "Resource": "*"
+  }]
+}`
+		bucketPolicy = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowRole",
+    "Effect": "Allow",
+    "Principal": {"AWS": "arn:aws:iam::123456789012:role/reader"},
+    "Action": "s3:GetObject",
+    "Resource": "arn:aws:s3:::example/*"
+  }]
+}`
+		conditions = `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Conditional",
+    "Effect": "Allow",
+    "Action": "ec2:*",
+    "Resource": "*",
+    "Condition": {
+      "IpAddress": {"aws:SourceIp": "10.0.0.0/8"},
+      "Bool": {"aws:SecureTransport": true},
+      "NumericLessThanEquals": {"aws:MultiFactorAuthAge": 3600},
+      "DateLessThan": {"aws:CurrentTime": "2030-01-01T00:00:00Z"},
+      "StringNotEqualsIfExists": {"ec2:InstanceType": "p4d.24xlarge"},
+      "ForAllValues:StringLike": {"aws:TagKeys": ["app-*", "env"]},
+      "Null": {"aws:PrincipalTag/team": false}
+    }
+  }]
+}`
+	)
+
+	validContext := map[string][]string{
+		"aws:SourceIp":           {"10.1.2.3"},
+		"aws:SecureTransport":    {"true"},
+		"aws:MultiFactorAuthAge": {"60"},
+		"aws:CurrentTime":        {"2025-06-01T12:00:00Z"},
+		"aws:TagKeys":            {"app-name", "env"},
+		"aws:PrincipalTag/team":  {"platform"},
+	}
+	withContext := func(k string, v []string) map[string][]string {
+		m := make(map[string][]string, len(validContext))
+		for k, v := range validContext {
+			m[k] = v
+		}
+		if v == nil {
+			delete(m, k)
+		} else {
+			m[k] = v
+		}
+		return m
+	}
+
+	testCases := []struct {
+		name                   string
+		identityPolicies       []string
+		resourcePolicies       []string
+		permissionsBoundaries  []string
+		serviceControlPolicies []string
+		action                 string
+		resource               string
+		principal              string
+		context                map[string][]string
+		wantDecision           tfiam.PolicyEvaluationDecision
+		wantPolicyType         tfiam.PolicyEvaluationPolicyType
+		wantSid                string
+		wantErr                bool
+	}{
+		{
+			name:         "no policies",
+			action:       "s3:GetObject",
+			wantDecision: tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "wildcard action allowed",
+			identityPolicies: []string{readObjects},
+			action:           "S3:GetObjectTagging",
+			resource:         "arn:aws:s3:::example/key",
+			wantDecision:     tfiam.PolicyEvaluationDecisionAllowed,
+			wantPolicyType:   tfiam.PolicyEvaluationPolicyTypeIdentity,
+			wantSid:          "ReadObjects",
+		},
+		{
+			name:             "resource not matched",
+			identityPolicies: []string{readObjects},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::other/key",
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "explicit deny",
+			identityPolicies: []string{allowAll, denyDelete},
+			action:           "s3:DeleteObject",
+			resource:         "arn:aws:s3:::example/key",
+			wantDecision:     tfiam.PolicyEvaluationDecisionExplicitDeny,
+			wantPolicyType:   tfiam.PolicyEvaluationPolicyTypeIdentity,
+			wantSid:          "DenyDelete",
+		},
+		{
+			name:             "NotAction",
+			identityPolicies: []string{allowAllExceptIAM},
+			action:           "iam:CreateUser",
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:                   "service control policy",
+			identityPolicies:       []string{allowAll},
+			serviceControlPolicies: []string{allowAllExceptIAM},
+			action:                 "iam:CreateUser",
+			wantDecision:           tfiam.PolicyEvaluationDecisionImplicitDeny,
+			wantPolicyType:         tfiam.PolicyEvaluationPolicyTypeServiceControl,
+		},
+		{
+			name:                  "permissions boundary",
+			identityPolicies:      []string{allowAll},
+			permissionsBoundaries: []string{readObjects},
+			action:                "s3:PutObject",
+			resource:              "arn:aws:s3:::example/key",
+			wantDecision:          tfiam.PolicyEvaluationDecisionImplicitDeny,
+			wantPolicyType:        tfiam.PolicyEvaluationPolicyTypePermissionsBoundary,
+		},
+		{
+			name:                  "permissions boundary allowed",
+			identityPolicies:      []string{allowAll},
+			permissionsBoundaries: []string{readObjects},
+			action:                "s3:GetObject",
+			resource:              "arn:aws:s3:::example/key",
+			wantDecision:          tfiam.PolicyEvaluationDecisionAllowed,
+			wantPolicyType:        tfiam.PolicyEvaluationPolicyTypeIdentity,
+			wantSid:               "AllowAll",
+		},
+		{
+			name:             "resource policy principal",
+			resourcePolicies: []string{bucketPolicy},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			principal:        "arn:aws:iam::123456789012:role/reader",
+			wantDecision:     tfiam.PolicyEvaluationDecisionAllowed,
+			wantPolicyType:   tfiam.PolicyEvaluationPolicyTypeResource,
+			wantSid:          "AllowRole",
+		},
+		{
+			name:             "resource policy other principal",
+			resourcePolicies: []string{bucketPolicy},
+			action:           "s3:GetObject",
+			resource:         "arn:aws:s3:::example/key",
+			principal:        "arn:aws:iam::123456789012:role/writer",
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "conditions satisfied",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          validContext,
+			wantDecision:     tfiam.PolicyEvaluationDecisionAllowed,
+			wantPolicyType:   tfiam.PolicyEvaluationPolicyTypeIdentity,
+			wantSid:          "Conditional",
+		},
+		{
+			name:             "condition IpAddress",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:SourceIp", []string{"192.168.0.1"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition Bool",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:SecureTransport", []string{"false"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition Numeric",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:MultiFactorAuthAge", []string{"7200"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition Date",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:CurrentTime", []string{"2031-01-01T00:00:00Z"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition IfExists",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("ec2:InstanceType", []string{"p4d.24xlarge"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition ForAllValues",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:TagKeys", []string{"app-name", "owner"}),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name:             "condition Null",
+			identityPolicies: []string{conditions},
+			action:           "ec2:RunInstances",
+			context:          withContext("aws:PrincipalTag/team", nil),
+			wantDecision:     tfiam.PolicyEvaluationDecisionImplicitDeny,
+		},
+		{
+			name: "unsupported condition operator",
+			identityPolicies: []string{`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "*",
+    "Resource": "*",
+    "Condition": {"StringSimilar": {"aws:username": "example"}}
+  }]
+}`},
+			action:  "s3:GetObject",
+			wantErr: true,
+		},
+	}
+
+	parse := func(t *testing.T, documents []string) []*tfiam.IAMPolicyDoc {
+		t.Helper()
+
+		var docs []*tfiam.IAMPolicyDoc
+		for _, document := range documents {
+			doc := &tfiam.IAMPolicyDoc{}
+			if err := json.Unmarshal([]byte(document), doc); err != nil {
+				t.Fatalf("parsing policy document: %s", err)
+			}
+			docs = append(docs, doc)
+		}
+		return docs
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfiam.EvaluatePolicies(&tfiam.PolicyEvaluationInput{
+				IdentityPolicies:       parse(t, testCase.identityPolicies),
+				ResourcePolicies:       parse(t, testCase.resourcePolicies),
+				PermissionsBoundaries:  parse(t, testCase.permissionsBoundaries),
+				ServiceControlPolicies: parse(t, testCase.serviceControlPolicies),
+				Action:                 testCase.action,
+				Resource:               testCase.resource,
+				Principal:              testCase.principal,
+				Context:                testCase.context,
+			})
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := got.Decision, testCase.wantDecision; got != want {
+				t.Errorf("Decision = %q, want %q", got, want)
+			}
+			if got, want := got.PolicyType, testCase.wantPolicyType; got != want {
+				t.Errorf("PolicyType = %q, want %q", got, want)
+			}
+			if got, want := got.MatchedStatementSid, testCase.wantSid; got != want {
+				t.Errorf("MatchedStatementSid = %q, want %q", got, want)
+			}
+		})
+	}
+}
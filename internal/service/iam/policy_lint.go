@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+)
+
+// PolicyFindingType is the type of a policy lint finding.
+// The values match the finding types returned by IAM Access Analyzer policy validation.
+type PolicyFindingType string
+
+const (
+	PolicyFindingTypeError           PolicyFindingType = "ERROR"
+	PolicyFindingTypeSecurityWarning PolicyFindingType = "SECURITY_WARNING"
+	PolicyFindingTypeSuggestion      PolicyFindingType = "SUGGESTION"
+	PolicyFindingTypeWarning         PolicyFindingType = "WARNING"
+)
+
+func (PolicyFindingType) Values() []PolicyFindingType {
+	return []PolicyFindingType{
+		PolicyFindingTypeError,
+		PolicyFindingTypeSecurityWarning,
+		PolicyFindingTypeSuggestion,
+		PolicyFindingTypeWarning,
+	}
+}
+
+// Policy lint issue codes, named after the equivalent IAM Access Analyzer policy checks.
+const (
+	PolicyIssueCodeAllowWithNotPrincipal               = "ALLOW_WITH_NOT_PRINCIPAL"
+	PolicyIssueCodeDuplicateSid                        = "DUPLICATE_SID"
+	PolicyIssueCodeInvalidAction                       = "INVALID_ACTION"
+	PolicyIssueCodeInvalidConditionKey                 = "INVALID_CONDITION_KEY"
+	PolicyIssueCodeInvalidConditionOperator            = "INVALID_CONDITION_OPERATOR"
+	PolicyIssueCodeInvalidEffect                       = "INVALID_EFFECT"
+	PolicyIssueCodeInvalidGlobalConditionKey           = "INVALID_GLOBAL_CONDITION_KEY"
+	PolicyIssueCodeInvalidVersion                      = "INVALID_VERSION"
+	PolicyIssueCodeMissingAction                       = "MISSING_ACTION"
+	PolicyIssueCodeMissingVersion                      = "MISSING_VERSION"
+	PolicyIssueCodePassRoleWithStarInActionAndResource = "PASS_ROLE_WITH_STAR_IN_ACTION_AND_RESOURCE"
+	PolicyIssueCodePassRoleWithStarInResource          = "PASS_ROLE_WITH_STAR_IN_RESOURCE"
+	PolicyIssueCodeRedundantAction                     = "REDUNDANT_ACTION"
+	PolicyIssueCodeRedundantStatement                  = "REDUNDANT_STATEMENT"
+)
+
+// PolicyIssueCodes returns the issue codes of all policy lint checks.
+func PolicyIssueCodes() []string {
+	return []string{
+		PolicyIssueCodeAllowWithNotPrincipal,
+		PolicyIssueCodeDuplicateSid,
+		PolicyIssueCodeInvalidAction,
+		PolicyIssueCodeInvalidConditionKey,
+		PolicyIssueCodeInvalidConditionOperator,
+		PolicyIssueCodeInvalidEffect,
+		PolicyIssueCodeInvalidGlobalConditionKey,
+		PolicyIssueCodeInvalidVersion,
+		PolicyIssueCodeMissingAction,
+		PolicyIssueCodeMissingVersion,
+		PolicyIssueCodePassRoleWithStarInActionAndResource,
+		PolicyIssueCodePassRoleWithStarInResource,
+		PolicyIssueCodeRedundantAction,
+		PolicyIssueCodeRedundantStatement,
+	}
+}
+
+// PolicyFinding is a single policy lint finding.
+type PolicyFinding struct {
+	FindingType PolicyFindingType
+	IssueCode   string
+	// StatementIndex is the index of the statement that the finding applies to, or -1 if the finding applies to the policy document.
+	StatementIndex int
+	// Sid is the Sid of the statement that the finding applies to, if any.
+	Sid     string
+	Message string
+}
+
+// Location returns a pointer to the part of the policy document that the finding applies to, for example `Statement[1] (Sid "PassRole")`.
+func (f PolicyFinding) Location() string {
+	switch {
+	case f.StatementIndex < 0:
+		return "policy"
+	case f.Sid == "":
+		return fmt.Sprintf("Statement[%d]", f.StatementIndex)
+	default:
+		return fmt.Sprintf("Statement[%d] (Sid %q)", f.StatementIndex, f.Sid)
+	}
+}
+
+func (f PolicyFinding) String() string {
+	return fmt.Sprintf("%s %s at %s: %s", f.FindingType, f.IssueCode, f.Location(), f.Message)
+}
+
+// policyGlobalConditionKeys are the AWS global condition keys, in lower case.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var policyGlobalConditionKeys = func() map[string]struct{} {
+	keys := make(map[string]struct{})
+	for _, k := range []string{
+		"aws:AssumedRoot",
+		"aws:CalledVia",
+		"aws:CalledViaFirst",
+		"aws:CalledViaLast",
+		"aws:CurrentTime",
+		"aws:Ec2InstanceSourcePrivateIPv4",
+		"aws:Ec2InstanceSourceVpc",
+		"aws:EpochTime",
+		"aws:FederatedProvider",
+		"aws:IsMcpServiceAction",
+		"aws:MultiFactorAuthAge",
+		"aws:MultiFactorAuthPresent",
+		"aws:PrincipalAccount",
+		"aws:PrincipalArn",
+		"aws:PrincipalIsAWSService",
+		"aws:PrincipalOrgID",
+		"aws:PrincipalOrgPaths",
+		"aws:PrincipalServiceName",
+		"aws:PrincipalServiceNamesList",
+		"aws:PrincipalType",
+		"aws:Referer",
+		"aws:RequestedRegion",
+		"aws:ResourceAccount",
+		"aws:ResourceOrgID",
+		"aws:ResourceOrgPaths",
+		"aws:SecureTransport",
+		"aws:SourceAccount",
+		"aws:SourceArn",
+		"aws:SourceIdentity",
+		"aws:SourceIp",
+		"aws:SourceOrgID",
+		"aws:SourceOrgPaths",
+		"aws:SourceOwner",
+		"aws:SourceVpc",
+		"aws:SourceVpcArn",
+		"aws:SourceVpce",
+		"aws:TagKeys",
+		"aws:TokenIssueTime",
+		"aws:UserAgent",
+		"aws:userid",
+		"aws:username",
+		"aws:ViaAWSService",
+		"aws:VpceAccount",
+		"aws:VpceOrgID",
+		"aws:VpceOrgPaths",
+		"aws:VpcSourceIp",
+	} {
+		keys[strings.ToLower(k)] = struct{}{}
+	}
+	return keys
+}()
+
+// policyGlobalConditionKeyPrefixes are the prefixes of AWS global condition keys that include a tag key, in lower case.
+var policyGlobalConditionKeyPrefixes = []string{
+	"aws:principaltag/",
+	"aws:requesttag/",
+	"aws:resourcetag/",
+}
+
+const (
+	policyVersion2008 = "2008-10-17"
+	policyVersion2012 = "2012-10-17"
+)
+
+var policyActionRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z*?]+$`)
+
+// LintPolicy checks an IAM policy document for common mistakes, in the manner of IAM Access Analyzer policy validation.
+// Only a subset of Access Analyzer's checks are implemented, and no AWS API calls are made.
+// An error is returned if the policy document cannot be parsed.
+func LintPolicy(policy string) ([]PolicyFinding, error) {
+	doc, err := parsePolicyLintDocument(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []PolicyFinding
+
+	switch doc.Version {
+	case "":
+		findings = append(findings, PolicyFinding{
+			FindingType:    PolicyFindingTypeWarning,
+			IssueCode:      PolicyIssueCodeMissingVersion,
+			StatementIndex: -1,
+			Message:        fmt.Sprintf("Specify Version %q; policies without a Version do not support policy variables", policyVersion2012),
+		})
+	case policyVersion2008, policyVersion2012:
+	default:
+		findings = append(findings, PolicyFinding{
+			FindingType:    PolicyFindingTypeError,
+			IssueCode:      PolicyIssueCodeInvalidVersion,
+			StatementIndex: -1,
+			Message:        fmt.Sprintf("Version must be %q or %q, got %q", policyVersion2012, policyVersion2008, doc.Version),
+		})
+	}
+
+	sids := make(map[string]int)
+	for i, statement := range doc.Statements {
+		if statement == nil {
+			continue
+		}
+
+		finding := func(findingType PolicyFindingType, issueCode, format string, a ...any) {
+			findings = append(findings, PolicyFinding{
+				FindingType:    findingType,
+				IssueCode:      issueCode,
+				StatementIndex: i,
+				Sid:            statement.Sid,
+				Message:        fmt.Sprintf(format, a...),
+			})
+		}
+
+		if statement.Sid != "" {
+			if j, ok := sids[statement.Sid]; ok {
+				finding(PolicyFindingTypeError, PolicyIssueCodeDuplicateSid, "Sid is also used by Statement[%d]; Sids must be unique within a policy", j)
+			} else {
+				sids[statement.Sid] = i
+			}
+		}
+
+		for j := range i {
+			if policyStatementsEquivalent(doc.Version, doc.Statements[j], statement) {
+				finding(PolicyFindingTypeSuggestion, PolicyIssueCodeRedundantStatement, "Statement is equivalent to Statement[%d] and can be removed", j)
+				break
+			}
+		}
+
+		if statement.Effect != "Allow" && statement.Effect != "Deny" {
+			finding(PolicyFindingTypeError, PolicyIssueCodeInvalidEffect, `Effect must be "Allow" or "Deny", got %q`, statement.Effect)
+		}
+
+		if statement.Effect == "Allow" && len(statement.NotPrincipals) > 0 {
+			finding(PolicyFindingTypeSecurityWarning, PolicyIssueCodeAllowWithNotPrincipal, `Using "Allow" with NotPrincipal grants access to every principal except those listed, including anonymous users; use Principal instead`)
+		}
+
+		actions := policyValues(statement.Actions)
+		if statement.Actions == nil && statement.NotActions == nil {
+			finding(PolicyFindingTypeError, PolicyIssueCodeMissingAction, "Statement must include an Action or NotAction element")
+		}
+
+		for _, action := range slices.Concat(actions, policyValues(statement.NotActions)) {
+			if action != "*" && !policyActionRegexp.MatchString(action) {
+				finding(PolicyFindingTypeError, PolicyIssueCodeInvalidAction, `Action %q must be "*" or of the form "service:action"`, action)
+			}
+		}
+
+		for j, action := range actions {
+			for k, other := range actions {
+				if j == k {
+					continue
+				}
+
+				// Report each duplicated action once.
+				if strings.EqualFold(action, other) {
+					if k < j {
+						finding(PolicyFindingTypeSuggestion, PolicyIssueCodeRedundantAction, "Action %q is listed more than once", action)
+						break
+					}
+					continue
+				}
+
+				if policyWildcardMatch(strings.ToLower(other), strings.ToLower(action)) {
+					finding(PolicyFindingTypeSuggestion, PolicyIssueCodeRedundantAction, "Action %q is already included by %q", action, other)
+					break
+				}
+			}
+		}
+
+		if statement.Effect == "Allow" && slices.Contains(policyValues(statement.Resources), "*") {
+			for _, action := range actions {
+				if strings.EqualFold(action, "iam:PassRole") {
+					finding(PolicyFindingTypeSecurityWarning, PolicyIssueCodePassRoleWithStarInResource, `Allowing "iam:PassRole" on Resource "*" allows any role to be passed to a service; specify role ARNs or add an "iam:PassedToService" condition`)
+					break
+				}
+				if policyWildcardMatch(strings.ToLower(action), "iam:passrole") {
+					finding(PolicyFindingTypeSecurityWarning, PolicyIssueCodePassRoleWithStarInActionAndResource, `Action %q includes "iam:PassRole" and, with Resource "*", allows any role to be passed to a service; specify role ARNs or add an "iam:PassedToService" condition`, action)
+					break
+				}
+			}
+		}
+
+		conditions := slices.Clone(statement.Conditions)
+		slices.SortFunc(conditions, func(a, b IAMPolicyStatementCondition) int {
+			if v := strings.Compare(a.Test, b.Test); v != 0 {
+				return v
+			}
+			return strings.Compare(a.Variable, b.Variable)
+		})
+
+		for _, condition := range conditions {
+			test := condition.Test
+			if v, ok := strings.CutPrefix(test, "ForAllValues:"); ok {
+				test = v
+			} else if v, ok := strings.CutPrefix(test, "ForAnyValue:"); ok {
+				test = v
+			}
+			test = strings.TrimSuffix(test, "IfExists")
+
+			if _, ok := policyConditionOperators[test]; !ok && test != "Null" {
+				finding(PolicyFindingTypeError, PolicyIssueCodeInvalidConditionOperator, "Condition operator %q is not valid", condition.Test)
+			}
+
+			key := strings.ToLower(condition.Variable)
+			prefix, _, ok := strings.Cut(key, ":")
+			switch {
+			case !ok || prefix == "":
+				finding(PolicyFindingTypeError, PolicyIssueCodeInvalidConditionKey, `Condition key %q must be of the form "service:key"`, condition.Variable)
+			case prefix == "aws":
+				if _, ok := policyGlobalConditionKeys[key]; ok {
+					continue
+				}
+				if slices.ContainsFunc(policyGlobalConditionKeyPrefixes, func(prefix string) bool {
+					return strings.HasPrefix(key, prefix) && len(key) > len(prefix)
+				}) {
+					continue
+				}
+				// New global condition keys are added over time, so an unrecognized key may be valid.
+				finding(PolicyFindingTypeWarning, PolicyIssueCodeInvalidGlobalConditionKey, "Condition key %q is not a known AWS global condition key", condition.Variable)
+			}
+		}
+	}
+
+	return findings, nil
+}
+
+// parsePolicyLintDocument parses a policy document, whose Statement element can be a single statement or a list of statements.
+func parsePolicyLintDocument(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("parsing policy document: %w", err)
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	if statement := bytes.TrimSpace(raw.Statement); len(statement) > 0 && statement[0] == '{' {
+		v := &IAMPolicyStatement{}
+		if err := json.Unmarshal(statement, v); err != nil {
+			return nil, fmt.Errorf("parsing policy document: %w", err)
+		}
+		doc.Statements = []*IAMPolicyStatement{v}
+	} else if len(statement) > 0 {
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return nil, fmt.Errorf("parsing policy document: %w", err)
+		}
+	}
+
+	return doc, nil
+}
+
+// policyStatementsEquivalent returns whether two statements are equivalent, ignoring their Sids.
+func policyStatementsEquivalent(version string, s1, s2 *IAMPolicyStatement) bool {
+	if s1 == nil || s2 == nil {
+		return false
+	}
+
+	policy := func(s *IAMPolicyStatement) (string, bool) {
+		s = &IAMPolicyStatement{
+			Effect:        s.Effect,
+			Actions:       s.Actions,
+			NotActions:    s.NotActions,
+			Resources:     s.Resources,
+			NotResources:  s.NotResources,
+			Principals:    s.Principals,
+			NotPrincipals: s.NotPrincipals,
+			Conditions:    s.Conditions,
+		}
+		b, err := json.Marshal(&IAMPolicyDoc{Version: version, Statements: []*IAMPolicyStatement{s}})
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+
+	p1, ok := policy(s1)
+	if !ok {
+		return false
+	}
+	p2, ok := policy(s2)
+	if !ok {
+		return false
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(p1, p2)
+
+	return err == nil && equivalent
+}
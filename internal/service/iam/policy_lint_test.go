@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+func TestLintPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy  string
+		want    []string
+		wantErr bool
+	}{
+		"no findings": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "PassRole",
+    "Effect": "Allow",
+    "Action": "iam:PassRole",
+    "Resource": "arn:aws:iam::123456789012:role/example",
+    "Condition": {"StringEquals": {"iam:PassedToService": "ec2.amazonaws.com", "aws:RequestedRegion": "us-west-2"}}
+  }]
+}`,
+		},
+		"single statement": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": {"Effect": "Allow", "Action": "iam:PassRole", "Resource": "*"}
+}`,
+			want: []string{
+				`SECURITY_WARNING PASS_ROLE_WITH_STAR_IN_RESOURCE at Statement[0]: Allowing "iam:PassRole" on Resource "*" allows any role to be passed to a service; specify role ARNs or add an "iam:PassedToService" condition`,
+			},
+		},
+		"trust policy": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"Service": "lambda.amazonaws.com"},
+    "Action": "sts:AssumeRole",
+    "Condition": {"StringEquals": {"aws:SourceAccount": "123456789012"}}
+  }]
+}`,
+		},
+		"pass role with wildcard action": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Sid": "Admin", "Effect": "Allow", "Action": "iam:*", "Resource": "*"}]
+}`,
+			want: []string{
+				`SECURITY_WARNING PASS_ROLE_WITH_STAR_IN_ACTION_AND_RESOURCE at Statement[0] (Sid "Admin"): Action "iam:*" includes "iam:PassRole" and, with Resource "*", allows any role to be passed to a service; specify role ARNs or add an "iam:PassedToService" condition`,
+			},
+		},
+		"pass role denied": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Deny", "Action": "iam:PassRole", "Resource": "*"}]
+}`,
+		},
+		"redundant statement": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "One", "Effect": "Allow", "Action": ["s3:GetObject", "s3:PutObject"], "Resource": "arn:aws:s3:::example/*"},
+    {"Sid": "Two", "Effect": "Allow", "Action": ["s3:PutObject", "s3:GetObject"], "Resource": ["arn:aws:s3:::example/*"]}
+  ]
+}`,
+			want: []string{
+				`SUGGESTION REDUNDANT_STATEMENT at Statement[1] (Sid "Two"): Statement is equivalent to Statement[0] and can be removed`,
+			},
+		},
+		"redundant actions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["s3:Get*", "s3:GetObject", "s3:ListBucket", "S3:LISTBUCKET"], "Resource": "*"}]
+}`,
+			want: []string{
+				`SUGGESTION REDUNDANT_ACTION at Statement[0]: Action "s3:GetObject" is already included by "s3:Get*"`,
+				`SUGGESTION REDUNDANT_ACTION at Statement[0]: Action "S3:LISTBUCKET" is listed more than once`,
+			},
+		},
+		"duplicate Sid": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "Read", "Effect": "Allow", "Action": "s3:ListBucket", "Resource": "*"}
+  ]
+}`,
+			want: []string{
+				`ERROR DUPLICATE_SID at Statement[1] (Sid "Read"): Sid is also used by Statement[0]; Sids must be unique within a policy`,
+			},
+		},
+		"invalid conditions": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": "*",
+    "Condition": {
+      "StringEqual": {"s3:prefix": "home/"},
+      "ForAnyValue:StringLikeIfExists": {"aws:RequestTag/Project": "a*"},
+      "Bool": {"aws:SecureTransports": "true", "secure": "true"}
+    }
+  }]
+}`,
+			want: []string{
+				`WARNING INVALID_GLOBAL_CONDITION_KEY at Statement[0]: Condition key "aws:SecureTransports" is not a known AWS global condition key`,
+				`ERROR INVALID_CONDITION_KEY at Statement[0]: Condition key "secure" must be of the form "service:key"`,
+				`ERROR INVALID_CONDITION_OPERATOR at Statement[0]: Condition operator "StringEqual" is not valid`,
+			},
+		},
+		"global condition keys": {
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Deny",
+    "Action": "s3:*",
+    "Resource": "*",
+    "Condition": {
+      "Bool": {"aws:AssumedRoot": "true"},
+      "StringEquals": {"aws:ResourceTag/Project": "a", "aws:PrincipalOrgID": "o-a1b2c3d4e5"}
+    }
+  }]
+}`,
+		},
+		"invalid statement elements": {
+			policy: `{
+  "Statement": [
+    {"Effect": "allow", "Action": "s3 GetObject", "Resource": "*"},
+    {"Effect": "Allow", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Resource": "*"}
+  ]
+}`,
+			want: []string{
+				`WARNING MISSING_VERSION at policy: Specify Version "2012-10-17"; policies without a Version do not support policy variables`,
+				`ERROR INVALID_EFFECT at Statement[0]: Effect must be "Allow" or "Deny", got "allow"`,
+				`ERROR INVALID_ACTION at Statement[0]: Action "s3 GetObject" must be "*" or of the form "service:action"`,
+				`SECURITY_WARNING ALLOW_WITH_NOT_PRINCIPAL at Statement[1]: Using "Allow" with NotPrincipal grants access to every principal except those listed, including anonymous users; use Principal instead`,
+				`ERROR MISSING_ACTION at Statement[1]: Statement must include an Action or NotAction element`,
+			},
+		},
+		"invalid version": {
+			policy: `{"Version": "2012-10-18", "Statement": []}`,
+			want: []string{
+				`ERROR INVALID_VERSION at policy: Version must be "2012-10-17" or "2008-10-17", got "2012-10-18"`,
+			},
+		},
+		"invalid JSON": {
+			policy:  `{"Version": `,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, err := tfiam.LintPolicy(testCase.policy)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("LintPolicy err = %v, wantErr %t", err, want)
+			}
+
+			var got []string
+			for _, finding := range findings {
+				got = append(got, finding.String())
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -220,10 +220,21 @@ func (cs *IAMPolicyStatementConditionSet) UnmarshalJSON(b []byte) error {
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: []string{var_values}})
 			case bool:
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatBool(var_values)})
+			case float64:
+				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: strconv.FormatFloat(var_values, 'f', -1, 64)})
 			case []interface{}:
 				values := []string{}
 				for _, v := range var_values {
-					values = append(values, v.(string))
+					switch v := v.(type) {
+					case string:
+						values = append(values, v)
+					case bool:
+						values = append(values, strconv.FormatBool(v))
+					case float64:
+						values = append(values, strconv.FormatFloat(v, 'f', -1, 64))
+					default:
+						return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementConditionSet.Values", v)
+					}
 				}
 				out = append(out, IAMPolicyStatementCondition{Test: test_key, Variable: var_key, Values: values})
 			}
@@ -428,3 +428,28 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyStatementConditionSet_UnmarshalJSON(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	var got tfiam.IAMPolicyStatementConditionSet
+	err := json.Unmarshal([]byte(`{"NumericLessThan": {"s3:max-keys": 10}, "NumericGreaterThan": {"aws:MultiFactorAuthAge": [3600, 7200.5]}, "Bool": {"aws:SecureTransport": [false]}}`), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]tfiam.IAMPolicyStatementCondition{
+		"NumericLessThan":    {Test: "NumericLessThan", Variable: "s3:max-keys", Values: "10"},
+		"NumericGreaterThan": {Test: "NumericGreaterThan", Variable: "aws:MultiFactorAuthAge", Values: []string{"3600", "7200.5"}},
+		"Bool":               {Test: "Bool", Variable: "aws:SecureTransport", Values: []string{"false"}},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d conditions, want %d", len(got), len(want))
+	}
+	for _, c := range got {
+		if !reflect.DeepEqual(c, want[c.Test]) {
+			t.Errorf("got %#v, want %#v", c, want[c.Test])
+		}
+	}
+}
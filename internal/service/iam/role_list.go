@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"iter"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_iam_role", name="Role")
+func listRoles(ctx context.Context, meta any) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+		for v, err := range listAllRoles(ctx, conn) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			// Service-linked roles are managed by aws_iam_service_linked_role.
+			if strings.HasPrefix(aws.ToString(v.Path), "/aws-service-role/") {
+				continue
+			}
+
+			if !yield(map[string]string{names.AttrName: aws.ToString(v.RoleName)}, nil) {
+				return
+			}
+		}
+	}
+}
@@ -27,6 +27,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listRoles,
+			TypeName: "aws_iam_role",
+			Name:     "Role",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -71,6 +81,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_iam_policy_document",
 			Name:     "Policy Document",
 		},
+		{
+			Factory:  dataSourcePolicyEvaluation,
+			TypeName: "aws_iam_policy_evaluation",
+			Name:     "Policy Evaluation",
+		},
 		{
 			Factory:  dataSourcePrincipalPolicySimulation,
 			TypeName: "aws_iam_principal_policy_simulation",
@@ -198,6 +213,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 				ResourceType:        "Role",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
 		},
 		{
 			Factory:  resourceRolePolicy,
@@ -208,6 +226,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceRolePolicyAttachment,
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes:  []string{names.AttrRole, "policy_arn"},
+				IDSeparator: "/",
+			},
 		},
 		{
 			Factory:  resourceSAMLProvider,
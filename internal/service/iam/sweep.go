@@ -6,6 +6,7 @@ package iam
 import (
 	"context"
 	"fmt"
+	"iter"
 	"log"
 	"strings"
 	"time"
@@ -30,7 +31,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -44,7 +45,7 @@ func RegisterSweepers() {
 
 	awsv1.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -56,7 +57,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -93,14 +94,14 @@ func RegisterSweepers() {
 
 	awsv1.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -430,9 +431,7 @@ func sweepRoles(region string) error {
 	conn := client.IAMClient(ctx)
 
 	roles := make([]string, 0)
-	pages := iam.NewListRolesPaginator(conn, &iam.ListRolesInput{})
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+	for role, err := range listAllRoles(ctx, conn) {
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
 			return nil
@@ -442,13 +441,11 @@ func sweepRoles(region string) error {
 			return fmt.Errorf("retrieving IAM Roles: %w", err)
 		}
 
-		for _, role := range page.Roles {
-			roleName := aws.ToString(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
-			}
+		roleName := aws.ToString(role.RoleName)
+		if roleNameFilter(roleName) {
+			roles = append(roles, roleName)
+		} else {
+			log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
 		}
 	}
 
@@ -479,6 +476,30 @@ func sweepRoles(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+// listAllRoles yields every IAM Role in the account.
+// It is shared by the sweeper and the aws_iam_role list resource.
+func listAllRoles(ctx context.Context, conn *iam.Client) iter.Seq2[awstypes.Role, error] {
+	return func(yield func(awstypes.Role, error) bool) {
+		input := &iam.ListRolesInput{}
+
+		pages := iam.NewListRolesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(awstypes.Role{}, err)
+				return
+			}
+
+			for _, v := range page.Roles {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func sweepSAMLProvider(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
 	conn := client.IAMClient(ctx)
 
@@ -86,7 +86,7 @@ func dataSourceContainerRecipesRead(ctx context.Context, d *schema.ResourceData,
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set(names.AttrNames, nms)
 
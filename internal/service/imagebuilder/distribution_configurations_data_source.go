@@ -76,7 +76,7 @@ func dataSourceDistributionConfigurationsRead(ctx context.Context, d *schema.Res
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set(names.AttrNames, nms)
 
@@ -76,7 +76,7 @@ func dataSourceImagePipelinesRead(ctx context.Context, d *schema.ResourceData, m
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set(names.AttrNames, nms)
 
@@ -86,7 +86,7 @@ func dataSourceImageRecipesRead(ctx context.Context, d *schema.ResourceData, met
 		nms = append(nms, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set(names.AttrNames, nms)
 
@@ -42,7 +42,7 @@ func dataSourceRulesPackagesRead(ctx context.Context, d *schema.ResourceData, me
 	arns := output
 	sort.Strings(arns)
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 
 	return diags
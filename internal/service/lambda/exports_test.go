@@ -17,6 +17,7 @@ var (
 	ResourcePermission                   = resourcePermission
 	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
 
+	BuildFunctionSourcePackage                   = buildFunctionSourcePackage
 	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
 	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
 	FindEventSourceMappingByID                   = findEventSourceMappingByID
@@ -30,6 +31,8 @@ var (
 	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
 	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
 	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
+	FunctionSourceCodeHash                       = functionSourceCodeHash
+	FunctionSourcePackageHash                    = functionSourcePackageHash
 	GetFunctionNameFromARN                       = getFunctionNameFromARN
 	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
@@ -41,6 +41,7 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @Identity("function_name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
 func resourceFunction() *schema.Resource {
@@ -173,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -212,7 +213,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -326,7 +327,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -337,7 +338,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", names.AttrSource},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -370,6 +371,38 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			names.AttrSource: {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ExactlyOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, names.AttrSource},
+				ConflictsWith: []string{"source_code_hash"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidPathPattern,
+							},
+						},
+						names.AttrPath: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						names.AttrS3Bucket: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrS3KeyPrefix: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"source_code_hash": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -457,6 +490,7 @@ func resourceFunction() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			setSourceCodeHashFromSource,
 			checkHandlerRuntimeForZipFunction,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
@@ -497,6 +531,20 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk(names.AttrSource); ok {
+		// Grab an exclusive lock so that we're only reading one function into memory at a time.
+		// See https://github.com/hashicorp/terraform/issues/9364.
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, cleanup, err := expandFunctionSource(v.([]interface{})).functionCode(ctx, meta, functionName, d.Get("source_code_hash").(string))
+		defer cleanup()
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) source package: %s", functionName, err)
+		}
+
+		input.Code = code
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -676,7 +724,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if output.Code != nil {
 		d.Set("image_uri", output.Code.ImageUri)
 	}
-	d.Set("invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
+	d.Set("invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
 	d.Set(names.AttrKMSKeyARN, function.KMSKeyArn)
 	d.Set("last_modified", function.LastModified)
 	if err := d.Set("layers", flattenLayers(function.Layers)); err != nil {
@@ -701,7 +749,12 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
 	}
-	d.Set("source_code_hash", d.Get("source_code_hash"))
+	if _, ok := d.GetOk(names.AttrSource); ok {
+		// Detect changes to code deployed from a source directory.
+		d.Set("source_code_hash", function.CodeSha256)
+	} else {
+		d.Set("source_code_hash", d.Get("source_code_hash"))
+	}
 	d.Set("source_code_size", function.CodeSize)
 	d.Set(names.AttrTimeout, function.Timeout)
 	tracingConfigMode := awstypes.TracingModePassThrough
@@ -721,7 +774,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
-		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), functionARN))
+		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
 		d.Set(names.AttrVersion, function.Version)
 	} else {
 		latest, err := findLatestFunctionVersionByName(ctx, conn, d.Id())
@@ -732,7 +785,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 		qualifiedARN := aws.ToString(latest.FunctionArn)
 		d.Set("qualified_arn", qualifiedARN)
-		d.Set("qualified_invoke_arn", invokeARN(meta.(*conns.AWSClient), qualifiedARN))
+		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), qualifiedARN))
 		d.Set(names.AttrVersion, latest.Version)
 
 		setTagsOut(ctx, output.Tags)
@@ -743,7 +796,7 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	// in AWS GovCloud (US)) so we cannot just ignore the error as would typically.
 	// Currently this functionality is not enabled in all Regions and returns ambiguous error codes
 	// (e.g. AccessDeniedException), so we cannot just ignore the error as we would typically.
-	if partition, region := meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region; partition == names.StandardPartitionID && signerServiceIsAvailable(region) {
+	if partition, region := meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).EffectiveRegion(ctx); partition == names.StandardPartitionID && signerServiceIsAvailable(region) {
 		var codeSigningConfigARN string
 
 		// Code Signing is only supported on zip packaged lambda functions.
@@ -963,6 +1016,26 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk(names.AttrSource); ok {
+			// Grab an exclusive lock so that we're only reading one function into memory at a time.
+			// See https://github.com/hashicorp/terraform/issues/9364
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, cleanup, err := expandFunctionSource(v.([]interface{})).functionCode(ctx, meta, d.Id(), d.Get("source_code_hash").(string))
+			defer cleanup()
+
+			if err != nil {
+				// As source_code_hash is only refreshed in resourceFunctionRead(), don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+
+				return sdkdiag.AppendErrorf(diags, "building Lambda Function (%s) source package: %s", d.Id(), err)
+			}
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.ZipFile = code.ZipFile
 		} else {
 			input.S3Bucket = aws.String(d.Get(names.AttrS3Bucket).(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -982,6 +1055,11 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				}
 			}
 
+			if _, ok := d.GetOk(names.AttrSource); ok {
+				old, _ := d.GetChange("source_code_hash")
+				d.Set("source_code_hash", old)
+			}
+
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
 
@@ -1383,11 +1461,11 @@ func needsFunctionConfigUpdate(d sdkv2.ResourceDiffer) bool {
 }
 
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/set-up-lambda-custom-integrations.html.
-func invokeARN(c *conns.AWSClient, functionOrAliasARN string) string {
+func invokeARN(ctx context.Context, c *conns.AWSClient, functionOrAliasARN string) string {
 	return arn.ARN{
 		Partition: c.Partition,
 		Service:   "apigateway",
-		Region:    c.Region,
+		Region:    c.EffectiveRegion(ctx),
 		AccountID: "lambda",
 		Resource:  fmt.Sprintf("path/2015-03-31/functions/%s/invocations", functionOrAliasARN),
 	}.String()
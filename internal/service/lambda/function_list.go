@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @ListResource("aws_lambda_function", name="Function")
+func listFunctions(ctx context.Context, meta any) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+		for v, err := range listAllFunctions(ctx, conn) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(map[string]string{"function_name": aws.ToString(v.FunctionName)}, nil) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	// The maximum size of a .zip file deployment package uploaded directly using the Lambda API.
+	functionZipFileMaxSize = 50_000_000
+	// The mode of every file in a source package.
+	// Files are executable so that custom runtime bootstrap files and binaries can be run.
+	functionSourcePackageFileMode os.FileMode = 0755
+)
+
+// functionSourcePackageModified is the modification time of every file in a source package.
+// It's the earliest time that can be represented in a .zip file.
+var functionSourcePackageModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// functionSource is the configuration of a deployment package built from a source directory.
+type functionSource struct {
+	exclude     []string
+	path        string
+	s3Bucket    string
+	s3KeyPrefix string
+}
+
+func expandFunctionSource(tfList []interface{}) *functionSource {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &functionSource{
+		exclude:     flex.ExpandStringValueSet(tfMap["exclude"].(*schema.Set)),
+		path:        tfMap[names.AttrPath].(string),
+		s3Bucket:    tfMap[names.AttrS3Bucket].(string),
+		s3KeyPrefix: tfMap[names.AttrS3KeyPrefix].(string),
+	}
+}
+
+// buildFunctionSourcePackage returns a .zip file deployment package containing the files in the source directory
+// that match no exclude pattern.
+// The package is reproducible: its files are sorted by name, have a fixed modification time and mode and are stored uncompressed,
+// so the same source files produce the same package on any machine and with any version of the compressor.
+func buildFunctionSourcePackage(path string, exclude []string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := writeFunctionSourcePackage(&buf, path, exclude); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// functionSourcePackageHash returns the hash of the package that buildFunctionSourcePackage would build.
+// The package is streamed through the hash rather than held in memory.
+func functionSourcePackageHash(path string, exclude []string) (string, error) {
+	hash := sha256.New()
+
+	if err := writeFunctionSourcePackage(hash, path, exclude); err != nil {
+		return "", err
+	}
+
+	return itypes.Base64Encode(hash.Sum(nil)), nil
+}
+
+func writeFunctionSourcePackage(dst io.Writer, path string, exclude []string) error {
+	root, err := homedir.Expand(path)
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source path (%s): %w", path, err)
+	}
+
+	files, err := tfio.FindFiles(root, nil, exclude)
+	if err != nil {
+		return fmt.Errorf("reading source directory (%s): %w", root, err)
+	}
+
+	if len(files) == 0 {
+		return fmt.Errorf("source directory (%s) contains no files", root)
+	}
+
+	w := zip.NewWriter(dst)
+
+	for _, v := range files {
+		header := &zip.FileHeader{
+			Name:     v.Name,
+			Method:   zip.Store,
+			Modified: functionSourcePackageModified,
+		}
+		header.SetMode(functionSourcePackageFileMode)
+
+		if err := addFileToPackage(w, header, v.Path); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
+func addFileToPackage(w *zip.Writer, header *zip.FileHeader, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	fw, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(fw, file); err != nil {
+		return fmt.Errorf("adding %s to package: %w", path, err)
+	}
+
+	return nil
+}
+
+// functionCode returns the function code for the package built from the source directory.
+// If the planned source code hash is known, the package must match it.
+// Packages larger than can be uploaded directly are first uploaded to the source's S3 bucket.
+// The returned function removes any such S3 object once Lambda has copied it.
+func (s *functionSource) functionCode(ctx context.Context, meta interface{}, functionName, sourceCodeHash string) (*awstypes.FunctionCode, func(), error) {
+	cleanup := func() {}
+
+	zipFile, err := buildFunctionSourcePackage(s.path, s.exclude)
+	if err != nil {
+		return nil, cleanup, err
+	}
+
+	// The package must be the one whose hash was planned.
+	// The hash is unknown at plan time if the source directory didn't yet exist, e.g. if it's created by another resource.
+	if hash := functionSourceCodeHash(zipFile); sourceCodeHash != "" && hash != sourceCodeHash {
+		return nil, cleanup, fmt.Errorf("source directory (%s) changed after plan: source_code_hash %s, expected %s", s.path, hash, sourceCodeHash)
+	}
+
+	if len(zipFile) <= functionZipFileMaxSize {
+		return &awstypes.FunctionCode{ZipFile: zipFile}, cleanup, nil
+	}
+
+	if s.s3Bucket == "" {
+		return nil, cleanup, fmt.Errorf("source package size (%d bytes) exceeds the maximum size for direct upload (%d bytes), source.s3_bucket must be set", len(zipFile), functionZipFileMaxSize)
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	hash := sha256.Sum256(zipFile)
+	key := fmt.Sprintf("%s%s/%s.zip", s.s3KeyPrefix, functionName, hex.EncodeToString(hash[:]))
+
+	_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(zipFile),
+		Bucket: aws.String(s.s3Bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("uploading source package to S3 Bucket (%s) key (%s): %w", s.s3Bucket, key, err)
+	}
+
+	cleanup = func() {
+		_, err := conn.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(s.s3Bucket),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			log.Printf("[WARN] Deleting Lambda Function (%s) source package from S3 Bucket (%s) key (%s): %s", functionName, s.s3Bucket, key, err)
+		}
+	}
+
+	return &awstypes.FunctionCode{
+		S3Bucket: aws.String(s.s3Bucket),
+		S3Key:    aws.String(key),
+	}, cleanup, nil
+}
+
+// functionSourceCodeHash returns the base64-encoded SHA-256 hash of a deployment package, as reported by Lambda's CodeSha256.
+func functionSourceCodeHash(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+
+	return itypes.Base64Encode(hash[:])
+}
+
+// setSourceCodeHashFromSource plans source_code_hash as the hash of the package built from the source directory.
+// The package itself is only built at apply time.
+// If the source directory doesn't exist yet, source_code_hash is unknown and any error is reported at apply time.
+func setSourceCodeHashFromSource(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source.0.exclude", "source.0.path"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	source := expandFunctionSource(d.Get(names.AttrSource).([]interface{}))
+
+	if source == nil {
+		return nil
+	}
+
+	hash, err := functionSourcePackageHash(source.path, source.exclude)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	if err != nil {
+		return fmt.Errorf("hashing Lambda Function source package: %w", err)
+	}
+
+	if d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+)
+
+func TestBuildFunctionSourcePackage(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":          "exports.handler = async () => {};",
+		"lib/util.js":       "module.exports = {};",
+		"lib/util.test.js":  "test();",
+		"node_modules/a.js": "",
+	}
+	exclude := []string{"**/*.test.js"}
+
+	// The same files with different modification times and modes.
+	dir1 := testAccFunctionSourceCreateDirectory(t, files, 0644, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC))
+	dir2 := testAccFunctionSourceCreateDirectory(t, files, 0600, time.Now())
+
+	zip1, err := tflambda.BuildFunctionSourcePackage(dir1, exclude)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	zip2, err := tflambda.BuildFunctionSourcePackage(dir2, exclude)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(zip1, zip2) {
+		t.Errorf("packages differ: %s, %s", tflambda.FunctionSourceCodeHash(zip1), tflambda.FunctionSourceCodeHash(zip2))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zip1), int64(len(zip1)))
+	if err != nil {
+		t.Fatalf("reading package: %s", err)
+	}
+
+	var got []string
+	for _, v := range r.File {
+		got = append(got, v.Name)
+
+		if got, want := v.Mode(), os.FileMode(0755); got != want {
+			t.Errorf("%s: mode = %s, want %s", v.Name, got, want)
+		}
+
+		if got, want := v.Modified, time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+			t.Errorf("%s: modified = %s, want %s", v.Name, got, want)
+		}
+
+		if got, want := v.Method, zip.Store; got != want {
+			t.Errorf("%s: method = %d, want %d", v.Name, got, want)
+		}
+	}
+
+	if diff := cmp.Diff(got, []string{"index.js", "lib/util.js", "node_modules/a.js"}); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	hash, err := tflambda.FunctionSourcePackageHash(dir1, exclude)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := hash, tflambda.FunctionSourceCodeHash(zip1); got != want {
+		t.Errorf("hash = %s, want %s", got, want)
+	}
+}
+
+func TestFunctionSourcePackageHash_stable(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":    "exports.handler = async () => {};",
+		"lib/util.js": "module.exports = {};",
+	}
+	dir := testAccFunctionSourceCreateDirectory(t, files, 0644, time.Now())
+
+	hash, err := tflambda.FunctionSourcePackageHash(dir, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The hash must not change between Go versions, so files aren't compressed.
+	if got, want := hash, "AfVqyp2NWfV2Tcu4q2r1hksUJa5TY9i1g7HqQ6doNdo="; got != want {
+		t.Errorf("hash = %s, want %s", got, want)
+	}
+}
+
+func TestBuildFunctionSourcePackage_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := tflambda.BuildFunctionSourcePackage(t.TempDir(), nil); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestFunctionSourcePackageHash_notExist(t *testing.T) {
+	t.Parallel()
+
+	_, err := tflambda.FunctionSourcePackageHash(filepath.Join(t.TempDir(), "missing"), nil)
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("err = %v, want %v", err, fs.ErrNotExist)
+	}
+}
+
+func testAccFunctionSourceCreateDirectory(t *testing.T, files map[string]string, mode os.FileMode, modified time.Time) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), mode); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chtimes(path, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
@@ -1930,6 +1930,59 @@ func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_source(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+	source := t.TempDir()
+	testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func.js", filepath.Join(source, "lambda.js"))
+	testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func.js", filepath.Join(source, "test", "lambda.test.js"))
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_source(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source.0.exclude.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "source.0.path", source),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(source, "lambda.js"))
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_source(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "source_code_hash", resourceName, "code_sha256"),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+			{
+				// Changes to excluded files don't change the package.
+				PreConfig: func() {
+					testAccFunctionCopySourceFile(t, "test-fixtures/lambda_func_modified.js", filepath.Join(source, "test", "lambda.test.js"))
+				},
+				Config:   testAccFunctionConfig_source(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_S3Update_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	path, zipFile, err := createTempFile("lambda_s3Update")
@@ -2401,6 +2454,23 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+func testAccFunctionCopySourceFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	b, err := os.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(dst, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -3735,6 +3805,24 @@ resource "aws_lambda_function" "test" {
 `, rName)
 }
 
+func testAccFunctionConfig_source(rName, source string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda.handler"
+  runtime       = "nodejs16.x"
+
+  source {
+    path    = %[2]q
+    exclude = ["test/**"]
+  }
+}
+`, rName, source))
+}
+
 func testAccFunctionConfig_local(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
@@ -4,12 +4,15 @@
 package lambda
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"log"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
@@ -17,12 +20,12 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 		Dependencies: []string{
@@ -38,13 +41,9 @@ func sweepFunctions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.LambdaClient(ctx)
-	input := &lambda.ListFunctionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := lambda.NewListFunctionsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-
+	for v, err := range listAllFunctions(ctx, conn) {
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
 			return nil
@@ -54,14 +53,12 @@ func sweepFunctions(region string) error {
 			return fmt.Errorf("error listing Lambda Functions (%s): %w", region, err)
 		}
 
-		for _, v := range page.Functions {
-			r := resourceFunction()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(v.FunctionName))
-			d.Set("function_name", v.FunctionName)
+		r := resourceFunction()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.FunctionName))
+		d.Set("function_name", v.FunctionName)
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -73,6 +70,30 @@ func sweepFunctions(region string) error {
 	return nil
 }
 
+// listAllFunctions yields every Lambda Function in the connection's Region.
+// It is shared by the sweeper and the aws_lambda_function list resource.
+func listAllFunctions(ctx context.Context, conn *lambda.Client) iter.Seq2[awstypes.FunctionConfiguration, error] {
+	return func(yield func(awstypes.FunctionConfiguration, error) bool) {
+		input := &lambda.ListFunctionsInput{}
+
+		pages := lambda.NewListFunctionsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(awstypes.FunctionConfiguration{}, err)
+				return
+			}
+
+			for _, v := range page.Functions {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func sweepLayerVersions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -161,8 +161,8 @@ func ResourceInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.ValidateChange(names.AttrAvailabilityZone, func(ctx context.Context, old, new, meta any) error {
 				// The availability_zone must be in the same region as the provider region
-				if !strings.HasPrefix(new.(string), meta.(*conns.AWSClient).Region) {
-					return fmt.Errorf("availability_zone must be within the same region as provider region: %s", meta.(*conns.AWSClient).Region)
+				if !strings.HasPrefix(new.(string), meta.(*conns.AWSClient).EffectiveRegion(ctx)) {
+					return fmt.Errorf("availability_zone must be within the same region as provider region: %s", meta.(*conns.AWSClient).EffectiveRegion(ctx))
 				}
 				return nil
 			}),
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_cloudwatch_log_group", name="Log Group")
+func listGroups(ctx context.Context, meta any) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		conn := meta.(*conns.AWSClient).LogsClient(ctx)
+
+		for v, err := range listAllGroups(ctx, conn) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(map[string]string{names.AttrName: aws.ToString(v.LogGroupName)}, nil) {
+				return
+			}
+		}
+	}
+}
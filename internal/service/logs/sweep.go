@@ -4,18 +4,21 @@
 package logs
 
 import (
+	"context"
 	"fmt"
+	"iter"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -42,12 +45,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
@@ -59,14 +62,10 @@ func sweepGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("getting client: %s", err)
 	}
-	input := &cloudwatchlogs.DescribeLogGroupsInput{}
 	conn := client.LogsClient(ctx)
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
-
+	for v, err := range listAllGroups(ctx, conn) {
 		if awsv2.SkipSweepError(err) {
 			log.Printf("[WARN] Skipping CloudWatch Logs Log Group sweep for %s: %s", region, err)
 			return nil
@@ -76,13 +75,11 @@ func sweepGroups(region string) error {
 			return fmt.Errorf("error listing CloudWatch Logs Log Groups (%s): %w", region, err)
 		}
 
-		for _, v := range page.LogGroups {
-			r := resourceGroup()
-			d := r.Data(nil)
-			d.SetId(aws.ToString(v.LogGroupName))
+		r := resourceGroup()
+		d := r.Data(nil)
+		d.SetId(aws.ToString(v.LogGroupName))
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
 	err = sweep.SweepOrchestrator(ctx, sweepResources)
@@ -94,6 +91,30 @@ func sweepGroups(region string) error {
 	return nil
 }
 
+// listAllGroups yields every CloudWatch Logs Log Group in the connection's Region.
+// It is shared by the sweeper and the aws_cloudwatch_log_group list resource.
+func listAllGroups(ctx context.Context, conn *cloudwatchlogs.Client) iter.Seq2[awstypes.LogGroup, error] {
+	return func(yield func(awstypes.LogGroup, error) bool) {
+		input := &cloudwatchlogs.DescribeLogGroupsInput{}
+
+		pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				yield(awstypes.LogGroup{}, err)
+				return
+			}
+
+			for _, v := range page.LogGroups {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
 func sweeplogQueryDefinitions(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
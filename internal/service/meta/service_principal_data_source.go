@@ -79,7 +79,7 @@ func (d *servicePrincipalDataSource) Read(ctx context.Context, request datasourc
 
 	// Default to provider current Region if no other filters matched.
 	if region == nil {
-		name := d.Meta().Region
+		name := d.Meta().EffectiveRegion(ctx)
 		matchingRegion, err := findRegionByName(ctx, name)
 
 		if err != nil {
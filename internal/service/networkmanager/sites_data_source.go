@@ -63,7 +63,7 @@ func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		siteIDs = append(siteIDs, aws.ToString(v.SiteId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrIDs, siteIDs)
 
 	return diags
@@ -16,7 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfpinpointsmsvoicev2 "github.com/hashicorp/terraform-provider-aws/internal/service/pinpointsmsvoicev2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -59,6 +61,47 @@ func TestAccPinpointSMSVoiceV2OptOutList_basic(t *testing.T) {
 	})
 }
 
+func TestAccPinpointSMSVoiceV2OptOutList_Identity(t *testing.T) {
+	ctx := acctest.Context(t)
+	var optOutList awstypes.OptOutListInformation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_pinpointsmsvoicev2_opt_out_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckOptOutList(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointSMSVoiceV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOptOutListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOptOutListConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOptOutListExists(ctx, resourceName, &optOutList),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					tfstatecheck.ExpectIdentity(tfpinpointsmsvoicev2.ServicePackage(ctx), resourceName),
+					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
+						names.AttrAccountID: knownvalue.StringExact(acctest.AccountID()),
+						names.AttrRegion:    knownvalue.StringExact(acctest.Region()),
+						names.AttrName:      knownvalue.StringExact(rName),
+					}),
+				},
+			},
+			{
+				ResourceName:    resourceName,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccPinpointSMSVoiceV2OptOutList_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var optOutList awstypes.OptOutListInformation
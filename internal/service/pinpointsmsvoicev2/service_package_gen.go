@@ -26,6 +26,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrName},
+			},
 		},
 		{
 			Factory: newPhoneNumberResource,
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"iter"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @ListResource("aws_s3_bucket", name="Bucket")
+func listBuckets(ctx context.Context, meta any) iter.Seq2[map[string]string, error] {
+	return func(yield func(map[string]string, error) bool) {
+		awsClient := meta.(*conns.AWSClient)
+		conn := awsClient.S3Client(ctx)
+
+		for v, err := range listBucketsInRegion(ctx, conn, awsClient.EffectiveRegion(ctx)) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			if !yield(map[string]string{names.AttrBucket: aws.ToString(v.Name)}, nil) {
+				return
+			}
+		}
+	}
+}
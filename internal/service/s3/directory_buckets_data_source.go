@@ -78,10 +78,10 @@ func (d *directoryBucketsDataSource) Read(ctx context.Context, request datasourc
 	}
 
 	data.ARNs = flex.FlattenFrameworkStringValueList(ctx, tfslices.ApplyToAll(buckets, func(v string) string {
-		return d.RegionalARN("s3express", fmt.Sprintf("bucket/%s", v))
+		return d.RegionalARN(ctx, "s3express", fmt.Sprintf("bucket/%s", v))
 	}))
 	data.Buckets = flex.FlattenFrameworkStringValueList(ctx, buckets)
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().EffectiveRegion(ctx))
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
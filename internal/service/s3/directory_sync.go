@@ -0,0 +1,818 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	directorySyncResourceIDPartCount = 2
+	// The maximum number of objects uploaded concurrently.
+	// Each multipart upload also uploads up to the upload manager's concurrency parts concurrently.
+	directorySyncUploadConcurrency = 8
+	// The maximum number of keys in a DeleteObjects request.
+	deleteObjectsMaxKeys = 1000
+	// The part size of the upload manager used to upload objects.
+	// The ETag of an object uploaded by multipart upload depends on the part size.
+	directorySyncUploadPartSize = manager.DefaultUploadPartSize
+	// The user-defined metadata key, stored as x-amz-meta-sha256, of the SHA-256 digest of a synchronized object's content.
+	// The ETags of SSE-KMS encrypted objects aren't derived from MD5 digests of the content, so their changes are detected using this digest.
+	directorySyncMetadataKeySHA256 = "sha256"
+)
+
+// @SDKResource("aws_s3_directory_sync", name="Directory Sync")
+func resourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDirectorySyncCreate,
+		ReadWithoutTimeout:   resourceDirectorySyncRead,
+		UpdateWithoutTimeout: resourceDirectorySyncUpdate,
+		DeleteWithoutTimeout: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_extraneous": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidPathPattern,
+				},
+			},
+			"include": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidPathPattern,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrContentType: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidPathPattern,
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	id, err := flex.FlattenResourceId([]string{bucket, keyPrefix}, directorySyncResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := syncDirectory(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	etags, err := findObjectETagsByPrefix(ctx, conn, bucket, d.Get("key_prefix").(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	manifest, err := expandDirectorySyncManifest(d)
+
+	if err != nil {
+		// The source directory may legitimately be absent, e.g. when destroying from a different machine.
+		log.Printf("[WARN] Reading S3 Directory Sync (%s) source, leaving manifest unchanged: %s", d.Id(), err)
+		return diags
+	}
+
+	hashes, err := findDirectorySyncObjectHashes(ctx, conn, bucket, manifest, etags)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("manifest_hash", manifest.observed(etags, hashes, d.Get("delete_extraneous").(bool)).hash())
+	d.Set("object_count", len(manifest))
+
+	return diags
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if err := syncDirectory(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceDirectorySyncRead(ctx, d, meta)...)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	existing, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	var keys []string
+	if d.Get("delete_extraneous").(bool) {
+		// The resource owns every object under the key prefix.
+		keys = slices.Collect(maps.Keys(existing))
+	} else {
+		files, err := findDirectorySyncFiles(d)
+
+		if err != nil {
+			log.Printf("[WARN] Reading S3 Directory Sync (%s) source, no objects deleted: %s", d.Id(), err)
+			return diags
+		}
+
+		for _, v := range files {
+			if _, ok := existing[v.key]; ok {
+				keys = append(keys, v.key)
+			}
+		}
+	}
+
+	log.Printf("[INFO] Deleting S3 Directory Sync: %s", d.Id())
+	if _, err := deleteObjectsByKey(ctx, conn, bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"exclude", "include", "key_prefix", names.AttrRule, names.AttrSource} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("manifest_hash"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("object_count")
+		}
+	}
+
+	manifest, err := expandDirectorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	if hash := manifest.hash(); d.Get("manifest_hash").(string) != hash {
+		if err := d.SetNew("manifest_hash", hash); err != nil {
+			return err
+		}
+	}
+
+	if n := len(manifest); d.Get("object_count").(int) != n {
+		if err := d.SetNew("object_count", n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncDirectory uploads new and changed files from the source directory and optionally deletes extraneous objects.
+// A file is uploaded if the object is missing, its content differs from the file's or its metadata would change.
+func syncDirectory(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, keyPrefix := d.Get(names.AttrBucket).(string), d.Get("key_prefix").(string)
+	etags, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return err
+	}
+
+	manifest, err := expandDirectorySyncManifest(d)
+
+	if err != nil {
+		return err
+	}
+
+	uploadAll := d.IsNewResource() || d.HasChange("acl")
+
+	var hashes map[string]string
+	if !uploadAll {
+		hashes, err = findDirectorySyncObjectHashes(ctx, conn, bucket, manifest, etags)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	// Object metadata can't be listed, so compare the metadata derived from the prior rules.
+	// Changes to object metadata made outside of Terraform aren't detected.
+	o, _ := d.GetChange(names.AttrRule)
+	oldRules := expandDirectorySyncRules(o.([]interface{}))
+
+	toUpload := make(directorySyncManifest)
+	for key, v := range manifest {
+		if uploadAll || hashes[key] != v.hash || oldRules.metadata(v.name) != v.directorySyncMetadata {
+			toUpload[key] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d objects to S3 Bucket (%s)", len(toUpload), len(manifest), bucket)
+	uploader := newObjectUploader(conn)
+	uploader.PartSize = directorySyncUploadPartSize
+	if err := uploadDirectorySyncObjects(ctx, uploader, bucket, d.Get("acl").(string), toUpload); err != nil {
+		return err
+	}
+
+	if d.Get("delete_extraneous").(bool) {
+		var extraneous []string
+		for key := range etags {
+			if _, ok := manifest[key]; !ok {
+				extraneous = append(extraneous, key)
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting %d extraneous objects from S3 Bucket (%s)", len(extraneous), bucket)
+		if _, err := deleteObjectsByKey(ctx, conn, bucket, extraneous); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func uploadDirectorySyncObjects(ctx context.Context, uploader *manager.Uploader, bucket, acl string, objects directorySyncManifest) error {
+	var (
+		errs []error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, directorySyncUploadConcurrency)
+
+	for key, v := range objects {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := uploadDirectorySyncObject(ctx, uploader, bucket, key, acl, v); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func uploadDirectorySyncObject(ctx context.Context, uploader *manager.Uploader, bucket, key, acl string, v directorySyncObject) error {
+	file, err := os.Open(v.path)
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", v.path, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", v.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:   file,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Metadata: map[string]string{
+			directorySyncMetadataKeySHA256: v.hash,
+		},
+	}
+
+	if acl != "" {
+		input.ACL = types.ObjectCannedACL(acl)
+	}
+
+	if v.cacheControl != "" {
+		input.CacheControl = aws.String(v.cacheControl)
+	}
+
+	if v.contentEncoding != "" {
+		input.ContentEncoding = aws.String(v.contentEncoding)
+	}
+
+	if v.contentType != "" {
+		input.ContentType = aws.String(v.contentType)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return nil
+}
+
+// deleteObjectsByKey deletes the specified objects from an S3 general purpose bucket.
+// Returns the number of objects deleted.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) (int64, error) {
+	var nObjects int64
+
+	for chunk := range slices.Chunk(keys, deleteObjectsMaxKeys) {
+		page := &s3.ListObjectsV2Output{
+			Contents: tfslices.ApplyToAll(chunk, func(v string) types.Object {
+				return types.Object{
+					Key: aws.String(v),
+				}
+			}),
+		}
+
+		n, err := deletePageOfObjects(ctx, conn, bucket, page)
+		nObjects += n
+
+		if err != nil {
+			return nObjects, err
+		}
+	}
+
+	return nObjects, nil
+}
+
+// findObjectETagsByPrefix returns the ETags, without quotes, of the objects whose keys begin with the specified prefix.
+// The returned map is keyed by object key.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
+
+// findDirectorySyncObjectHashes returns the content hashes of the manifest's existing objects, keyed by object key.
+// etags are the listed ETags of the existing objects.
+// An object whose ETag equals that of the object uploaded from its file has the file's content hash.
+// Other objects may be SSE-KMS encrypted, whose ETags aren't derived from the content,
+// so their metadata is read using HeadObject and the content hash stored in the metadata of SSE-KMS encrypted objects is used.
+// The hash of any other object, e.g. one whose content has changed, is empty.
+func findDirectorySyncObjectHashes(ctx context.Context, conn *s3.Client, bucket string, manifest directorySyncManifest, etags map[string]string) (map[string]string, error) {
+	var (
+		errs   []error
+		keys   []string
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		output = make(map[string]string, len(manifest))
+	)
+
+	for key, v := range manifest {
+		switch etag, ok := etags[key]; {
+		case !ok:
+		case etag == v.etag:
+			output[key] = v.hash
+		default:
+			keys = append(keys, key)
+		}
+	}
+
+	sem := make(chan struct{}, directorySyncUploadConcurrency)
+
+	for _, key := range keys {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			object, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				errs = append(errs, fmt.Errorf("reading S3 Object (%s) metadata: %w", key, err))
+			default:
+				switch object.ServerSideEncryption {
+				case types.ServerSideEncryptionAwsKms, types.ServerSideEncryptionAwsKmsDsse:
+					output[key] = object.Metadata[directorySyncMetadataKeySHA256]
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// directorySyncFile is a local file that is synchronized to an S3 object.
+type directorySyncFile struct {
+	key  string // S3 object key.
+	name string // Slash-separated path relative to the source directory.
+	path string // Local file path.
+}
+
+type directorySyncMetadata struct {
+	cacheControl    string
+	contentEncoding string
+	contentType     string
+}
+
+type directorySyncObject struct {
+	directorySyncFile
+	directorySyncMetadata
+	etag string // ETag, without quotes, of the object uploaded from the file.
+	hash string // Hex-encoded SHA-256 digest of the content.
+}
+
+// directorySyncManifest describes the expected state of the synchronized objects, keyed by object key.
+type directorySyncManifest map[string]directorySyncObject
+
+// hash returns a digest of the manifest's object keys, content hashes and metadata.
+func (m directorySyncManifest) hash() string {
+	h := sha256.New()
+
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		v := m[key]
+		fmt.Fprintf(h, "%q %q %q %q %q\n", key, v.hash, v.cacheControl, v.contentEncoding, v.contentType)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// observed returns a copy of the manifest with the expected content hashes replaced by those of the existing objects.
+// If extraneous is true then existing objects, whose ETags are keyed by object key, not in the manifest are added to the copy.
+// Its hash equals the manifest's hash only if all objects are up to date.
+func (m directorySyncManifest) observed(etags map[string]string, hashes map[string]string, extraneous bool) directorySyncManifest {
+	output := make(directorySyncManifest, len(m))
+
+	for key, v := range m {
+		v.hash = hashes[key]
+		output[key] = v
+	}
+
+	if extraneous {
+		for key := range etags {
+			if _, ok := output[key]; !ok {
+				output[key] = directorySyncObject{}
+			}
+		}
+	}
+
+	return output
+}
+
+// directorySyncContentTypes are the content types of common file extensions.
+// A fixed table is used instead of the host's MIME type database so that the detected types, and so the manifest hash, don't depend on the host.
+var directorySyncContentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "text/xml; charset=utf-8",
+	".zip":   "application/zip",
+}
+
+// directorySyncContentType returns the content type detected from the named file's extension.
+func directorySyncContentType(name string) string {
+	return directorySyncContentTypes[strings.ToLower(path.Ext(name))]
+}
+
+type directorySyncRule struct {
+	directorySyncMetadata
+	pattern string
+}
+
+type directorySyncRules []directorySyncRule
+
+// metadata returns the metadata of the object synchronized from the named file.
+// The content type is detected from the file extension. All matching rules are applied in order.
+func (rules directorySyncRules) metadata(name string) directorySyncMetadata {
+	output := directorySyncMetadata{
+		contentType: directorySyncContentType(name),
+	}
+
+	for _, rule := range rules {
+		if !tfio.MatchPattern(rule.pattern, name) {
+			continue
+		}
+
+		if v := rule.cacheControl; v != "" {
+			output.cacheControl = v
+		}
+
+		if v := rule.contentEncoding; v != "" {
+			output.contentEncoding = v
+		}
+
+		if v := rule.contentType; v != "" {
+			output.contentType = v
+		}
+	}
+
+	return output
+}
+
+func expandDirectorySyncRules(tfList []interface{}) directorySyncRules {
+	var apiObjects directorySyncRules
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, directorySyncRule{
+			directorySyncMetadata: directorySyncMetadata{
+				cacheControl:    tfMap["cache_control"].(string),
+				contentEncoding: tfMap["content_encoding"].(string),
+				contentType:     tfMap[names.AttrContentType].(string),
+			},
+			pattern: tfMap["pattern"].(string),
+		})
+	}
+
+	return apiObjects
+}
+
+// findDirectorySyncFiles returns the files in the source directory that match the include and exclude patterns.
+func findDirectorySyncFiles(d sdkv2.ResourceDiffer) ([]directorySyncFile, error) {
+	source := d.Get(names.AttrSource).(string)
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	keyPrefix := d.Get("key_prefix").(string)
+	include := flex.ExpandStringValueSet(d.Get("include").(*schema.Set))
+	exclude := flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set))
+
+	files, err := tfio.FindFiles(root, include, exclude)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", root, err)
+	}
+
+	return tfslices.ApplyToAll(files, func(v tfio.File) directorySyncFile {
+		return directorySyncFile{
+			key:  keyPrefix + v.Name,
+			name: v.Name,
+			path: v.Path,
+		}
+	}), nil
+}
+
+// expandDirectorySyncManifest returns the manifest of the objects synchronized from the source directory.
+func expandDirectorySyncManifest(d sdkv2.ResourceDiffer) (directorySyncManifest, error) {
+	files, err := findDirectorySyncFiles(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rules := expandDirectorySyncRules(d.Get(names.AttrRule).([]interface{}))
+	manifest := make(directorySyncManifest, len(files))
+
+	for _, v := range files {
+		hash, etag, err := fileDigests(v.path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		manifest[v.key] = directorySyncObject{
+			directorySyncFile:     v,
+			directorySyncMetadata: rules.metadata(v.name),
+			etag:                  etag,
+			hash:                  hash,
+		}
+	}
+
+	return manifest, nil
+}
+
+// fileDigests returns the hex-encoded SHA-256 digest of the specified file's content and the ETag of the object uploaded from the file.
+func fileDigests(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", fmt.Errorf("opening S3 object source (%s): %w", path, err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+	}
+
+	h := sha256.New()
+
+	etag, err := objectETag(io.TeeReader(file, h), info.Size(), directorySyncUploadPartSize, manager.MaxUploadParts)
+	if err != nil {
+		return "", "", fmt.Errorf("reading S3 object source (%s): %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), etag, nil
+}
+
+// objectETag returns the ETag of the object uploaded from the specified content by an upload manager with the specified part size
+// and maximum number of parts. The part size is increased, as the upload manager does, if the content would need more parts.
+// Content no larger than one part is uploaded by PutObject and its ETag is the MD5 digest of the content.
+// Larger content is uploaded by multipart upload and its ETag is the MD5 digest of the concatenated MD5 digests of the parts,
+// followed by "-" and the number of parts.
+// ETags of objects encrypted using SSE-KMS or SSE-C are not MD5 digests.
+func objectETag(r io.Reader, size, partSize int64, maxParts int32) (string, error) {
+	if size/partSize >= int64(maxParts) {
+		partSize = size/int64(maxParts) + 1
+	}
+
+	if size <= partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	var nParts int
+	for remaining := size; remaining > 0; remaining -= partSize {
+		h := md5.New()
+
+		if _, err := io.CopyN(h, r, min(partSize, remaining)); err != nil {
+			return "", err
+		}
+
+		digests = h.Sum(digests)
+		nParts++
+	}
+
+	digest := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digest[:]), nParts), nil
+}
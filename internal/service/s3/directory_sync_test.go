@@ -0,0 +1,518 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectETag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		content  string
+		partSize int64
+		maxParts int32
+		want     string
+	}{
+		{
+			name:     "empty",
+			content:  "",
+			partSize: 5,
+			maxParts: 10000,
+			want:     "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		{
+			name:     "single part",
+			content:  "hello",
+			partSize: 5,
+			maxParts: 10000,
+			want:     "5d41402abc4b2a76b9719d911017c592",
+		},
+		{
+			name:     "multipart",
+			content:  "hello world",
+			partSize: 5,
+			maxParts: 10000,
+			want:     "df349a9519959b17a605009540f4b31d-3",
+		},
+		{
+			name:     "part size increased",
+			content:  "hello world",
+			partSize: 1,
+			maxParts: 4,
+			want:     "a27052b58f3ef937f47fbed3dee1524c-4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.ObjectETag(strings.NewReader(testCase.content), int64(len(testCase.content)), testCase.partSize, testCase.maxParts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestFileDigests(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFile(t, dir, "hello.txt", "hello")
+
+	hash, etag, err := tfs3.FileDigests(filepath.Join(dir, "hello.txt"))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"; hash != want {
+		t.Errorf("hash = %s, want %s", hash, want)
+	}
+
+	if want := "5d41402abc4b2a76b9719d911017c592"; etag != want {
+		t.Errorf("etag = %s, want %s", etag, want)
+	}
+}
+
+func TestDirectorySyncContentType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"index.html":         "text/html; charset=utf-8",
+		"docs/api/INDEX.HTM": "text/html; charset=utf-8",
+		"css/site.css":       "text/css; charset=utf-8",
+		"js/app.min.js":      "text/javascript; charset=utf-8",
+		"img/logo.svg":       "image/svg+xml",
+		"fonts/a.woff2":      "font/woff2",
+		"img/.gitkeep":       "",
+		"LICENSE":            "",
+		"data.unknown":       "",
+	}
+
+	for name, want := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfs3.DirectorySyncContentType(name); got != want {
+				t.Errorf("DirectorySyncContentType(%q) = %q, want %q", name, got, want)
+			}
+		})
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateSource(t, map[string]string{
+		"index.html":         "<h1>Hello</h1>",
+		"css/site.css":       "body {}",
+		"img/.gitkeep":       "",
+		"docs/api/index.htm": "<h1>API</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "site/css/site.css", "site/docs/api/index.htm", "site/img/.gitkeep", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_hash"),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_includeExclude(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateSource(t, map[string]string{
+		"index.html":        "<h1>Hello</h1>",
+		"css/site.css":      "body {}",
+		"css/site.css.map":  "{}",
+		"drafts/draft.html": "<h1>Draft</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_includeExclude(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "css/site.css", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateSource(t, map[string]string{
+		"index.html":   "<h1>Hello</h1>",
+		"css/site.css": "body {}",
+	})
+	var manifestHash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_rule(rName, source, "max-age=60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "css/site.css", "index.html"),
+					testAccCheckDirectorySyncObjectMetadata(ctx, rName, "css/site.css", "max-age=60", ""),
+					testAccCheckDirectorySyncObjectMetadata(ctx, rName, "index.html", "no-cache", "text/html"),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_hash", func(v string) error {
+						manifestHash = v
+						return nil
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, source, "index.html", "<h1>Hello, World</h1>")
+					testAccDirectorySyncWriteFile(t, source, "js/site.js", "console.log('hello');")
+				},
+				Config: testAccDirectorySyncConfig_rule(rName, source, "max-age=3600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "css/site.css", "index.html", "js/site.js"),
+					testAccCheckDirectorySyncObjectMetadata(ctx, rName, "css/site.css", "max-age=3600", ""),
+					testAccCheckDirectorySyncObjectMetadata(ctx, rName, "index.html", "no-cache", "text/html"),
+					testAccCheckDirectorySyncObjectMetadata(ctx, rName, "js/site.js", "max-age=3600", ""),
+					resource.TestCheckResourceAttrWith(resourceName, "manifest_hash", func(v string) error {
+						if v == manifestHash {
+							return fmt.Errorf("manifest_hash not updated")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_kmsEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateSource(t, map[string]string{
+		"index.html": "<h1>Hello</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_kmsEncryption(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "1"),
+				),
+			},
+			{
+				// The ETags of SSE-KMS encrypted objects aren't MD5 digests, but changes are detected using content hashes.
+				Config:   testAccDirectorySyncConfig_kmsEncryption(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_directory_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncCreateSource(t, map[string]string{
+		"index.html": "<h1>Hello</h1>",
+		"old.html":   "<h1>Old</h1>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "index.html", "old.html"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncPutObject(ctx, t, rName, "unmanaged.txt")
+
+					if err := os.Remove(filepath.Join(source, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteExtraneous(rName, source, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncObjects(ctx, resourceName, "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "object_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			keys, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(keys) > 0 {
+				return fmt.Errorf("S3 Directory Sync %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDirectorySyncObjects checks that the objects under the resource's key prefix have exactly the specified keys.
+func testAccCheckDirectorySyncObjects(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		existing, err := tfs3.FindObjectETagsByPrefix(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if diff := cmp.Diff(slices.Sorted(maps.Keys(existing)), keys); diff != "" {
+			return fmt.Errorf("unexpected S3 Directory Sync (%s) objects (-got +want): %s", rs.Primary.ID, diff)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckDirectorySyncObjectMetadata checks an object's Cache-Control and, if not empty, Content-Type.
+func testAccCheckDirectorySyncObjectMetadata(ctx context.Context, bucket, key, cacheControl, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control = %q, want %q", key, got, cacheControl)
+		}
+
+		if got := aws.ToString(output.ContentType); contentType != "" && got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %q, want %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+// testAccDirectorySyncPutObject creates an object outside of Terraform.
+func testAccDirectorySyncPutObject(ctx context.Context, t *testing.T, bucket, key string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+	_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   strings.NewReader(key),
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		t.Fatalf("creating S3 Object (%s): %s", key, err)
+	}
+}
+
+func testAccDirectorySyncCreateSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		testAccDirectorySyncWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_includeExclude(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  source  = %[1]q
+  include = ["*.html", "css/**"]
+  exclude = ["**/*.map"]
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_rule(rName, source, cacheControl string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[1]q
+
+  rule {
+    pattern       = "**"
+    cache_control = %[2]q
+  }
+
+  rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+    content_type  = "text/html"
+  }
+}
+`, source, cacheControl))
+}
+
+func testAccDirectorySyncConfig_deleteExtraneous(rName, source string, deleteExtraneous bool) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  source            = %[1]q
+  delete_extraneous = %[2]t
+}
+`, source, deleteExtraneous))
+}
+
+func testAccDirectorySyncConfig_kmsEncryption(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_s3_bucket_server_side_encryption_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    apply_server_side_encryption_by_default {
+      kms_master_key_id = aws_kms_key.test.arn
+      sse_algorithm     = "aws:kms"
+    }
+  }
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket_server_side_encryption_configuration.test.bucket
+  source = %[2]q
+}
+`, rName, source))
+}
@@ -33,6 +33,8 @@ var (
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
 	EmptyBucket                           = emptyBucket
+	DirectorySyncContentType              = directorySyncContentType
+	FileDigests                           = fileDigests
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
 	FindBucketACL                         = findBucketACL
@@ -49,6 +51,7 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectETagsByPrefix               = findObjectETagsByPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
@@ -56,6 +59,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	ObjectETag                            = objectETag
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
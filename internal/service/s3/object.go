@@ -588,7 +588,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploader := newObjectUploader(conn, optFns...)
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -601,6 +601,12 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// newObjectUploader returns an S3 upload manager.
+// Objects larger than the upload manager's part size are uploaded using multipart upload.
+func newObjectUploader(conn *s3.Client, optFns ...func(*s3.Options)) *manager.Uploader {
+	return manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -30,6 +30,16 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 	}
 }
 
+func (p *servicePackage) ListResources(ctx context.Context) []*types.ServicePackageListResource {
+	return []*types.ServicePackageListResource{
+		{
+			List:     listBuckets,
+			TypeName: "aws_s3_bucket",
+			Name:     "Bucket",
+		},
+	}
+}
+
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
@@ -88,6 +98,9 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				Attributes: []string{names.AttrBucket},
+			},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
@@ -193,6 +206,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_s3_bucket_website_configuration",
 			Name:     "Bucket Website Configuration",
 		},
+		{
+			Factory:  resourceDirectorySync,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+		},
 		{
 			Factory:  resourceObject,
 			TypeName: "aws_s3_object",
@@ -6,18 +6,19 @@ package s3
 import (
 	"context"
 	"fmt"
+	"iter"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
@@ -26,7 +27,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_s3_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_object", &resource.Sweeper{
 		Name: "aws_s3_object",
 		F:    sweepObjects,
 		Dependencies: []string{
@@ -34,7 +35,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -45,7 +46,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_directory_bucket", &resource.Sweeper{
 		Name: "aws_s3_directory_bucket",
 		F:    sweepDirectoryBuckets,
 		Dependencies: []string{
@@ -63,23 +64,23 @@ func sweepObjects(region string) error {
 	conn := client.S3Client(ctx)
 
 	// General purpose buckets.
-	output, err := conn.ListBuckets(ctx, &s3.ListBucketsInput{})
+	sweepables := make([]sweep.Sweepable, 0)
 
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping S3 Objects sweep for %s: %s", region, err)
-		return nil
-	}
+	for v, err := range listBucketsInRegion(ctx, conn, region) {
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping S3 Objects sweep for %s: %s", region, err)
+			return nil
+		}
 
-	if err != nil {
-		return fmt.Errorf("error listing S3 Buckets: %w", err)
-	}
+		if err != nil {
+			return fmt.Errorf("error listing S3 Buckets: %w", err)
+		}
 
-	buckets := tfslices.Filter(output.Buckets, bucketRegionFilter(ctx, conn, region, client.S3UsePathStyle(ctx)))
-	buckets = tfslices.Filter(buckets, bucketNameFilter)
-	sweepables := make([]sweep.Sweepable, 0)
+		if !bucketNameFilter(v) {
+			continue
+		}
 
-	for _, bucket := range buckets {
-		bucket := aws.ToString(bucket.Name)
+		bucket := aws.ToString(v.Name)
 		objLockConfig, err := findObjectLockConfiguration(ctx, conn, bucket, "")
 
 		var objectLockEnabled bool
@@ -174,30 +175,23 @@ func sweepBuckets(region string) error {
 		return fmt.Errorf("getting client: %s", err)
 	}
 	conn := client.S3Client(ctx)
-	input := &s3.ListBucketsInput{}
-
-	output, err := conn.ListBuckets(ctx, input)
-
-	if awsv2.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping S3 Buckets sweep for %s: %s", region, err)
-		return nil
-	}
+	sweepables := make([]sweep.Sweepable, 0)
 
-	if err != nil {
-		return fmt.Errorf("listing S3 Buckets: %w", err)
-	}
+	for v, err := range listBucketsInRegion(ctx, conn, region) {
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping S3 Buckets sweep for %s: %s", region, err)
+			return nil
+		}
 
-	if len(output.Buckets) == 0 {
-		log.Print("[DEBUG] No S3 Buckets to sweep")
-		return nil
-	}
+		if err != nil {
+			return fmt.Errorf("listing S3 Buckets: %w", err)
+		}
 
-	buckets := tfslices.Filter(output.Buckets, bucketRegionFilter(ctx, conn, region, client.S3UsePathStyle(ctx)))
-	buckets = tfslices.Filter(buckets, bucketNameFilter)
-	sweepables := make([]sweep.Sweepable, 0)
+		if !bucketNameFilter(v) {
+			continue
+		}
 
-	for _, bucket := range buckets {
-		name := aws.ToString(bucket.Name)
+		name := aws.ToString(v.Name)
 
 		r := resourceBucket()
 		d := r.Data(nil)
@@ -215,20 +209,53 @@ func sweepBuckets(region string) error {
 	return nil
 }
 
-func bucketRegion(ctx context.Context, conn *s3.Client, bucket string, s3UsePathStyle bool) (string, error) {
-	region, err := manager.GetBucketRegion(ctx, conn, bucket, func(o *s3.Options) {
-		// By default, GetBucketRegion forces virtual host addressing, which
-		// is not compatible with many non-AWS implementations. Instead, pass
-		// the provider s3_force_path_style configuration, which defaults to
-		// false, but allows override.
-		o.UsePathStyle = s3UsePathStyle
-	})
+// listBucketsInRegion yields the general purpose buckets in the specified Region.
+// It is shared by the sweepers and the aws_s3_bucket list resource.
+func listBucketsInRegion(ctx context.Context, conn *s3.Client, region string) iter.Seq2[types.Bucket, error] {
+	return func(yield func(types.Bucket, error) bool) {
+		input := &s3.ListBucketsInput{}
 
-	if err != nil {
-		return "", err
+		pages := s3.NewListBucketsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, withBucketRegion(region))
+
+			if err != nil {
+				yield(types.Bucket{}, err)
+				return
+			}
+
+			for _, v := range page.Buckets {
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
 	}
+}
 
-	return region, nil
+// withBucketRegion limits a ListBuckets response to buckets in the specified Region.
+// Without it, ListBuckets returns general purpose buckets in all Regions.
+// The vendored AWS SDK for Go v2 predates ListBucketsInput.BucketRegion, so the bucket-region query parameter is set directly.
+func withBucketRegion(region string) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc(
+				"BucketRegion",
+				func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (out middleware.BuildOutput, metadata middleware.Metadata, err error) {
+					switch req := in.Request.(type) {
+					case *smithyhttp.Request:
+						query := req.URL.Query()
+						query.Set("bucket-region", region)
+						req.URL.RawQuery = query.Encode()
+					default:
+						return out, metadata, fmt.Errorf("unknown transport type %T", in.Request)
+					}
+
+					return next.HandleBuild(ctx, in)
+				},
+			), middleware.After)
+		})
+	}
 }
 
 func bucketNameFilter(bucket types.Bucket) bool {
@@ -261,26 +288,6 @@ var (
 	defaultNameRegexp = regexache.MustCompile(fmt.Sprintf(`^%s\d+$`, id.UniqueIdPrefix))
 )
 
-func bucketRegionFilter(ctx context.Context, conn *s3.Client, region string, s3UsePathStyle bool) tfslices.Predicate[types.Bucket] {
-	return func(bucket types.Bucket) bool {
-		name := aws.ToString(bucket.Name)
-
-		bucketRegion, err := bucketRegion(ctx, conn, name, s3UsePathStyle)
-
-		if err != nil {
-			log.Printf("[WARN] Getting S3 Bucket (%s) region: %s", name, err)
-			return false
-		}
-
-		if bucketRegion != region {
-			log.Printf("[INFO] Skipping S3 Bucket (%s): not in %s", name, region)
-			return false
-		}
-
-		return true
-	}
-}
-
 func sweepDirectoryBuckets(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
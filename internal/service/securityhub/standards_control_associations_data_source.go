@@ -114,7 +114,7 @@ func (d *dataSourceStandardsControlAssociations) Read(ctx context.Context, req d
 		return
 	}
 
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().EffectiveRegion(ctx))
 	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data.StandardsControlAssociations)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -52,7 +52,7 @@ func dataSourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, me
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		Resource:  fmt.Sprintf("identity/%s", email),
 		Service:   "ses",
 	}.String()
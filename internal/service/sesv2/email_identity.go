@@ -177,7 +177,7 @@ func resourceEmailIdentityRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.SESV2, create.ErrActionReading, resNameEmailIdentity, d.Id(), err)
 	}
 
-	arn := emailIdentityARN(meta, d.Id())
+	arn := emailIdentityARN(ctx, meta, d.Id())
 
 	d.Set(names.AttrARN, arn)
 	d.Set("configuration_set_name", out.ConfigurationSetName)
@@ -354,11 +354,11 @@ func flattenDKIMAttributes(apiObject *types.DkimAttributes) map[string]interface
 	return m
 }
 
-func emailIdentityARN(meta interface{}, emailIdentityName string) string {
+func emailIdentityARN(ctx context.Context, meta interface{}, emailIdentityName string) string {
 	return arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "ses",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("identity/%s", emailIdentityName),
 	}.String()
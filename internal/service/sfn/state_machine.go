@@ -14,12 +14,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/asl"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
@@ -62,9 +64,12 @@ func resourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.AllDiag(
+					validation.ToDiagFunc(validation.StringLenBetween(0, 1024*1024)), // 1048576
+					stateMachineDefinitionWarnings,
+				),
 			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
@@ -561,6 +566,23 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 	return false
 }
 
+// stateMachineDefinitionWarnings reports the findings of local Amazon States Language validation, such as missing transition targets, as warnings.
+// The definition is validated by AWS when the diff is customized.
+func stateMachineDefinitionWarnings(v any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, finding := range asl.Validate(v.(string)) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Step Functions State Machine definition may be invalid",
+			Detail:        finding.String(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
 func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
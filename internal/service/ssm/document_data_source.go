@@ -81,7 +81,7 @@ func dataDocumentRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   "ssm",
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Resource:  "document/" + name,
 		}.String()
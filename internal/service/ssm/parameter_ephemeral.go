@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ssm_parameter", name="Parameter")
+func newEphemeralParameter(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralParameter{}, nil
+}
+
+const (
+	ERNameParameter = "Parameter Ephemeral Resource"
+)
+
+type ephemeralParameter struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralParameter) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ssm_parameter"
+}
+
+func (e *ephemeralParameter) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrType: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrValue: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrVersion: schema.Int64Attribute{
+				Computed: true,
+			},
+			"with_decryption": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralParameter) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().SSMClient(ctx)
+
+	var data epParameterData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Decrypt SecureString parameters by default, as the data source does.
+	if data.WithDecryption.IsNull() {
+		data.WithDecryption = types.BoolValue(true)
+	}
+
+	name := data.Name.ValueString()
+	param, err := findParameterByName(ctx, conn, name, data.WithDecryption.ValueBool())
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSM, create.ErrActionReading, ERNameParameter, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, param.ARN)
+	data.Name = fwflex.StringToFramework(ctx, param.Name)
+	data.Type = fwflex.StringValueToFramework(ctx, param.Type)
+	data.Value = fwflex.StringToFramework(ctx, param.Value)
+	data.Version = types.Int64Value(param.Version)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epParameterData struct {
+	ARN            types.String `tfsdk:"arn"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Value          types.String `tfsdk:"value"`
+	Version        types.Int64  `tfsdk:"version"`
+	WithDecryption types.Bool   `tfsdk:"with_decryption"`
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6EchoProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterEphemeralConfig_basic(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey(names.AttrName), knownvalue.StringExact(name)),
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey(names.AttrType), knownvalue.StringExact("SecureString")),
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey(names.AttrValue), knownvalue.StringExact("TestValue")),
+					statecheck.ExpectKnownValue(acctest.EchoResourceName, tfjsonpath.New("data").AtMapKey("with_decryption"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func testAccParameterEphemeralConfig_basic(name string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_ssm_parameter.test"),
+		fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "TestValue"
+}
+
+ephemeral "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.name
+}
+`, name))
+}
@@ -329,7 +329,7 @@ func resourcePatchBaselineRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("approved_patches_enable_non_security", output.ApprovedPatchesEnableNonSecurity)
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		Service:   "ssm",
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  "patchbaseline/" + strings.TrimPrefix(d.Id(), "/"),
@@ -53,7 +53,7 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		arns = append(arns, aws.ToString(v.InstanceArn))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).EffectiveRegion(ctx))
 	d.Set(names.AttrARNs, arns)
 	d.Set("identity_store_ids", identityStoreIDs)
 
@@ -380,7 +380,7 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 	canaryArn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "synthetics",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("canary:%s", aws.ToString(canary.Name)),
 	}.String()
@@ -167,7 +167,7 @@ func resourceWebACL() *schema.Resource {
 func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	name := d.Get(names.AttrName).(string)
 	output, err := newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
@@ -192,7 +192,7 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		arn := arn.ARN{
 			Partition: meta.(*conns.AWSClient).Partition,
 			Service:   "waf-regional",
-			Region:    meta.(*conns.AWSClient).Region,
+			Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 			AccountID: meta.(*conns.AWSClient).AccountID,
 			Resource:  "webacl/" + d.Id(),
 		}.String()
@@ -247,7 +247,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   "waf-regional",
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).EffectiveRegion(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  "webacl/" + d.Id(),
 	}.String()
@@ -286,7 +286,7 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	if d.HasChanges(names.AttrDefaultAction, names.AttrRule) {
 		o, n := d.GetChange(names.AttrRule)
@@ -338,7 +338,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFRegionalClient(ctx)
-	region := meta.(*conns.AWSClient).Region
+	region := meta.(*conns.AWSClient).EffectiveRegion(ctx)
 
 	if rules := d.Get(names.AttrRule).(*schema.Set).List(); len(rules) > 0 {
 		_, err := newRetryer(conn, region).RetryWithToken(ctx, func(token *string) (interface{}, error) {
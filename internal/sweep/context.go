@@ -10,6 +10,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+	sweepRunContextKey
+	sweepingContextKey
+)
+
+// Context returns the Context in which a sweeper is run in the specified Region.
+// While RunSweepers is running sweepers in the Region, the Context carries its dry run and resource filter settings.
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +28,49 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
+	if run := activeSweepRun(region); run != nil {
+		ctx = withSweepRun(ctx, run)
+	}
+
 	return ctx
 }
+
+// WithResourceType returns a copy of the Context recording the type of resource being swept.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+	return v
+}
+
+func withSweepRun(ctx context.Context, run *sweepRun) context.Context {
+	return context.WithValue(ctx, sweepRunContextKey, run)
+}
+
+// sweepRunFromContext returns the state of the call to RunSweepers in which the Context was created.
+// It returns nil when sweepers are run by the testing framework.
+func sweepRunFromContext(ctx context.Context) *sweepRun {
+	v, _ := ctx.Value(sweepRunContextKey).(*sweepRun)
+	return v
+}
+
+// withSweeping returns a copy of the Context in which SweepOrchestrator deletes a resource that matches the run's filter.
+func withSweeping(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sweepingContextKey, true)
+}
+
+func isSweeping(ctx context.Context) bool {
+	v, _ := ctx.Value(sweepingContextKey).(bool)
+	return v
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+var (
+	// errDryRun is returned by API operations that are blocked during a dry run.
+	errDryRun = errors.New("blocked during sweeper dry run")
+	// errUnfiltered is returned by API operations that are blocked when resources are filtered,
+	// as the resources they modify have not been matched against the filter.
+	errUnfiltered = errors.New("blocked outside sweep.SweepOrchestrator when filtering resources")
+)
+
+// readOnlyOperationPrefixes are the prefixes of the names of API operations that do not modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(operation string) bool {
+	return slices.ContainsFunc(readOnlyOperationPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(operation, prefix)
+	})
+}
+
+// guardError returns the error with which an API operation that may modify resources fails when called in the specified Context,
+// or nil if the operation is allowed.
+// During a dry run all such operations fail. When resources are filtered,
+// only the operations called by SweepOrchestrator while deleting a resource that matches the filter are allowed.
+// This prevents sweepers that delete resources directly, rather than via SweepOrchestrator, from doing so.
+func (r *sweepRun) guardError(ctx context.Context) error {
+	switch {
+	case r.dryRun:
+		return errDryRun
+	case !r.filter.isEmpty() && !isSweeping(ctx):
+		return errUnfiltered
+	}
+
+	return nil
+}
+
+// addGuard adds middleware to an AWS SDK for Go v2 API operation's middleware stack that fails any operation which may modify resources
+// and is not allowed during the run.
+// Its signature matches aws.Config.APIOptions.
+func (r *sweepRun) addGuard(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TF_AWS_SweepGuard", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if operation := awsmiddleware.GetOperationName(ctx); !isReadOnlyOperation(operation) {
+			if err := r.guardError(ctx); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("%s %s: %w", awsmiddleware.GetServiceID(ctx), operation, err)
+			}
+		}
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// addGuardHandler adds a request handler to AWS SDK for Go v1 request handlers that fails any operation which may modify resources
+// and is not allowed during the run.
+// It is the AWS SDK for Go v1 equivalent of addGuard.
+func (r *sweepRun) addGuardHandler(handlers *request.Handlers) {
+	handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "TF_AWS_SweepGuard",
+		Fn: func(req *request.Request) {
+			if operation := req.Operation.Name; !isReadOnlyOperation(operation) {
+				if err := r.guardError(req.Context()); err != nil {
+					req.Error = fmt.Errorf("%s %s: %w", req.ClientInfo.ServiceID, operation, err)
+				}
+			}
+		},
+	})
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Describable is implemented by Sweepables that can describe the resource they delete.
+// Each method returns the zero value if the property is not known.
+type Describable interface {
+	ResourceID() string
+	ResourceName() string
+	ResourceTags() map[string]string
+	// ResourceStringAttribute returns the value of the resource's named top-level string attribute.
+	ResourceStringAttribute(name string) string
+}
+
+// readable is implemented by Sweepables that can read the resource they delete.
+type readable interface {
+	Read(ctx context.Context) error
+}
+
+// Filter selects the resources that sweepers delete.
+// A resource must match every configured criterion.
+// Resources whose properties are not known never match criteria on those properties.
+type Filter struct {
+	// MinAge is the minimum time since the resource was created.
+	MinAge time.Duration
+	// NamePrefixes are the allowed prefixes of the resource's name, or of its ID if its name is not known.
+	NamePrefixes []string
+	// Tags are the tags that the resource must have.
+	// An empty value matches any value.
+	Tags map[string]string
+}
+
+// isEmpty returns whether the filter has no criteria, i.e. it matches every resource.
+func (f Filter) isEmpty() bool {
+	return f.MinAge == 0 && len(f.NamePrefixes) == 0 && len(f.Tags) == 0
+}
+
+// needsRead returns whether the filter uses properties of a resource that are typically only known once it is read.
+func (f Filter) needsRead() bool {
+	return f.MinAge > 0 || len(f.Tags) > 0
+}
+
+// resourceDescription is the known properties of a resource being swept.
+type resourceDescription struct {
+	id, name     string
+	tags         map[string]string
+	creationTime time.Time
+}
+
+func describe(sweepable Sweepable) resourceDescription {
+	var d resourceDescription
+
+	if v, ok := sweepable.(Describable); ok {
+		d.id = v.ResourceID()
+		d.name = v.ResourceName()
+		d.tags = v.ResourceTags()
+		d.creationTime = creationTime(v)
+	}
+
+	return d
+}
+
+// creationTimeAttributes are the names of attributes that commonly hold a resource's RFC 3339 creation time.
+var creationTimeAttributes = []string{
+	"creation_date",
+	"created_date",
+	"create_date",
+	"creation_time",
+	"created_time",
+	"created_at",
+	"create_time",
+}
+
+// creationTime returns the described resource's creation time, or the zero time if it is not known.
+func creationTime(v Describable) time.Time {
+	for _, k := range creationTimeAttributes {
+		if t, err := time.Parse(time.RFC3339, v.ResourceStringAttribute(k)); err == nil {
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// match returns whether the described resource matches the filter and, if not, why not.
+func (f Filter) match(d resourceDescription, now time.Time) (bool, string) {
+	if len(f.NamePrefixes) > 0 {
+		name := cmp.Or(d.name, d.id)
+		if name == "" {
+			return false, "name not known"
+		}
+		if !slices.ContainsFunc(f.NamePrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			return false, fmt.Sprintf("name (%s) does not match prefixes", name)
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		if d.tags == nil {
+			return false, "tags not known"
+		}
+		for _, k := range slices.Sorted(maps.Keys(f.Tags)) {
+			if v, ok := d.tags[k]; !ok {
+				return false, fmt.Sprintf("tag (%s) not present", k)
+			} else if want := f.Tags[k]; want != "" && v != want {
+				return false, fmt.Sprintf("tag (%s) value (%s) does not match", k, v)
+			}
+		}
+	}
+
+	if f.MinAge > 0 {
+		if d.creationTime.IsZero() {
+			return false, "creation time not known"
+		}
+		if age := now.Sub(d.creationTime); age < f.MinAge {
+			return false, fmt.Sprintf("created %s ago", age.Round(time.Second))
+		}
+	}
+
+	return true, ""
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		filter     Filter
+		resource   resourceDescription
+		wantMatch  bool
+		wantReason string
+	}{
+		"empty filter": {
+			resource:  resourceDescription{id: "i-123"},
+			wantMatch: true,
+		},
+		"name prefix match": {
+			filter:    Filter{NamePrefixes: []string{"other-", "tf-acc-test-"}},
+			resource:  resourceDescription{id: "i-123", name: "tf-acc-test-abc"},
+			wantMatch: true,
+		},
+		"name prefix match ID": {
+			filter:    Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			resource:  resourceDescription{id: "tf-acc-test-abc"},
+			wantMatch: true,
+		},
+		"name prefix mismatch": {
+			filter:     Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			resource:   resourceDescription{id: "i-123", name: "production"},
+			wantReason: "name (production) does not match prefixes",
+		},
+		"name unknown": {
+			filter:     Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			wantReason: "name not known",
+		},
+		"tag match": {
+			filter:    Filter{Tags: map[string]string{"Env": "test", "Owner": ""}},
+			resource:  resourceDescription{tags: map[string]string{"Env": "test", "Owner": "me"}},
+			wantMatch: true,
+		},
+		"tag not present": {
+			filter:     Filter{Tags: map[string]string{"Env": "test"}},
+			resource:   resourceDescription{tags: map[string]string{}},
+			wantReason: "tag (Env) not present",
+		},
+		"tag value mismatch": {
+			filter:     Filter{Tags: map[string]string{"Env": "test"}},
+			resource:   resourceDescription{tags: map[string]string{"Env": "prod"}},
+			wantReason: "tag (Env) value (prod) does not match",
+		},
+		"tags unknown": {
+			filter:     Filter{Tags: map[string]string{"Env": "test"}},
+			wantReason: "tags not known",
+		},
+		"old enough": {
+			filter:    Filter{MinAge: time.Hour},
+			resource:  resourceDescription{creationTime: now.Add(-2 * time.Hour)},
+			wantMatch: true,
+		},
+		"too new": {
+			filter:     Filter{MinAge: time.Hour},
+			resource:   resourceDescription{creationTime: now.Add(-30 * time.Minute)},
+			wantReason: "created 30m0s ago",
+		},
+		"creation time unknown": {
+			filter:     Filter{MinAge: time.Hour},
+			wantReason: "creation time not known",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotMatch, gotReason := testCase.filter.match(testCase.resource, now)
+
+			if got, want := gotMatch, testCase.wantMatch; got != want {
+				t.Errorf("match = %t, want %t", got, want)
+			}
+			if got, want := gotReason, testCase.wantReason; got != want {
+				t.Errorf("reason = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// testDescribable describes a resource from its string attributes.
+type testDescribable map[string]string
+
+func (d testDescribable) ResourceID() string                         { return d["id"] }
+func (d testDescribable) ResourceName() string                       { return d["name"] }
+func (d testDescribable) ResourceTags() map[string]string            { return nil }
+func (d testDescribable) ResourceStringAttribute(name string) string { return d[name] }
+
+func TestCreationTime(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource testDescribable
+		want     time.Time
+	}{
+		"no attributes": {
+			resource: testDescribable{},
+		},
+		"creation_date": {
+			resource: testDescribable{"creation_date": "2024-01-02T12:00:00Z"},
+			want:     time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		},
+		"created_at": {
+			resource: testDescribable{"created_at": "2024-01-02T12:00:00Z"},
+			want:     time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		},
+		"not RFC 3339": {
+			resource: testDescribable{"creation_date": "1704196800"},
+		},
+		"first valid": {
+			resource: testDescribable{"creation_date": "yesterday", "create_time": "2024-01-02T12:00:00Z"},
+			want:     time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := creationTime(testCase.resource), testCase.want; !got.Equal(want) {
+				t.Errorf("creationTime = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"DescribeInstances":  true,
+		"GetBucketPolicy":    true,
+		"ListRoles":          true,
+		"DeleteBucket":       false,
+		"TerminateInstances": false,
+		"":                   false,
+	}
+
+	for operation, want := range testCases {
+		t.Run(operation, func(t *testing.T) {
+			t.Parallel()
+
+			if got := isReadOnlyOperation(operation); got != want {
+				t.Errorf("isReadOnlyOperation(%q) = %t, want %t", operation, got, want)
+			}
+		})
+	}
+}
+
+func TestSweepRunGuardError(t *testing.T) {
+	t.Parallel()
+
+	filter := Filter{NamePrefixes: []string{"tf-acc-test-"}}
+
+	testCases := map[string]struct {
+		run      *sweepRun
+		sweeping bool
+		want     error
+	}{
+		"dry run": {
+			run:  &sweepRun{dryRun: true},
+			want: errDryRun,
+		},
+		"dry run sweeping": {
+			run:      &sweepRun{dryRun: true, filter: filter},
+			sweeping: true,
+			want:     errDryRun,
+		},
+		"filtered": {
+			run:  &sweepRun{filter: filter},
+			want: errUnfiltered,
+		},
+		"filtered sweeping": {
+			run:      &sweepRun{filter: filter},
+			sweeping: true,
+		},
+		"unfiltered": {
+			run: &sweepRun{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.sweeping {
+				ctx = withSweeping(ctx)
+			}
+
+			if got, want := testCase.run.guardError(ctx), testCase.want; !errors.Is(got, want) || (want == nil && got != nil) {
+				t.Errorf("guardError = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestContextActiveSweepRun(t *testing.T) {
+	t.Parallel()
+
+	const region = "test-region-1"
+	run := &sweepRun{dryRun: true}
+
+	setActiveSweepRun(region, run)
+	if got := sweepRunFromContext(Context(region)); got != run {
+		t.Errorf("sweepRunFromContext = %p, want %p", got, run)
+	}
+
+	setActiveSweepRun(region, nil)
+	if got := sweepRunFromContext(Context(region)); got != nil {
+		t.Errorf("sweepRunFromContext = %p, want nil", got)
+	}
+}
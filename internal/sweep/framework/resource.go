@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -38,6 +39,7 @@ type sweepResource struct {
 	factory    func(context.Context) (fwresource.ResourceWithConfigure, error)
 	meta       *conns.AWSClient
 	attributes []attribute
+	state      *tfsdk.State // The state read by Read, if any.
 }
 
 func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConfigure, error), meta *conns.AWSClient, attributes ...attribute) *sweepResource {
@@ -48,11 +50,12 @@ func NewSweepResource(factory func(context.Context) (fwresource.ResourceWithConf
 	}
 }
 
-func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+// newResource returns the configured resource and a state containing the sweep resource's attributes.
+func (sr *sweepResource) newResource(ctx context.Context) (context.Context, fwresource.Resource, tfsdk.State, error) {
 	resource, err := sr.factory(ctx)
 
 	if err != nil {
-		return err
+		return ctx, nil, tfsdk.State{}, err
 	}
 
 	metadata := resourceMetadata(ctx, resource)
@@ -71,11 +74,45 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	for _, attr := range sr.attributes {
 		d := state.SetAttribute(ctx, path.Root(attr.path), attr.value)
 		if d.HasError() {
-			return fwdiag.DiagnosticsError(d)
+			return ctx, nil, tfsdk.State{}, fwdiag.DiagnosticsError(d)
 		}
 		ctx = tflog.SetField(ctx, attr.path, attr.value)
 	}
 
+	return ctx, resource, state, nil
+}
+
+// Read reads the resource so that its tags and creation time are known.
+func (sr *sweepResource) Read(ctx context.Context) error {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	response := fwresource.ReadResponse{State: state}
+	resource.Read(ctx, fwresource.ReadRequest{State: state}, &response)
+
+	if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+		return err
+	}
+
+	if response.State.Raw.IsNull() {
+		return &retry.NotFoundError{Message: "resource not found"}
+	}
+
+	sr.state = &response.State
+
+	return nil
+}
+
+func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	ctx, resource, state, err := sr.newResource(ctx)
+
+	if err != nil {
+		return err
+	}
+
 	tflog.Info(ctx, "Sweeping resource")
 
 	jitter := time.Duration(rand.Int63n(int64(1*time.Second))) - 1*time.Second/2
@@ -118,6 +155,80 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+func (sr *sweepResource) ResourceID() string {
+	return sr.stringAttribute(names.AttrID)
+}
+
+func (sr *sweepResource) ResourceName() string {
+	return sr.stringAttribute(names.AttrName)
+}
+
+// ResourceTags returns the resource's tags, or nil if the resource has not been read.
+func (sr *sweepResource) ResourceTags() map[string]string {
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		var v map[string]tftypes.Value
+		if !sr.readAttribute(k, &v) {
+			continue
+		}
+
+		tags := make(map[string]string, len(v))
+		for k, v := range v {
+			var s string
+			if v.IsKnown() && !v.IsNull() && v.As(&s) == nil {
+				tags[k] = s
+			}
+		}
+
+		return tags
+	}
+
+	return nil
+}
+
+// ResourceStringAttribute returns the value of a top-level string attribute, or "" if the resource has not been read.
+func (sr *sweepResource) ResourceStringAttribute(name string) string {
+	var v string
+	sr.readAttribute(name, &v)
+
+	return v
+}
+
+// readAttribute sets dst to the value of a top-level attribute in the state read by Read.
+// It returns false if the resource has not been read or the attribute is not set.
+func (sr *sweepResource) readAttribute(name string, dst any) bool {
+	if sr.state == nil {
+		return false
+	}
+
+	if _, ok := sr.state.Schema.GetAttributes()[name]; !ok {
+		return false
+	}
+
+	v, _, err := tftypes.WalkAttributePath(sr.state.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return false
+	}
+
+	value, ok := v.(tftypes.Value)
+	if !ok || !value.IsKnown() || value.IsNull() {
+		return false
+	}
+
+	return value.As(dst) == nil
+}
+
+func (sr *sweepResource) stringAttribute(path string) string {
+	for _, attr := range sr.attributes {
+		if attr.path == path {
+			if v, ok := attr.value.(string); ok {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// sweepers is the registry of all sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// contextSweepers is the registry of sweepers that are run in a Context, keyed by name.
+var contextSweepers = make(map[string]ContextSweeperFn)
+
+// ContextSweeperFn is a sweeper that is run in a Context.
+// The Context carries the dry run and resource filter settings of RunSweepers to SharedRegionalSweepClient and SweepOrchestrator.
+type ContextSweeperFn func(ctx context.Context, region string) error
+
+// AddTestSweepers registers a sweeper.
+// The sweeper's Dependencies are the sweepers that must complete before it runs.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// AddContextSweeper registers a sweeper that is run in a Context.
+// When run by the testing framework, the sweeper is run in a Context created by Context.
+func AddContextSweeper(name string, f ContextSweeperFn, dependencies ...string) {
+	AddTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F: func(region string) error {
+			return f(Context(region), region)
+		},
+	})
+
+	contextSweepers[name] = f
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// Statuses of swept resources and sweepers in a Report.
+const (
+	StatusCompleted   = "completed"
+	StatusDeleted     = "deleted"
+	StatusFailed      = "failed"
+	StatusSkipped     = "skipped"
+	StatusWouldDelete = "would_delete"
+)
+
+// Report is the machine-readable result of running sweepers.
+type Report struct {
+	DryRun    bool                 `json:"dry_run"`
+	Sweepers  []SweeperReportEntry `json:"sweepers"`
+	Resources []ReportEntry        `json:"resources"`
+
+	lock sync.Mutex
+}
+
+// SweeperReportEntry is the result of running a single sweeper in a Region.
+type SweeperReportEntry struct {
+	Name   string `json:"name"`
+	Region string `json:"region"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ReportEntry is the result of sweeping a single resource.
+type ReportEntry struct {
+	Type   string `json:"type,omitempty"`
+	Region string `json:"region"`
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+func (r *Report) addSweeper(entry SweeperReportEntry) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Sweepers = append(r.Sweepers, entry)
+}
+
+func (r *Report) addResource(entry ReportEntry) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.Resources = append(r.Resources, entry)
+}
+
+// Write writes the report as JSON.
+func (r *Report) Write(w io.Writer) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// RunOptions configures how the registered sweepers are run.
+type RunOptions struct {
+	// AllowFailures continues running sweepers after a failure.
+	// Sweepers that depend on a failed sweeper are always skipped.
+	AllowFailures bool
+	// DryRun lists the resources that would be deleted without deleting them.
+	// API operations that may modify resources fail during a dry run.
+	DryRun bool
+	// Filter selects the resources to delete.
+	Filter Filter
+	// Output receives the planned sweeper order and, during a dry run, the resources that would be deleted.
+	Output io.Writer
+	// Parallelism is the maximum number of sweepers run concurrently.
+	Parallelism int
+	// Report, if set, receives a JSON Report of the sweepers run and the resources deleted, skipped and failed.
+	Report io.Writer
+	// Run is a comma-separated list of sweeper names to run, as for the `-sweep-run` flag.
+	// Names are matched case-insensitively by substring and the matching sweepers' dependencies are also run.
+	// Defaults to all sweepers.
+	Run string
+}
+
+// sweepRun is the state of a call to RunSweepers.
+// It is passed to SharedRegionalSweepClient and SweepOrchestrator in the Context in which each sweeper is run.
+type sweepRun struct {
+	dryRun bool
+	filter Filter
+	report *Report
+}
+
+// isGuarded returns whether API operations that may modify resources are restricted during the run.
+func (r *sweepRun) isGuarded() bool {
+	return r != nil && (r.dryRun || !r.filter.isEmpty())
+}
+
+var (
+	// activeSweepRuns are the calls to RunSweepers currently running sweepers, keyed by Region.
+	// Context attaches the active run to the Contexts of sweepers registered by AddTestSweepers.
+	activeSweepRuns     = make(map[string]*sweepRun)
+	activeSweepRunsLock sync.Mutex
+)
+
+func activeSweepRun(region string) *sweepRun {
+	activeSweepRunsLock.Lock()
+	defer activeSweepRunsLock.Unlock()
+
+	return activeSweepRuns[region]
+}
+
+func setActiveSweepRun(region string, run *sweepRun) {
+	activeSweepRunsLock.Lock()
+	defer activeSweepRunsLock.Unlock()
+
+	if run == nil {
+		delete(activeSweepRuns, region)
+		return
+	}
+	activeSweepRuns[region] = run
+}
+
+// RunSweepers runs the registered sweepers in each of the specified Regions.
+// The sweepers are ordered by their dependencies and run in waves, each sweeper running only after all of its dependencies have completed.
+// The sweepers within a wave run concurrently.
+func RunSweepers(regions []string, opts RunOptions) (err error) {
+	g, err := sweeperGraph(opts.Run)
+	if err != nil {
+		return err
+	}
+
+	waves, err := g.OverallOrderWaves()
+	if err != nil {
+		return fmt.Errorf("ordering sweepers: %w", err)
+	}
+	for _, wave := range waves {
+		slices.Sort(wave)
+	}
+
+	report := &Report{
+		DryRun:    opts.DryRun,
+		Sweepers:  make([]SweeperReportEntry, 0),
+		Resources: make([]ReportEntry, 0),
+	}
+	run := &sweepRun{
+		dryRun: opts.DryRun,
+		filter: opts.Filter,
+		report: report,
+	}
+
+	if opts.Report != nil {
+		defer func() {
+			if e := report.Write(opts.Report); e != nil {
+				err = errors.Join(err, fmt.Errorf("writing sweeper report: %w", e))
+			}
+		}()
+	}
+
+	var errs []error
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+
+		if opts.DryRun {
+			writeSweeperWaves(opts.Output, region, waves)
+		}
+
+		err := runSweeperWaves(run, region, g, waves, opts)
+
+		if opts.DryRun {
+			writeWouldDelete(opts.Output, region, report)
+		}
+
+		if err != nil {
+			if !opts.AllowFailures {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// sweeperGraph returns the dependency graph of the registered sweepers selected by the specified filter.
+func sweeperGraph(filter string) (*depgraph.Graph, error) {
+	g := depgraph.New()
+	names := slices.Sorted(maps.Keys(sweepers))
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency)
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	filters := tfslices.Filter(strings.Split(strings.ToLower(filter), ","), func(s string) bool {
+		return s != ""
+	})
+	if len(filters) == 0 {
+		return g, nil
+	}
+
+	selected := make(map[string]struct{})
+	for _, name := range names {
+		if !slices.ContainsFunc(filters, func(s string) bool { return strings.Contains(strings.ToLower(name), s) }) {
+			continue
+		}
+
+		dependencies, err := g.DependenciesOf(name)
+		if err != nil {
+			return nil, fmt.Errorf("ordering sweepers: %w", err)
+		}
+
+		selected[name] = struct{}{}
+		for _, dependency := range dependencies {
+			selected[dependency] = struct{}{}
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := selected[name]; !ok {
+			g.RemoveNode(name)
+		}
+	}
+
+	return g, nil
+}
+
+// runSweeperWaves runs the sweepers in a single Region, wave by wave.
+func runSweeperWaves(run *sweepRun, region string, g *depgraph.Graph, waves [][]string, opts RunOptions) error {
+	setActiveSweepRun(region, run)
+	defer setActiveSweepRun(region, nil)
+
+	ctx := Context(region)
+	parallelism := max(opts.Parallelism, 1)
+
+	var lock sync.Mutex
+	failed := make(map[string]error)
+
+	start := time.Now()
+	for i, wave := range waves {
+		tflog.Info(ctx, "Running sweeper wave", map[string]any{
+			"wave":     i + 1,
+			"sweepers": len(wave),
+		})
+
+		var mg multierror.Group
+		semaphore := make(chan struct{}, parallelism)
+
+		for _, name := range wave {
+			dependencies, err := g.DirectDependenciesOf(name)
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			j := slices.IndexFunc(dependencies, func(s string) bool {
+				_, ok := failed[s]
+				return ok
+			})
+			if j != -1 {
+				failed[name] = fmt.Errorf("dependency (%s) failed", dependencies[j])
+			}
+			lock.Unlock()
+
+			// Deleting resources whose dependents could not be deleted would only fail.
+			if j != -1 {
+				tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+					"sweeper":    name,
+					"dependency": dependencies[j],
+				})
+				run.report.addSweeper(SweeperReportEntry{
+					Name:   name,
+					Region: region,
+					Status: StatusSkipped,
+					Reason: fmt.Sprintf("dependency (%s) failed", dependencies[j]),
+				})
+				continue
+			}
+
+			semaphore <- struct{}{}
+			mg.Go(func() error {
+				defer func() { <-semaphore }()
+
+				err := runSweeper(ctx, region, name)
+
+				entry := SweeperReportEntry{
+					Name:   name,
+					Region: region,
+					Status: StatusCompleted,
+				}
+				if err != nil {
+					entry.Status, entry.Reason = StatusFailed, err.Error()
+				}
+				run.report.addSweeper(entry)
+
+				if err != nil {
+					lock.Lock()
+					failed[name] = err
+					lock.Unlock()
+
+					return fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, err)
+				}
+
+				return nil
+			})
+		}
+
+		if err := mg.Wait().ErrorOrNil(); err != nil && !opts.AllowFailures {
+			return err
+		}
+	}
+
+	tflog.Info(ctx, "Completed sweepers", map[string]any{
+		"duration": time.Since(start).String(),
+		"failed":   len(failed),
+	})
+
+	if len(failed) > 0 {
+		errs := make([]error, 0, len(failed))
+		for _, name := range slices.Sorted(maps.Keys(failed)) {
+			errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s): %w", name, region, failed[name]))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// runSweeper runs the named sweeper in a single Region.
+// Sweepers registered by AddContextSweeper are run in the specified Context.
+// Other sweepers create their own Context using Context, which carries the same run state.
+func runSweeper(ctx context.Context, region, name string) error {
+	start := time.Now()
+
+	tflog.Debug(ctx, "Running sweeper", map[string]any{
+		"sweeper": name,
+	})
+
+	var err error
+	if f, ok := contextSweepers[name]; ok {
+		err = f(ctx, region)
+	} else {
+		err = sweepers[name].F(region)
+	}
+
+	tflog.Debug(ctx, "Completed sweeper", map[string]any{
+		"sweeper":  name,
+		"duration": time.Since(start).String(),
+	})
+
+	return err
+}
+
+// writeSweeperWaves writes the planned sweeper order for a Region.
+func writeSweeperWaves(w io.Writer, region string, waves [][]string) {
+	fmt.Fprintf(w, "Sweepers for region (%s) would run in %d waves:\n", region, len(waves))
+	for i, wave := range waves {
+		fmt.Fprintf(w, "  Wave %d:\n", i+1)
+		for _, name := range wave {
+			fmt.Fprintf(w, "    - %s\n", name)
+		}
+	}
+}
+
+// writeWouldDelete writes the resources that would be deleted in a Region during a dry run.
+func writeWouldDelete(w io.Writer, region string, report *Report) {
+	report.lock.Lock()
+	defer report.lock.Unlock()
+
+	var lines []string
+	for _, entry := range report.Resources {
+		if entry.Region != region || entry.Status != StatusWouldDelete {
+			continue
+		}
+
+		line := entry.ID
+		if entry.Name != "" && entry.Name != entry.ID {
+			line += " (" + entry.Name + ")"
+		}
+		if entry.Type != "" {
+			line = entry.Type + ": " + line
+		}
+		lines = append(lines, line)
+	}
+	slices.Sort(lines)
+
+	fmt.Fprintf(w, "Resources in region (%s) that would be deleted: %d\n", region, len(lines))
+	for _, line := range lines {
+		fmt.Fprintf(w, "  - %s\n", line)
+	}
+}
+
+// sweep deletes a single resource, subject to the run's filter and dry run setting.
+func (r *sweepRun) sweep(ctx context.Context, sweepable Sweepable, optFns ...tfresource.OptionsFunc) error {
+	if r == nil {
+		return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+	}
+
+	entry := ReportEntry{
+		Type:   resourceTypeFromContext(ctx),
+		Region: regionFromContext(ctx),
+	}
+
+	if r.filter.needsRead() {
+		if v, ok := sweepable.(readable); ok {
+			if err := v.Read(ctx); err != nil {
+				d := describe(sweepable)
+				entry.ID, entry.Name = d.id, d.name
+				entry.Status, entry.Reason = StatusFailed, fmt.Sprintf("reading: %s", err)
+				r.report.addResource(entry)
+
+				return err
+			}
+		}
+	}
+
+	d := describe(sweepable)
+	entry.ID, entry.Name = d.id, d.name
+
+	if ok, reason := r.filter.match(d, time.Now()); !ok {
+		tflog.Info(ctx, "Skipping resource", map[string]any{
+			"id":     d.id,
+			"reason": reason,
+		})
+		entry.Status, entry.Reason = StatusSkipped, reason
+		r.report.addResource(entry)
+
+		return nil
+	}
+
+	if r.dryRun {
+		tflog.Info(ctx, "Would sweep resource", map[string]any{
+			"id": d.id,
+		})
+		entry.Status = StatusWouldDelete
+		r.report.addResource(entry)
+
+		return nil
+	}
+
+	err := sweepable.Delete(withSweeping(ctx), ThrottlingRetryTimeout, optFns...)
+
+	entry.Status = StatusDeleted
+	if err != nil {
+		entry.Status, entry.Reason = StatusFailed, err.Error()
+	}
+	r.report.addResource(entry)
+
+	return err
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +90,48 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+func (sr *sweepResource) ResourceID() string {
+	return sr.d.Id()
+}
+
+func (sr *sweepResource) ResourceName() string {
+	return sr.ResourceStringAttribute(names.AttrName)
+}
+
+func (sr *sweepResource) ResourceTags() map[string]string {
+	schemaMap := sr.resource.SchemaMap()
+
+	for _, k := range []string{names.AttrTagsAll, names.AttrTags} {
+		if _, ok := schemaMap[k]; !ok {
+			continue
+		}
+
+		v, ok := sr.d.GetOk(k)
+		if !ok {
+			continue
+		}
+
+		tags := make(map[string]string)
+		for k, v := range v.(map[string]any) {
+			tags[k], _ = v.(string)
+		}
+
+		return tags
+	}
+
+	return nil
+}
+
+func (sr *sweepResource) ResourceStringAttribute(name string) string {
+	if _, ok := sr.resource.SchemaMap()[name]; !ok {
+		return ""
+	}
+
+	v, _ := sr.d.Get(name).(string)
+
+	return v
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -34,9 +35,23 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock guards sweeperClients as sweepers may run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
+// During a dry run the client fails any API operation that may modify resources.
+// When resources are filtered, it fails any such operation not called by SweepOrchestrator to delete a matching resource.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
-	if client, ok := sweeperClients[region]; ok {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
+	run := sweepRunFromContext(ctx)
+	key := region
+	if run.isGuarded() {
+		key += fmt.Sprintf("/%p", run)
+	}
+
+	if client, ok := sweeperClients[key]; ok {
 		return client, nil
 	}
 
@@ -66,6 +81,11 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		SuppressDebugLog: true,
 	}
 
+	if run.isGuarded() {
+		conf.APIHandlers = append(conf.APIHandlers, run.addGuardHandler)
+		conf.APIOptions = append(conf.APIOptions, run.addGuard)
+	}
+
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
 		ar := awsbase.AssumeRole{
 			RoleARN:  role,
@@ -98,7 +118,7 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
+	sweeperClients[key] = client
 
 	return client, nil
 }
@@ -113,10 +133,11 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 	}
 
 	var g multierror.Group
+	run := sweepRunFromContext(ctx)
 
 	for _, sweepable := range sweepables {
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			return run.sweep(ctx, sweepable, optFns...)
 		})
 	}
 
@@ -5,12 +5,27 @@ package sweep_test
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "List the sweepers and resources that would be deleted without deleting them")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only delete resources created at least this long ago")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma-separated list of prefixes; only delete resources whose names match one")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of sweepers to run concurrently in each Region")
+	flagSweepReport      = flag.String("sweep-report", "", "Path of a file to which a JSON report of the sweep is written")
+	flagSweepTag         = flag.String("sweep-tag", "", "Comma-separated list of key=value or key; only delete resources with all of these tags")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +33,72 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The testing framework's `-sweep`, `-sweep-run` and `-sweep-allow-failures` flags are honored,
+	// but sweepers are run in dependency order by this package.
+	flag.Parse()
+	if regions := flag.Lookup("sweep").Value.String(); regions != "" {
+		allowFailures, _ := strconv.ParseBool(flag.Lookup("sweep-allow-failures").Value.String())
+
+		opts := sweep.RunOptions{
+			AllowFailures: allowFailures,
+			DryRun:        *flagSweepDryRun,
+			Filter:        sweepFilter(),
+			Output:        os.Stdout,
+			Parallelism:   *flagSweepParallelism,
+			Run:           flag.Lookup("sweep-run").Value.String(),
+		}
+
+		var report *os.File
+		if *flagSweepReport != "" {
+			f, err := os.Create(*flagSweepReport)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+
+			report, opts.Report = f, f
+		}
+
+		err := sweep.RunSweepers(strings.Split(regions, ","), opts)
+
+		if report != nil {
+			err = errors.Join(err, report.Close())
+		}
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+// sweepFilter returns the resource filter specified by the `-sweep-name-prefix`, `-sweep-tag` and `-sweep-min-age` flags.
+func sweepFilter() sweep.Filter {
+	filter := sweep.Filter{
+		MinAge: *flagSweepMinAge,
+	}
+
+	for _, v := range strings.Split(*flagSweepNamePrefix, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			filter.NamePrefixes = append(filter.NamePrefixes, v)
+		}
+	}
+
+	for _, v := range strings.Split(*flagSweepTag, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		if filter.Tags == nil {
+			filter.Tags = make(map[string]string)
+		}
+		key, value, _ := strings.Cut(v, "=")
+		filter.Tags[key] = value
+	}
+
+	return filter
+}
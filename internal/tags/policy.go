@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicyEnforcement is how violations of a tag policy are reported.
+type PolicyEnforcement string
+
+const (
+	PolicyEnforcementError   PolicyEnforcement = "error"
+	PolicyEnforcementWarning PolicyEnforcement = "warning"
+)
+
+// PolicyConfig contains rules that resource tags must satisfy.
+type PolicyConfig struct {
+	Enforcement PolicyEnforcement
+	Rules       []PolicyRule
+}
+
+// PolicyRule is a rule for a single tag key.
+type PolicyRule struct {
+	// Key is the tag key, with its required casing.
+	Key string
+	// IgnoreKeyCase is set if tag keys that differ from Key only in casing satisfy the rule.
+	IgnoreKeyCase bool
+	// Required is set if the tag must be present.
+	Required bool
+	// AllowedValues are the tag's allowed values, if any.
+	// A value ending in `*` allows any value with that prefix.
+	AllowedValues []string
+	// AllowedValuePattern is a regular expression that the tag's value must match, if any.
+	AllowedValuePattern *regexp.Regexp
+}
+
+// IsWarning returns whether violations of the policy are warnings rather than errors.
+func (pc *PolicyConfig) IsWarning() bool {
+	return pc != nil && pc.Enforcement == PolicyEnforcementWarning
+}
+
+// Violations returns a description of each way in which the specified tags violate the policy.
+func (pc *PolicyConfig) Violations(tags KeyValueTags) []string {
+	if pc == nil {
+		return nil
+	}
+
+	keys := tags.Keys()
+	slices.Sort(keys)
+
+	var violations []string
+
+	for _, rule := range pc.Rules {
+		var found bool
+
+		for _, k := range keys {
+			if !strings.EqualFold(k, rule.Key) {
+				continue
+			}
+
+			if k != rule.Key && !rule.IgnoreKeyCase {
+				violations = append(violations, fmt.Sprintf("tag key (%s) must be cased as (%s)", k, rule.Key))
+				continue
+			}
+
+			found = true
+
+			if v := tags.KeyValue(k); v != nil {
+				violations = append(violations, rule.valueViolations(k, *v)...)
+			}
+		}
+
+		if rule.Required && !found {
+			violations = append(violations, fmt.Sprintf("tag (%s) is required", rule.Key))
+		}
+	}
+
+	return violations
+}
+
+func (rule PolicyRule) valueViolations(key, value string) []string {
+	var violations []string
+
+	if len(rule.AllowedValues) > 0 && !slices.ContainsFunc(rule.AllowedValues, func(allowed string) bool {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			return strings.HasPrefix(value, prefix)
+		}
+		return value == allowed
+	}) {
+		violations = append(violations, fmt.Sprintf("tag (%s) value (%s) is not one of the allowed values (%s)", key, value, strings.Join(rule.AllowedValues, ", ")))
+	}
+
+	if rule.AllowedValuePattern != nil && !rule.AllowedValuePattern.MatchString(value) {
+		violations = append(violations, fmt.Sprintf("tag (%s) value (%s) does not match pattern (%s)", key, value, rule.AllowedValuePattern))
+	}
+
+	return violations
+}
+
+// organizationsTagPolicy is the subset of an AWS Organizations tag policy document that is enforced.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+type organizationsTagPolicy struct {
+	Tags map[string]struct {
+		TagKey *struct {
+			Assign string `json:"@@assign"`
+		} `json:"tag_key"`
+		TagValue *struct {
+			Assign []string `json:"@@assign"`
+		} `json:"tag_value"`
+	} `json:"tags"`
+}
+
+// PolicyRulesFromOrganizationsTagPolicy returns the rules defined by an AWS Organizations tag policy document.
+// Tag keys are required to be cased as in the policy's `tag_key` and values must be one of those in its `tag_value`.
+// Tag policies do not make tags required.
+func PolicyRulesFromOrganizationsTagPolicy(document string) ([]PolicyRule, error) {
+	var policy organizationsTagPolicy
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	tagNames := make([]string, 0, len(policy.Tags))
+	for name := range policy.Tags {
+		tagNames = append(tagNames, name)
+	}
+	slices.Sort(tagNames)
+
+	rules := make([]PolicyRule, 0, len(tagNames))
+
+	for _, name := range tagNames {
+		tag := policy.Tags[name]
+		rule := PolicyRule{
+			Key:           name,
+			IgnoreKeyCase: true,
+		}
+
+		if v := tag.TagKey; v != nil && v.Assign != "" {
+			rule.Key = v.Assign
+			rule.IgnoreKeyCase = false
+		}
+
+		if v := tag.TagValue; v != nil {
+			rule.AllowedValues = v.Assign
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
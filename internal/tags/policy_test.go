@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPolicyConfigViolations(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		want         []string
+	}{
+		{
+			name: "nil config",
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name: "compliant",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true, AllowedValues: []string{"100", "200*"}},
+					{Key: "Owner", Required: true, AllowedValuePattern: regexache.MustCompile(`^[a-z]+@example\.com$`)},
+				},
+			},
+			tags: New(ctx, map[string]string{"CostCenter": "2001", "Owner": "team@example.com"}),
+		},
+		{
+			name: "required missing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true},
+					{Key: "Owner"},
+				},
+			},
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+			want: []string{"tag (CostCenter) is required"},
+		},
+		{
+			name: "key casing",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", Required: true},
+				},
+			},
+			tags: New(ctx, map[string]string{"costcenter": "100"}),
+			want: []string{
+				"tag key (costcenter) must be cased as (CostCenter)",
+				"tag (CostCenter) is required",
+			},
+		},
+		{
+			name: "key casing ignored",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", IgnoreKeyCase: true, Required: true, AllowedValues: []string{"100"}},
+				},
+			},
+			tags: New(ctx, map[string]string{"costcenter": "300"}),
+			want: []string{"tag (costcenter) value (300) is not one of the allowed values (100)"},
+		},
+		{
+			name: "value not allowed",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{Key: "CostCenter", AllowedValues: []string{"100", "200*"}},
+					{Key: "Owner", AllowedValuePattern: regexache.MustCompile(`^[a-z]+$`)},
+				},
+			},
+			tags: New(ctx, map[string]string{"CostCenter": "300", "Owner": "Team1"}),
+			want: []string{
+				"tag (CostCenter) value (300) is not one of the allowed values (100, 200*)",
+				"tag (Owner) value (Team1) does not match pattern (^[a-z]+$)",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.policyConfig.Violations(testCase.tags)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPolicyRulesFromOrganizationsTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		document string
+		want     []PolicyRule
+		wantErr  bool
+	}{
+		{
+			name:     "invalid JSON",
+			document: `{`,
+			wantErr:  true,
+		},
+		{
+			name: "tag policy",
+			document: `{
+  "tags": {
+    "owner": {
+      "tag_key": {"@@assign": "Owner"}
+    },
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "project": {
+      "tag_value": {"@@assign": ["alpha"]}
+    }
+  }
+}`,
+			want: []PolicyRule{
+				{Key: "CostCenter", AllowedValues: []string{"100", "200*"}},
+				{Key: "Owner"},
+				{Key: "project", IgnoreKeyCase: true, AllowedValues: []string{"alpha"}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := PolicyRulesFromOrganizationsTagPolicy(testCase.document)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, wantErr %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"net"
+	"net/netip"
 )
 
 // ValidateCIDRBlock validates that the specified CIDR block is valid:
@@ -52,3 +54,42 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// ParseCIDRBlock parses a CIDR block that is valid as defined by ValidateCIDRBlock.
+func ParseCIDRBlock(cidr string) (netip.Prefix, error) {
+	if err := ValidateCIDRBlock(cidr); err != nil {
+		return netip.Prefix{}, err
+	}
+
+	prefix, err := netip.ParsePrefix(CanonicalCIDRBlock(cidr))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	return prefix, nil
+}
+
+// CIDRSubnet returns the subnet numbered netNum within the specified prefix, extended by newBits bits.
+// It behaves like Terraform's `cidrsubnet` function.
+func CIDRSubnet(prefix netip.Prefix, newBits int, netNum int64) (netip.Prefix, error) {
+	addrBits := prefix.Addr().BitLen()
+	bits := prefix.Bits() + newBits
+
+	if newBits < 0 || bits > addrBits {
+		return netip.Prefix{}, fmt.Errorf("insufficient address space to extend prefix of %d by %d", prefix.Bits(), newBits)
+	}
+
+	if netNum < 0 || (newBits < 63 && netNum >= int64(1)<<newBits) {
+		return netip.Prefix{}, fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %d", newBits, netNum)
+	}
+
+	n := new(big.Int).SetBytes(prefix.Masked().Addr().AsSlice())
+	n.Or(n, new(big.Int).Lsh(big.NewInt(netNum), uint(addrBits-bits)))
+
+	addr, ok := netip.AddrFromSlice(n.FillBytes(make([]byte, addrBits/8)))
+	if !ok {
+		return netip.Prefix{}, fmt.Errorf("invalid subnet address for %s", prefix)
+	}
+
+	return netip.PrefixFrom(addr, bits), nil
+}
@@ -3,7 +3,10 @@
 
 package types
 
-import "testing"
+import (
+	"net/netip"
+	"testing"
+)
 
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
@@ -72,3 +75,63 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestParseCIDRBlock(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr     string
+		expected string
+		valid    bool
+	}{
+		{"10.2.2.0/24", "10.2.2.0/24", true},
+		{"10.2.2.2/24", "", false},
+		{"2001:DB8::/32", "2001:db8::/32", true},
+		{"2001:0db8:0000::/32", "2001:db8::/32", true},
+		{"2001:db8::1/32", "", false},
+		{"", "", false},
+	} {
+		got, err := ParseCIDRBlock(ts.cidr)
+		if !ts.valid && err == nil {
+			t.Fatalf("Input '%s' should error but didn't!", ts.cidr)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for '%s' input: %s", ts.cidr, err)
+		}
+		if ts.valid && got.String() != ts.expected {
+			t.Fatalf("ParseCIDRBlock(%q) should be: %q, got: %q", ts.cidr, ts.expected, got)
+		}
+	}
+}
+
+func TestCIDRSubnet(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr     string
+		newBits  int
+		netNum   int64
+		expected string
+		valid    bool
+	}{
+		{"10.0.0.0/16", 8, 0, "10.0.0.0/24", true},
+		{"10.0.0.0/16", 8, 255, "10.0.255.0/24", true},
+		{"10.0.0.0/16", 4, 3, "10.0.48.0/20", true},
+		{"10.0.0.0/16", 8, 256, "", false},
+		{"10.0.0.0/16", 17, 0, "", false},
+		{"2001:db8:1200::/56", 8, 0, "2001:db8:1200::/64", true},
+		{"2001:db8:1200::/56", 8, 255, "2001:db8:1200:ff::/64", true},
+		{"2001:db8::/32", 32, 1, "2001:db8:0:1::/64", true},
+	} {
+		got, err := CIDRSubnet(netip.MustParsePrefix(ts.cidr), ts.newBits, ts.netNum)
+		if !ts.valid && err == nil {
+			t.Fatalf("CIDRSubnet(%q, %d, %d) should error but didn't!", ts.cidr, ts.newBits, ts.netNum)
+		}
+		if ts.valid && err != nil {
+			t.Fatalf("Got unexpected error for CIDRSubnet(%q, %d, %d): %s", ts.cidr, ts.newBits, ts.netNum, err)
+		}
+		if ts.valid && got.String() != ts.expected {
+			t.Fatalf("CIDRSubnet(%q, %d, %d) should be: %q, got: %q", ts.cidr, ts.newBits, ts.netNum, ts.expected, got)
+		}
+	}
+}
@@ -5,8 +5,11 @@ package types
 
 import (
 	"context"
+	"iter"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,6 +20,38 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+// A resource's identity is its AWS account ID, its Region (for regional services) and
+// the natural key attributes from which its `id` is built.
+type ServicePackageResourceIdentity struct {
+	Attributes  []string // The natural key attributes, in the order in which they appear in the resource's ID.
+	IDSeparator string   // Separator between natural key attribute values in the resource's ID.
+}
+
+const (
+	defaultIdentityIDSeparator = ","
+)
+
+// IdentityAttributes returns the natural key attributes.
+// Resources identified solely by `id` need not declare any attributes.
+func (v *ServicePackageResourceIdentity) IdentityAttributes() []string {
+	if len(v.Attributes) == 0 {
+		return []string{"id"}
+	}
+
+	return v.Attributes
+}
+
+// ID returns the resource ID built from the specified natural key attribute values.
+func (v *ServicePackageResourceIdentity) ID(values []string) string {
+	sep := v.IDSeparator
+	if sep == "" {
+		sep = defaultIdentityIDSeparator
+	}
+
+	return strings.Join(values, sep)
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +63,26 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
+}
+
+// ServicePackageEphemeralResource represents a Terraform Plugin Framework ephemeral resource
+// implemented by a service package.
+type ServicePackageEphemeralResource struct {
+	Factory func(context.Context) (ephemeral.EphemeralResourceWithConfigure, error)
 	Name    string
-	Tags    *ServicePackageResourceTags
+}
+
+// ServicePackageListResource represents a resource type's list capability implemented by a service package.
+// List enumerates the resource type's existing remote objects in the current AWS account and Region,
+// yielding the natural key attribute values of each object's resource identity.
+type ServicePackageListResource struct {
+	List     func(context.Context, any) iter.Seq2[map[string]string, error]
+	TypeName string
+	Name     string
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -49,4 +101,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
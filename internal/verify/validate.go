@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"path"
 	"regexp"
 	"slices"
 	"strconv"
@@ -401,6 +402,22 @@ var (
 	ValidRegionName = validation.StringMatch(regionRegexp, "must be a valid AWS Region Code")
 )
 
+// IsValidRegionName returns whether the specified string is a valid AWS Region code.
+func IsValidRegionName(s string) bool {
+	return regionRegexp.MatchString(s)
+}
+
+// ValidPathPattern validates that a string is a pattern matched by io.MatchPattern.
+func ValidPathPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := path.Match(value, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, value, err))
+	}
+
+	return
+}
+
 func ValidStringIsJSONOrYAML(v interface{}, k string) (ws []string, errors []error) {
 	if looksLikeJSONString(v) {
 		if _, err := structure.NormalizeJsonString(v); err != nil {
@@ -436,6 +436,35 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 	}
 }
 
+func TestValidPathPattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"*.html",
+		"**/*.js",
+		"assets/**",
+		"[a-z]?.txt",
+	}
+	for _, v := range validPatterns {
+		_, errors := ValidPathPattern(v, "pattern")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		"[",
+		"a/[z-a/b",
+		`\`,
+	}
+	for _, v := range invalidPatterns {
+		_, errors := ValidPathPattern(v, "pattern")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid pattern", v)
+		}
+	}
+}
+
 func TestValidStringIsJSONOrYAML(t *testing.T) {
 	t.Parallel()
 
@@ -149,6 +149,7 @@ service "amp" {
   provider_package_correct = "amp"
   doc_prefix               = ["prometheus_"]
   brand                    = "AWS"
+  iam_action_prefix        = "aps"
 }
 
 service "amplify" {
@@ -299,6 +300,7 @@ service "apigatewayv2" {
   provider_package_correct = "apigatewayv2"
   doc_prefix               = ["apigatewayv2_"]
   brand                    = "AWS"
+  iam_action_prefix        = "apigateway"
 }
 
 service "appfabric" {
@@ -475,6 +477,7 @@ service "appintegrations" {
   provider_package_correct = "appintegrations"
   doc_prefix               = ["appintegrations_"]
   brand                    = "AWS"
+  iam_action_prefix        = "app-integrations"
 }
 
 service "appautoscaling" {
@@ -898,6 +901,7 @@ service "bedrockagent" {
   provider_package_correct = "bedrockagent"
   doc_prefix               = ["bedrockagent_"]
   brand                    = "Amazon"
+  iam_action_prefix        = "bedrock"
 }
 
 service "bcmdataexports" {
@@ -1114,6 +1118,7 @@ service "chimesdkmediapipelines" {
   provider_package_correct = "chimesdkmediapipelines"
   doc_prefix               = ["chimesdkmediapipelines_"]
   brand                    = "AWS"
+  iam_action_prefix        = "chime"
 }
 
 service "chimesdkmeetings" {
@@ -1200,6 +1205,7 @@ service "chimesdkvoice" {
   provider_package_correct = "chimesdkvoice"
   doc_prefix               = ["chimesdkvoice_"]
   brand                    = "AWS"
+  iam_action_prefix        = "chime"
 }
 
 service "cleanrooms" {
@@ -1257,6 +1263,7 @@ service "cloudcontrol" {
   provider_package_correct = "cloudcontrol"
   doc_prefix               = ["cloudcontrolapi_"]
   brand                    = "AWS"
+  iam_action_prefix        = "cloudformation"
 }
 
 service "clouddirectory" {
@@ -1442,6 +1449,7 @@ service "cloudhsmv2" {
   provider_package_correct = "cloudhsmv2"
   doc_prefix               = ["cloudhsm"]
   brand                    = "AWS"
+  iam_action_prefix        = "cloudhsm"
 }
 
 service "cloudsearch" {
@@ -1833,6 +1841,7 @@ service "deploy" {
   provider_package_correct = "deploy"
   doc_prefix               = ["codedeploy_"]
   brand                    = "AWS"
+  iam_action_prefix        = "codedeploy"
 }
 
 service "codeguruprofiler" {
@@ -2188,6 +2197,7 @@ service "configservice" {
   provider_package_correct = "configservice"
   doc_prefix               = ["config_"]
   brand                    = "AWS"
+  iam_action_prefix        = "config"
 }
 
 service "connect" {
@@ -2242,6 +2252,7 @@ service "connectcases" {
   provider_package_correct = "connectcases"
   doc_prefix               = ["connectcases_"]
   brand                    = "AWS"
+  iam_action_prefix        = "cases"
 }
 
 service "connectcontactlens" {
@@ -2299,6 +2310,7 @@ service "customerprofiles" {
   provider_package_correct = "customerprofiles"
   doc_prefix               = ["customerprofiles_"]
   brand                    = "AWS"
+  iam_action_prefix        = "profile"
 }
 
 service "connectparticipant" {
@@ -2757,6 +2769,7 @@ service "docdb" {
   provider_package_correct = "docdb"
   doc_prefix               = ["docdb_"]
   brand                    = "AWS"
+  iam_action_prefix        = "rds"
 }
 
 service "docdbelastic" {
@@ -3067,6 +3080,7 @@ service "efs" {
   provider_package_correct = "efs"
   doc_prefix               = ["efs_"]
   brand                    = "AWS"
+  iam_action_prefix        = "elasticfilesystem"
 }
 
 service "eks" {
@@ -3262,6 +3276,7 @@ service "elbv2" {
 
   provider_package_correct = "elbv2"
   doc_prefix               = ["lbs?\\.", "lb_listener", "lb_target_group", "lb_hosted", "lb_trust_store"]
+  iam_action_prefix        = "elasticloadbalancing"
 }
 
 service "elb" {
@@ -3295,6 +3310,7 @@ service "elb" {
 
   provider_package_correct = "elb"
   doc_prefix               = ["app_cookie_stickiness_policy", "elb", "lb_cookie_stickiness_policy", "lb_ssl_negotiation_policy", "load_balancer", "proxy_protocol_policy"]
+  iam_action_prefix        = "elasticloadbalancing"
 }
 
 service "mediaconnect" {
@@ -3521,6 +3537,7 @@ service "emr" {
   provider_package_correct = "emr"
   doc_prefix               = ["emr_"]
   brand                    = "AWS"
+  iam_action_prefix        = "elasticmapreduce"
 }
 
 service "emrcontainers" {
@@ -4696,6 +4713,7 @@ service "keyspaces" {
   provider_package_correct = "keyspaces"
   doc_prefix               = ["keyspaces_"]
   brand                    = "AWS"
+  iam_action_prefix        = "cassandra"
 }
 
 service "kinesis" {
@@ -4779,6 +4797,7 @@ service "kinesisanalyticsv2" {
   provider_package_correct = "kinesisanalyticsv2"
   doc_prefix               = ["kinesisanalyticsv2_"]
   brand                    = "AWS"
+  iam_action_prefix        = "kinesisanalytics"
 }
 
 service "firehose" {
@@ -5059,6 +5078,7 @@ service "lexmodels" {
   provider_package_correct = "lexmodels"
   doc_prefix               = ["lex_"]
   brand                    = "AWS"
+  iam_action_prefix        = "lex"
 }
 
 service "lexv2models" {
@@ -5093,6 +5113,7 @@ service "lexv2models" {
   provider_package_correct = "lexv2models"
   doc_prefix               = ["lexv2models_"]
   brand                    = "AWS"
+  iam_action_prefix        = "lex"
 }
 
 service "lexruntime" {
@@ -5250,6 +5271,7 @@ service "location" {
   provider_package_correct = "location"
   doc_prefix               = ["location_"]
   brand                    = "AWS"
+  iam_action_prefix        = "geo"
 }
 
 service "lookoutequipment" {
@@ -5918,6 +5940,7 @@ service "mwaa" {
   provider_package_correct = "mwaa"
   doc_prefix               = ["mwaa_"]
   brand                    = "AWS"
+  iam_action_prefix        = "airflow"
 }
 
 service "neptune" {
@@ -5945,6 +5968,7 @@ service "neptune" {
   provider_package_correct = "neptune"
   doc_prefix               = ["neptune_"]
   brand                    = "AWS"
+  iam_action_prefix        = "rds"
 }
 
 service "neptunegraph" {
@@ -6124,6 +6148,7 @@ service "opensearch" {
   provider_package_correct = "opensearch"
   doc_prefix               = ["opensearch_"]
   brand                    = "AWS"
+  iam_action_prefix        = "es"
 }
 
 service "opensearchserverless" {
@@ -6147,6 +6172,7 @@ service "opensearchserverless" {
   provider_package_correct = "opensearchserverless"
   doc_prefix               = ["opensearchserverless_"]
   brand                    = "AWS"
+  iam_action_prefix        = "aoss"
 }
 
 service "osis" {
@@ -6494,6 +6520,7 @@ service "pinpoint" {
   provider_package_correct = "pinpoint"
   doc_prefix               = ["pinpoint_"]
   brand                    = "AWS"
+  iam_action_prefix        = "mobiletargeting"
 }
 
 service "pinpointemail" {
@@ -6584,6 +6611,7 @@ service "pinpointsmsvoicev2" {
   provider_package_correct = "pinpointsmsvoicev2"
   doc_prefix               = ["pinpointsmsvoicev2_"]
   brand                    = "AWS"
+  iam_action_prefix        = "sms-voice"
 }
 
 service "pipes" {
@@ -7141,6 +7169,7 @@ service "resourcegroupstaggingapi" {
   provider_package_correct = "resourcegroupstaggingapi"
   doc_prefix               = ["resourcegroupstaggingapi_"]
   brand                    = "AWS"
+  iam_action_prefix        = "tag"
 }
 
 service "robomaker" {
@@ -7429,6 +7458,7 @@ service "s3" {
   provider_package_correct = "s3"
   doc_prefix               = ["s3_bucket", "s3_directory_bucket", "s3_object", "canonical_user_id"]
   brand                    = "AWS"
+  iam_action_prefix        = "s3"
 }
 
 service "s3control" {
@@ -7453,6 +7483,7 @@ service "s3control" {
   provider_package_correct = "s3control"
   doc_prefix               = ["s3control", "s3_account_", "s3_access_"]
   brand                    = "AWS"
+  iam_action_prefix        = "s3"
 }
 
 service "glacier" {
@@ -7986,6 +8017,7 @@ service "sesv2" {
   provider_package_correct = "sesv2"
   doc_prefix               = ["sesv2_"]
   brand                    = "AWS"
+  iam_action_prefix        = "ses"
 }
 
 service "sfn" {
@@ -8019,6 +8051,7 @@ service "sfn" {
   provider_package_correct = "sfn"
   doc_prefix               = ["sfn_"]
   brand                    = "AWS"
+  iam_action_prefix        = "states"
 }
 
 service "shield" {
@@ -8358,6 +8391,7 @@ service "ssoadmin" {
   provider_package_correct = "ssoadmin"
   doc_prefix               = ["ssoadmin_"]
   brand                    = "AWS"
+  iam_action_prefix        = "sso"
 }
 
 service "identitystore" {
@@ -8628,6 +8662,7 @@ service "timestreamwrite" {
   provider_package_correct = "timestreamwrite"
   doc_prefix               = ["timestreamwrite_"]
   brand                    = "AWS"
+  iam_action_prefix        = "timestream"
 }
 
 service "transcribe" {
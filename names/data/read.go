@@ -238,6 +238,13 @@ func (sr ServiceRecord) Note() string {
 	return sr.service.Note
 }
 
+func (sr ServiceRecord) IAMActionPrefix() string {
+	if sr.service.IAMActionPrefix != "" {
+		return sr.service.IAMActionPrefix
+	}
+	return sr.AWSCLIV2Command()
+}
+
 func parseService(curr Service) ServiceRecord {
 	return ServiceRecord{
 		service: curr,
@@ -340,6 +347,7 @@ type Service struct {
 	NotImplemented                bool     `hcl:"not_implemented,optional"`
 	AllowedSubcategory            bool     `hcl:"allowed_subcategory,optional"`
 	Note                          string   `hcl:"note,optional"`
+	IAMActionPrefix               string   `hcl:"iam_action_prefix,optional"`
 }
 
 type Services struct {
@@ -254,6 +254,20 @@ func Regions() []string {
 	return slices.Clone(allRegionIDs)
 }
 
+var allPartitionIDs = []string{
+	StandardPartitionID,
+	ChinaPartitionID,
+	USGovCloudPartitionID,
+	ISOPartitionID,
+	ISOBPartitionID,
+	ISOEPartitionID,
+	ISOFPartitionID,
+}
+
+func Partitions() []string {
+	return slices.Clone(allPartitionIDs)
+}
+
 func DNSSuffixForPartition(partition string) string {
 	switch partition {
 	case "":
@@ -273,6 +287,19 @@ func DNSSuffixForPartition(partition string) string {
 	}
 }
 
+// DualStackDNSSuffixForPartition returns the DNS suffix of dual-stack (IPv4 and IPv6) endpoints in the specified partition.
+// Returns an empty string if the partition does not support dual-stack endpoints.
+func DualStackDNSSuffixForPartition(partition string) string {
+	switch partition {
+	case StandardPartitionID, USGovCloudPartitionID:
+		return "api.aws"
+	case ChinaPartitionID:
+		return "api.amazonwebservices.com.cn"
+	default:
+		return ""
+	}
+}
+
 func ServicePrincipalSuffixForPartition(partition string) string {
 	switch partition {
 	case ChinaPartitionID:
@@ -335,6 +362,30 @@ func IsOptInRegion(region string) bool {
 	}
 }
 
+// IsGlobalService returns whether the specified service package's resources are global,
+// i.e. not scoped to an AWS Region, and so cannot have their Region overridden.
+func IsGlobalService(servicePackageName string) bool {
+	switch servicePackageName {
+	case Account,
+		Budgets,
+		CE,
+		CloudFront,
+		CUR,
+		GlobalAccelerator,
+		IAM,
+		Organizations,
+		Route53,
+		Route53Domains,
+		Route53RecoveryControlConfig,
+		Route53RecoveryReadiness,
+		Shield,
+		WAF:
+		return true
+	default:
+		return false
+	}
+}
+
 func PartitionForRegion(region string) string {
 	switch region {
 	case "":
@@ -57,6 +57,52 @@ func TestDNSSuffixForPartition(t *testing.T) {
 	}
 }
 
+func TestDualStackDNSSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: "",
+		},
+		{
+			name:     "China",
+			input:    ChinaPartitionID,
+			expected: "api.amazonwebservices.com.cn",
+		},
+		{
+			name:     "GovCloud",
+			input:    USGovCloudPartitionID,
+			expected: "api.aws",
+		},
+		{
+			name:     "ISO",
+			input:    ISOPartitionID,
+			expected: "",
+		},
+		{
+			name:     "standard",
+			input:    StandardPartitionID,
+			expected: "api.aws",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := DualStackDNSSuffixForPartition(testCase.input), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestIsOptInRegion(t *testing.T) {
 	t.Parallel()
 
@@ -103,6 +149,52 @@ func TestIsOptInRegion(t *testing.T) {
 	}
 }
 
+func TestIsGlobalService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "empty",
+			input:    "",
+			expected: false,
+		},
+		{
+			name:     "IAM",
+			input:    IAM,
+			expected: true,
+		},
+		{
+			name:     "Route 53",
+			input:    Route53,
+			expected: true,
+		},
+		{
+			name:     "EC2",
+			input:    EC2,
+			expected: false,
+		},
+		{
+			name:     "S3",
+			input:    S3,
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IsGlobalService(testCase.input), testCase.expected; got != want {
+				t.Errorf("got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
+
 func TestPartitionForRegion(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// iampolicy writes a least-privilege IAM policy document for the AWS API operations in API audit logs and VCR cassettes.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/leastprivilege"
+)
+
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(v string) error {
+	*f = append(*f, v)
+	return nil
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tiampolicy [flags] [<cassette>...]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	var auditLogs stringsFlag
+	flag.Var(&auditLogs, "audit-log", "API audit log file (repeatable)")
+	output := flag.String("output", "", "file to write the IAM policy document to (default is stdout)")
+	flag.Usage = usage
+	flag.Parse()
+
+	log.SetFlags(0)
+
+	if len(auditLogs) == 0 && flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	policy, err := leastprivilege.NewPolicy()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, filename := range auditLogs {
+		f, err := os.Open(filename)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = policy.AddAPIAuditLog(f)
+		f.Close()
+
+		if err != nil {
+			log.Fatalf("%s: %s", filename, err)
+		}
+	}
+
+	for _, name := range flag.Args() {
+		unidentified, err := policy.AddCassette(strings.TrimSuffix(name, ".yaml"))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, v := range unidentified {
+			log.Printf("%s: unidentified API operation: %s", name, v)
+		}
+	}
+
+	b, err := json.MarshalIndent(policy.Document(), "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	b = append(b, '\n')
+
+	if *output == "" {
+		os.Stdout.Write(b)
+		return
+	}
+
+	if err := os.WriteFile(*output, b, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// importblocks writes Terraform import blocks for existing AWS resources.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	listTypes = flag.Bool("list", false, "list the resource types that support listing and exit")
+	output    = flag.String("out", "imports.tf", "file to write the import blocks to")
+	region    = flag.String("region", "", "Region to list resources in (default the provider's configured Region)")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\timportblocks [-region <region>] [-out <file>] <resource-type>...\n")
+	fmt.Fprintf(os.Stderr, "\timportblocks -list\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+	ctx := context.Background()
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		g.Fatalf("creating provider: %s", err)
+	}
+
+	// Credentials and the default Region are read from the environment and shared configuration files.
+	if err := sdkdiag.DiagnosticsError(p.Configure(ctx, terraform.NewResourceConfigRaw(nil))); err != nil {
+		g.Fatalf("configuring provider: %s", err)
+	}
+	meta := p.Meta().(*conns.AWSClient)
+
+	if *listTypes {
+		for _, typeName := range provider.ListResourceTypes(ctx, meta) {
+			fmt.Println(typeName)
+		}
+		return
+	}
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var b strings.Builder
+	for _, typeName := range flag.Args() {
+		g.Infof("Listing %s", typeName)
+
+		seen := make(map[string]int)
+		n := 0
+		for identity, err := range provider.ListResourceIdentities(ctx, meta, typeName, *region) {
+			if err != nil {
+				g.Fatalf("%s", err)
+			}
+
+			if b.Len() > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(provider.ImportBlock(typeName, resourceName(identity, seen), identity))
+			n++
+		}
+
+		g.Infof("Found %d %s", n, typeName)
+	}
+
+	g.Infof("Generating %s", *output)
+
+	d := g.NewUnformattedFileDestination(*output)
+
+	if err := d.WriteBytes([]byte(b.String())); err != nil {
+		g.Fatalf("generating file (%s): %s", *output, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", *output, err)
+	}
+}
+
+var (
+	invalidNameCharsRegexp = regexache.MustCompile(`[^0-9A-Za-z_-]+`)
+	startsWithLetterRegexp = regexache.MustCompile(`^[A-Za-z_]`)
+)
+
+// resourceName returns a unique resource name derived from the identity's natural key attribute values.
+func resourceName(identity map[string]string, seen map[string]int) string {
+	var parts []string
+	for _, k := range slices.Sorted(maps.Keys(identity)) {
+		if k == names.AttrAccountID || k == names.AttrRegion {
+			continue
+		}
+		parts = append(parts, identity[k])
+	}
+
+	name := strings.Trim(invalidNameCharsRegexp.ReplaceAllString(strings.Join(parts, "_"), "_"), "_-")
+	// Names must start with a letter or underscore.
+	if !startsWithLetterRegexp.MatchString(name) {
+		name = "r_" + name
+	}
+
+	seen[name]++
+	if n := seen[name]; n > 1 {
+		// A suffixed name may itself have been derived from another identity.
+		suffixed := fmt.Sprintf("%s_%d", name, n)
+		for seen[suffixed] > 0 {
+			n++
+			suffixed = fmt.Sprintf("%s_%d", name, n)
+		}
+		seen[name] = n
+		seen[suffixed]++
+		name = suffixed
+	}
+
+	return name
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		identities []map[string]string
+		want       []string
+	}{
+		"natural key": {
+			identities: []map[string]string{
+				{names.AttrAccountID: "123456789012", names.AttrRegion: "us-west-2", names.AttrName: "example"}, //lintignore:AWSAT003
+			},
+			want: []string{"example"},
+		},
+		"multiple attributes": {
+			identities: []map[string]string{
+				{"bucket": "example", names.AttrKey: "path/to/object.txt"},
+			},
+			want: []string{"example_path_to_object_txt"},
+		},
+		"invalid first character": {
+			identities: []map[string]string{
+				{names.AttrID: "1234"},
+				{names.AttrID: "-example"},
+			},
+			want: []string{"r_1234", "example"},
+		},
+		"collisions": {
+			identities: []map[string]string{
+				{names.AttrName: "foo"},
+				{names.AttrName: "foo"},
+				{names.AttrName: "foo"},
+			},
+			want: []string{"foo", "foo_2", "foo_3"},
+		},
+		"collisions after sanitizing": {
+			identities: []map[string]string{
+				{names.AttrName: "foo.bar"},
+				{names.AttrName: "foo/bar"},
+			},
+			want: []string{"foo_bar", "foo_bar_2"},
+		},
+		"natural name before generated suffix": {
+			identities: []map[string]string{
+				{names.AttrName: "foo_2"},
+				{names.AttrName: "foo"},
+				{names.AttrName: "foo"},
+			},
+			want: []string{"foo_2", "foo", "foo_3"},
+		},
+		"natural name after generated suffix": {
+			identities: []map[string]string{
+				{names.AttrName: "foo"},
+				{names.AttrName: "foo"},
+				{names.AttrName: "foo_2"},
+				{names.AttrName: "foo"},
+			},
+			want: []string{"foo", "foo_2", "foo_2_2", "foo_3"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			seen := make(map[string]int)
+			var got []string
+			for _, identity := range testCase.identities {
+				got = append(got, resourceName(identity, seen))
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("resourceName() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}